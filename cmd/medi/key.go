@@ -0,0 +1,143 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/crypto/signature/secp256k1"
+	"github.com/medibloc/go-medibloc/keystore"
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var (
+	keyFileFlag = cli.StringFlag{
+		Name:  "keyfile",
+		Usage: "path of the encrypted key file",
+	}
+
+	keyCommand = cli.Command{
+		Name:  "key",
+		Usage: "manage encrypted key files",
+		Subcommands: []cli.Command{
+			{
+				Name:   "new",
+				Usage:  "generate a new key into the key file",
+				Flags:  []cli.Flag{keyFileFlag},
+				Action: keyNew,
+			},
+			{
+				Name:   "import",
+				Usage:  "encrypt a hex private key read from the terminal into the key file",
+				Flags:  []cli.Flag{keyFileFlag},
+				Action: keyImport,
+			},
+		},
+	}
+)
+
+var stdin = bufio.NewReader(os.Stdin)
+
+func readLine(prompt string) (string, error) {
+	fmt.Print(prompt)
+	line, err := stdin.ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// readSecret reads a line from the terminal without echo.
+func readSecret(prompt string) (string, error) {
+	if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+		return readLine(prompt)
+	}
+	fmt.Print(prompt)
+	b, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	return string(b), err
+}
+
+// loadKeyFile decrypts the key file given by the keyfile flag with a passphrase read from the terminal.
+func loadKeyFile(ctx *cli.Context) (signature.PrivateKey, error) {
+	path := ctx.String(keyFileFlag.Name)
+	if path == "" {
+		return nil, fmt.Errorf("--%s is required", keyFileFlag.Name)
+	}
+	passphrase, err := readSecret("Passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	return keystore.LoadKeyFile(path, passphrase)
+}
+
+func storeKeyFile(ctx *cli.Context, key signature.PrivateKey) error {
+	path := ctx.String(keyFileFlag.Name)
+	if path == "" {
+		return fmt.Errorf("--%s is required", keyFileFlag.Name)
+	}
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("%s already exists", path)
+	}
+	passphrase, err := readSecret("New passphrase: ")
+	if err != nil {
+		return err
+	}
+	confirm, err := readSecret("Repeat passphrase: ")
+	if err != nil {
+		return err
+	}
+	if passphrase != confirm {
+		return fmt.Errorf("passphrases do not match")
+	}
+	if err := keystore.StoreKeyFile(path, key, passphrase, keystore.StandardScryptN, keystore.StandardScryptP); err != nil {
+		return err
+	}
+	addr, err := common.PublicKeyToAddress(key.PublicKey())
+	if err != nil {
+		return err
+	}
+	fmt.Println(addr.Hex())
+	return nil
+}
+
+func keyNew(ctx *cli.Context) error {
+	key, err := crypto.GenerateKey(algorithm.SECP256K1)
+	if err != nil {
+		return err
+	}
+	return storeKeyFile(ctx, key)
+}
+
+func keyImport(ctx *cli.Context) error {
+	hexKey, err := readSecret("Private key: ")
+	if err != nil {
+		return err
+	}
+	key, err := secp256k1.NewPrivateKeyFromHex(strings.TrimSpace(hexKey))
+	if err != nil {
+		return err
+	}
+	return storeKeyFile(ctx, key)
+}
@@ -37,6 +37,12 @@ func main() {
 	app.Name = "medi"
 	app.Usage = "medibloc command line interface"
 	app.Version = versionStr()
+	app.Commands = []cli.Command{
+		keyCommand,
+		multisigCommand,
+		signerCommand,
+		protectionCommand,
+	}
 
 	app.Run(os.Args)
 }
@@ -0,0 +1,145 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/rpc"
+	"github.com/medibloc/go-medibloc/rpc/pb"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/urfave/cli"
+	"golang.org/x/net/context"
+)
+
+var (
+	txFileFlag = cli.StringFlag{
+		Name:  "tx",
+		Usage: "json file of a transaction request",
+	}
+	rpcAddrFlag = cli.StringFlag{
+		Name:  "rpc",
+		Usage: "grpc address of a node",
+		Value: "localhost:9920",
+	}
+
+	multisigCommand = cli.Command{
+		Name:  "multisig",
+		Usage: "collect partial signatures of multisig owners",
+		Subcommands: []cli.Command{
+			{
+				Name:   "sign",
+				Usage:  "add the owner's partial signature to the transaction file",
+				Flags:  []cli.Flag{txFileFlag, keyFileFlag},
+				Action: multisigSign,
+			},
+			{
+				Name:   "send",
+				Usage:  "send the transaction file with collected signatures",
+				Flags:  []cli.Flag{txFileFlag, rpcAddrFlag},
+				Action: multisigSend,
+			},
+		},
+	}
+)
+
+func loadTxRequest(path string) (*rpcpb.SendTransactionRequest, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	req := new(rpcpb.SendTransactionRequest)
+	if err := json.Unmarshal(buf, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func multisigSign(ctx *cli.Context) error {
+	path := ctx.String(txFileFlag.Name)
+	req, err := loadTxRequest(path)
+	if err != nil {
+		return err
+	}
+	tx, err := rpc.TransactionFromRequest(req)
+	if err != nil {
+		return err
+	}
+	// Owners sign the hash only after checking that it is calculated from the fields they see.
+	if err := tx.VerifyHash(); err != nil {
+		return err
+	}
+	fmt.Printf("chain id:  %d\n", req.ChainId)
+	fmt.Printf("from:      %s\n", tx.From().Hex())
+	fmt.Printf("to:        %s\n", tx.To().Hex())
+	fmt.Printf("value:     %s\n", tx.Value())
+	fmt.Printf("nonce:     %d\n", tx.Nonce())
+	fmt.Printf("timestamp: %d\n", tx.Timestamp())
+	fmt.Printf("type:      %s\n", tx.Type())
+	fmt.Printf("payload:   %s\n", req.Data.Payload)
+	fmt.Printf("hash:      %s\n", byteutils.Bytes2Hex(tx.Hash()))
+	answer, err := readLine("Sign this transaction? [y/N] ")
+	if err != nil {
+		return err
+	}
+	if answer != "y" && answer != "Y" {
+		return fmt.Errorf("signing is canceled")
+	}
+
+	privKey, err := loadKeyFile(ctx)
+	if err != nil {
+		return err
+	}
+	sig, err := crypto.NewSignature(privKey.Algorithm())
+	if err != nil {
+		return err
+	}
+	sig.InitSign(privKey)
+	sign, err := sig.Sign(tx.Hash())
+	if err != nil {
+		return err
+	}
+	req.MultiSign = append(req.MultiSign, byteutils.Bytes2Hex(sign))
+
+	buf, err := json.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, buf, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("%d signature(s) collected\n", len(req.MultiSign))
+	return nil
+}
+
+func multisigSend(ctx *cli.Context) error {
+	req, err := loadTxRequest(ctx.String(txFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	conn := rpc.Dial(ctx.String(rpcAddrFlag.Name))
+	defer conn.Close()
+
+	res, err := rpcpb.NewApiServiceClient(conn).SendTransaction(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	fmt.Println(res.Hash)
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/signer"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/urfave/cli"
+)
+
+var (
+	protectionFileFlag = cli.StringFlag{
+		Name:  "file",
+		Usage: "json file of the record of the last signed block",
+	}
+
+	protectionCommand = cli.Command{
+		Name:      "protection",
+		Usage:     "export or import the miner's slashing protection record when migrating a validator",
+		ArgsUsage: "<config>",
+		Subcommands: []cli.Command{
+			{
+				Name:      "export",
+				Usage:     "write the last block signed by the miner to the file",
+				ArgsUsage: "<config>",
+				Flags:     []cli.Flag{protectionFileFlag},
+				Action:    protectionExport,
+			},
+			{
+				Name:      "import",
+				Usage:     "merge the record in the file into the node's storage",
+				ArgsUsage: "<config>",
+				Flags:     []cli.Flag{protectionFileFlag},
+				Action:    protectionImport,
+			},
+		},
+	}
+)
+
+type signedBlockJSON struct {
+	Address   string `json:"address"`
+	Height    uint64 `json:"height"`
+	Timestamp int64  `json:"timestamp"`
+	Hash      string `json:"hash"`
+}
+
+// openProtection opens slashing protection in the storage of the stopped node.
+func openProtection(ctx *cli.Context) (*signer.SlashingProtection, common.Address, *storage.LeveldbStorage, error) {
+	conf := medlet.LoadConfig(ctx.Args().Get(0))
+	stor, err := storage.NewLeveldbStorage(conf.Global.Datadir)
+	if err != nil {
+		return nil, common.Address{}, nil, err
+	}
+	return signer.NewSlashingProtection(stor), common.HexToAddress(conf.Chain.Miner), stor, nil
+}
+
+func protectionExport(ctx *cli.Context) error {
+	protection, miner, stor, err := openProtection(ctx)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	signed, err := protection.LastSigned(miner)
+	if err != nil {
+		return err
+	}
+	buf, err := json.MarshalIndent(&signedBlockJSON{
+		Address:   signed.Address.Hex(),
+		Height:    signed.Height,
+		Timestamp: signed.Timestamp,
+		Hash:      byteutils.Bytes2Hex(signed.Hash),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if ctx.String(protectionFileFlag.Name) == "" {
+		fmt.Println(string(buf))
+		return nil
+	}
+	return ioutil.WriteFile(ctx.String(protectionFileFlag.Name), buf, 0600)
+}
+
+func protectionImport(ctx *cli.Context) error {
+	buf, err := ioutil.ReadFile(ctx.String(protectionFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	record := new(signedBlockJSON)
+	if err := json.Unmarshal(buf, record); err != nil {
+		return err
+	}
+
+	protection, miner, stor, err := openProtection(ctx)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	addr := common.HexToAddress(record.Address)
+	if !addr.Equals(miner) {
+		return signer.ErrSignerMismatch
+	}
+	return protection.Import(&signer.SignedBlock{
+		Address:   addr,
+		Height:    record.Height,
+		Timestamp: record.Timestamp,
+		Hash:      byteutils.Hex2Bytes(record.Hash),
+	})
+}
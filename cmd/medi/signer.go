@@ -0,0 +1,88 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package main
+
+import (
+	"os"
+	"os/signal"
+
+	"github.com/medibloc/go-medibloc/signer"
+	"github.com/medibloc/go-medibloc/storage"
+	log "github.com/medibloc/go-medibloc/util/logging"
+	"github.com/urfave/cli"
+)
+
+var (
+	signerListenFlag = cli.StringFlag{
+		Name:  "listen",
+		Usage: "grpc address to serve sign requests",
+		Value: "localhost:9930",
+	}
+	signerSecretFileFlag = cli.StringFlag{
+		Name:  "secret-file",
+		Usage: "file containing the secret shared with the node to authenticate its requests",
+	}
+	signerTLSCertFlag = cli.StringFlag{
+		Name:  "tls-cert",
+		Usage: "TLS certificate file presented to the node",
+	}
+	signerTLSKeyFlag = cli.StringFlag{
+		Name:  "tls-key",
+		Usage: "private key file of the TLS certificate",
+	}
+	signerDataDirFlag = cli.StringFlag{
+		Name:  "datadir",
+		Usage: "directory of the record of signed blocks",
+		Value: "data/signer.db",
+	}
+
+	signerCommand = cli.Command{
+		Name:  "signer",
+		Usage: "run a remote signer which holds the miner's key apart from the node",
+		Flags: []cli.Flag{signerListenFlag, keyFileFlag, signerSecretFileFlag, signerTLSCertFlag, signerTLSKeyFlag,
+			signerDataDirFlag},
+		Action: runSigner,
+	}
+)
+
+func runSigner(ctx *cli.Context) error {
+	secret, err := signer.ReadSecretFile(ctx.String(signerSecretFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	key, err := loadKeyFile(ctx)
+	if err != nil {
+		return err
+	}
+	stor, err := storage.NewLeveldbStorage(ctx.String(signerDataDirFlag.Name))
+	if err != nil {
+		return err
+	}
+	s, err := signer.NewServer(key, stor, secret, ctx.String(signerTLSCertFlag.Name), ctx.String(signerTLSKeyFlag.Name))
+	if err != nil {
+		return err
+	}
+	if err := s.Start(ctx.String(signerListenFlag.Name)); err != nil {
+		return err
+	}
+
+	sigch := make(chan os.Signal, 1)
+	signal.Notify(sigch, os.Interrupt, os.Kill)
+	<-sigch
+	s.Stop()
+	log.Console().Info("Stop signer...")
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package consensus
+
+import (
+	"errors"
+
+	"github.com/medibloc/go-medibloc/consensus/dev"
+	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/consensus/poa"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+)
+
+// Consensus engine names
+const (
+	EngineDpos = "dpos"
+	EngineDev  = "dev"
+	EnginePoa  = "poa"
+)
+
+// Error types
+var (
+	ErrUnknownEngine = errors.New("unknown consensus engine")
+)
+
+// Engine is a consensus engine which can be plugged into medlet.
+type Engine interface {
+	core.Consensus
+
+	Setup(genesis *corepb.Genesis, bm *core.BlockManager, tm *core.TransactionManager) error
+	Start()
+	Stop()
+}
+
+// New returns consensus engine selected by config. Dpos is used if none is given.
+func New(cfg *medletpb.Config, genesis *corepb.Genesis) (Engine, error) {
+	switch cfg.Chain.Consensus {
+	case "", EngineDpos:
+		return dpos.New(cfg, genesis)
+	case EngineDev:
+		return dev.New(cfg)
+	case EnginePoa:
+		return poa.New(cfg, genesis)
+	default:
+		return nil, ErrUnknownEngine
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package dev
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/consensus/dev/pb"
+	"github.com/medibloc/go-medibloc/core"
+)
+
+// ConsensusState represents state of a single authority which proposes every block
+type ConsensusState struct {
+	authority   common.Address
+	dynastySize int
+	timestamp   int64
+}
+
+// NewConsensusState returns new ConsensusState instance
+func NewConsensusState() *ConsensusState {
+	return &ConsensusState{}
+}
+
+// LoadConsensusState returns consensus state made from root bytes
+func LoadConsensusState(rootBytes []byte) (*ConsensusState, error) {
+	pb := new(devpb.ConsensusState)
+	if err := proto.Unmarshal(rootBytes, pb); err != nil {
+		return nil, err
+	}
+	cs := NewConsensusState()
+	if err := cs.FromProto(pb); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// Timestamp returns timestamp
+func (cs *ConsensusState) Timestamp() int64 {
+	return cs.timestamp
+}
+
+// Proposer returns the authority
+func (cs *ConsensusState) Proposer() common.Address {
+	return cs.authority
+}
+
+// InitDynasty sets the first miner as the authority
+func (cs *ConsensusState) InitDynasty(miners []*common.Address, dynastySize int, startTime int64) error {
+	if len(miners) == 0 {
+		return ErrNoAuthority
+	}
+	cs.authority = *miners[0]
+	cs.dynastySize = dynastySize
+	cs.timestamp = startTime
+	return nil
+}
+
+// Dynasty returns the authority
+func (cs *ConsensusState) Dynasty() ([]*common.Address, error) {
+	authority := cs.authority
+	return []*common.Address{&authority}, nil
+}
+
+// DynastySize returns dynasty size given at genesis
+func (cs *ConsensusState) DynastySize() int {
+	return cs.dynastySize
+}
+
+// Liveness returns nothing since the authority proposes every block
+func (cs *ConsensusState) Liveness() ([]*core.ProposerLiveness, error) {
+	return nil, nil
+}
+
+// PrevLiveness returns nothing since the authority proposes every block
+func (cs *ConsensusState) PrevLiveness() ([]*core.ProposerLiveness, error) {
+	return nil, nil
+}
+
+// GetNextStateAfterGenesis returns consensus state after genesis block
+func (cs *ConsensusState) GetNextStateAfterGenesis(timestamp int64) (core.ConsensusState, error) {
+	return &ConsensusState{
+		authority:   cs.authority,
+		dynastySize: cs.dynastySize,
+		timestamp:   timestamp,
+	}, nil
+}
+
+// GetNextStateAfter returns consensus state after certain amount of time. Dynasty of dev engine never expires.
+func (cs *ConsensusState) GetNextStateAfter(elapsedTime int64) (core.ConsensusState, error) {
+	if elapsedTime < 0 {
+		return nil, ErrInvalidBlockTimestamp
+	}
+	return &ConsensusState{
+		authority:   cs.authority,
+		dynastySize: cs.dynastySize,
+		timestamp:   cs.timestamp + elapsedTime,
+	}, nil
+}
+
+// Clone clone states
+func (cs *ConsensusState) Clone() (core.ConsensusState, error) {
+	return &ConsensusState{
+		authority:   cs.authority,
+		dynastySize: cs.dynastySize,
+		timestamp:   cs.timestamp,
+	}, nil
+}
+
+// RootBytes returns marshalled consensus state
+func (cs *ConsensusState) RootBytes() ([]byte, error) {
+	pb, err := cs.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// ToProto converts ConsensusState to devpb.ConsensusState
+func (cs *ConsensusState) ToProto() (proto.Message, error) {
+	return &devpb.ConsensusState{
+		Authority:   cs.authority.Bytes(),
+		DynastySize: int64(cs.dynastySize),
+		Timestamp:   cs.timestamp,
+	}, nil
+}
+
+// FromProto converts devpb.ConsensusState to ConsensusState
+func (cs *ConsensusState) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*devpb.ConsensusState); ok {
+		cs.authority = common.BytesToAddress(msg.Authority)
+		cs.dynastySize = int(msg.DynastySize)
+		cs.timestamp = msg.Timestamp
+		return nil
+	}
+	return ErrInvalidProtoToConsensusState
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package dev_test
+
+import (
+	"testing"
+
+	"github.com/medibloc/go-medibloc/consensus/dev"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDev(t *testing.T) *dev.Dev {
+	cfg := medlet.DefaultConfig()
+	cfg.Chain.Consensus = "dev"
+	d, err := dev.New(cfg)
+	require.NoError(t, err)
+	return d
+}
+
+func TestLoadConsensusState(t *testing.T) {
+	conf, dynasties, _ := testutil.NewTestGenesisConf(t)
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	genesis, err := core.NewGenesisBlock(conf, newTestDev(t), stor)
+	require.NoError(t, err)
+
+	members, err := genesis.State().Dynasty()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(members))
+	assert.Equal(t, dynasties[0].Addr, *members[0])
+	assert.Equal(t, dynasties[0].Addr, genesis.State().Proposer())
+
+	cs := dev.NewConsensusState()
+	require.NoError(t, cs.InitDynasty(members, len(dynasties), genesis.Timestamp()))
+	root1, err := cs.RootBytes()
+	require.NoError(t, err)
+	newCs, err := dev.LoadConsensusState(root1)
+	require.NoError(t, err)
+	root2, err := newCs.RootBytes()
+	require.NoError(t, err)
+	assert.Equal(t, root1, root2)
+	assert.Equal(t, cs.Proposer(), newCs.Proposer())
+}
+
+func TestDynastyNeverExpires(t *testing.T) {
+	conf, dynasties, _ := testutil.NewTestGenesisConf(t)
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	genesis, err := core.NewGenesisBlock(conf, newTestDev(t), stor)
+	require.NoError(t, err)
+
+	st, err := genesis.State().Clone()
+	require.NoError(t, err)
+	require.NoError(t, st.TransitionDynasty(genesis.Timestamp()+1))
+	require.NoError(t, st.TransitionDynasty(genesis.Timestamp()+365*24*60*60))
+	assert.Equal(t, dynasties[0].Addr, st.Proposer())
+
+	assert.Equal(t, dev.ErrInvalidBlockTimestamp, st.TransitionDynasty(genesis.Timestamp()))
+}
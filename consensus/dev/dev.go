@@ -0,0 +1,316 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package dev
+
+import (
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/signer"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/clock"
+	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Dev is a single node consensus for development.
+// The first member of genesis dynasty is the authority which proposes every block,
+// and every block becomes LIB as soon as it is pushed.
+type Dev struct {
+	coinbase common.Address
+	miner    common.Address
+	signer   signer.Signer
+	mining   bool
+	interval time.Duration
+
+	bm *core.BlockManager
+	tm *core.TransactionManager
+
+	lastMint time.Time
+
+	clock clock.Clock
+
+	quitCh chan int
+}
+
+// New returns dev consensus.
+func New(cfg *medletpb.Config) (*Dev, error) {
+	dev := &Dev{
+		interval: time.Duration(cfg.Chain.DevBlockInterval) * time.Millisecond,
+		clock:    core.Clock(),
+		quitCh:   make(chan int, 1),
+	}
+
+	if cfg.Chain.StartMine {
+		dev.mining = true
+		dev.coinbase = common.HexToAddress(cfg.Chain.Coinbase)
+		dev.miner = common.HexToAddress(cfg.Chain.Miner)
+		s, err := signer.New(cfg)
+		if err != nil {
+			return nil, err
+		}
+		dev.signer = s
+	}
+	return dev, nil
+}
+
+// NewConsensusState generates new consensus state
+func (d *Dev) NewConsensusState(rootHash []byte, storage storage.Storage) (core.ConsensusState, error) {
+	return NewConsensusState(), nil
+}
+
+// LoadConsensusState loads a consensus state from marshalled bytes
+func (d *Dev) LoadConsensusState(rootBytes []byte, storage storage.Storage) (core.ConsensusState, error) {
+	return LoadConsensusState(rootBytes)
+}
+
+// Setup sets up dev.
+func (d *Dev) Setup(genesis *corepb.Genesis, bm *core.BlockManager, tm *core.TransactionManager) error {
+	if len(genesis.GetConsensus().GetDpos().GetDynasty()) == 0 {
+		return ErrNoAuthority
+	}
+	d.bm = bm
+	d.tm = tm
+	if local, ok := d.signer.(*signer.LocalSigner); ok {
+		local.SetProtection(signer.NewSlashingProtection(bm.Storage()))
+	}
+	if d.signer != nil {
+		bm.SetFinalitySigner(d.signer)
+	}
+	return nil
+}
+
+// SetClock replaces the clock which schedules mining. It is the clock of core by default.
+func (d *Dev) SetClock(c clock.Clock) {
+	d.clock = c
+}
+
+// Start starts miner.
+func (d *Dev) Start() {
+	if !d.mining {
+		return
+	}
+	go d.loop()
+}
+
+// Stop stops miner.
+func (d *Dev) Stop() {
+	if !d.mining {
+		return
+	}
+	d.quitCh <- 0
+}
+
+// ForkChoice chooses the highest tail.
+func (d *Dev) ForkChoice(bc *core.BlockChain) (newTail *core.Block) {
+	newTail = bc.MainTailBlock()
+	for _, block := range bc.TailBlocks() {
+		if bc.IsForkedBeforeLIB(block) {
+			continue
+		}
+		if block.Height() > newTail.Height() {
+			newTail = block
+		}
+	}
+	return newTail
+}
+
+// FindLIB returns main tail block since the authority is the only proposer.
+func (d *Dev) FindLIB(bc *core.BlockChain) (newLIB *core.Block) {
+	return bc.MainTailBlock()
+}
+
+// VerifyProposer verifies that the block is signed by the authority.
+func (d *Dev) VerifyProposer(bc *core.BlockChain, block *core.BlockData) error {
+	parent := bc.BlockByHash(block.ParentHash())
+	if parent == nil {
+		parent = bc.MainTailBlock()
+	}
+	if block.Timestamp() <= parent.Timestamp() {
+		return ErrInvalidBlockTimestamp
+	}
+
+	authority := parent.State().Proposer()
+	signer, err := recoverSigner(block)
+	if err != nil {
+		logging.WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Debug("Failed to recover block's signer.")
+		return err
+	}
+	if !authority.Equals(signer) {
+		logging.WithFields(logrus.Fields{
+			"signer":    signer,
+			"authority": authority,
+			"block":     block,
+		}).Debug("Block is not signed by the authority.")
+		return ErrInvalidBlockProposer
+	}
+	return nil
+}
+
+func recoverSigner(block *core.BlockData) (common.Address, error) {
+	sig, err := crypto.NewSignature(block.Alg())
+	if err != nil {
+		return common.Address{}, err
+	}
+	pub, err := sig.RecoverPublic(block.Hash(), block.Signature())
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.PublicKeyToAddress(pub)
+}
+
+func (d *Dev) mintBlock(now time.Time) error {
+	tail := d.bm.TailBlock()
+	if !tail.State().Proposer().Equals(d.miner) && tail.Height() != core.GenesisHeight {
+		return ErrInvalidBlockProposer
+	}
+
+	var first *core.Transaction
+	if d.interval > 0 {
+		if now.Sub(d.lastMint) < d.interval {
+			return ErrNotTimeToMint
+		}
+	} else {
+		first = d.tm.Pop()
+		if first == nil {
+			return ErrNotTimeToMint
+		}
+	}
+
+	timestamp := now.Unix()
+	if timestamp <= tail.Timestamp() {
+		timestamp = tail.Timestamp() + 1
+	}
+
+	block, err := d.makeBlock(tail, timestamp, first)
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"tail": tail,
+			"err":  err,
+		}).Error("Failed to make a new block.")
+		return err
+	}
+	if err := block.Seal(); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Error("Failed to seal a new block.")
+		return err
+	}
+	if err := d.signer.SignBlock(block); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to sign block.")
+		return err
+	}
+	d.lastMint = now
+
+	logging.Console().WithFields(logrus.Fields{
+		"block": block,
+	}).Info("New block is minted.")
+
+	if err := d.bm.PushBlockData(block.GetBlockData()); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Error("Failed to push block to blockchain.")
+		return err
+	}
+	d.bm.BroadCast(block.GetBlockData())
+	return nil
+}
+
+func (d *Dev) makeBlock(tail *core.Block, timestamp int64, first *core.Transaction) (*core.Block, error) {
+	block, err := core.NewBlock(d.bm.ChainID(), d.coinbase, tail)
+	if err != nil {
+		return nil, err
+	}
+	if err := block.SetTimestamp(timestamp); err != nil {
+		return nil, err
+	}
+	if err := block.State().TransitionDynasty(timestamp); err != nil {
+		return nil, err
+	}
+
+	for tx := first; tx != nil; tx = d.tm.Pop() {
+		if err := block.BeginBatch(); err != nil {
+			return nil, err
+		}
+		if err := block.ExecuteTransaction(tx); err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"err": err,
+				"tx":  tx,
+			}).Warn("Failed to execute transaction.")
+			if err := block.RollBack(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := block.AcceptTransaction(tx); err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"err": err,
+				"tx":  tx,
+			}).Warn("Failed to accept transaction.")
+			if err := block.RollBack(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := block.Commit(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := block.BeginBatch(); err != nil {
+		return nil, err
+	}
+	if err := block.ExecuteReservedTasks(); err != nil {
+		block.RollBack()
+		return nil, err
+	}
+	if err := block.PayReward(); err != nil {
+		block.RollBack()
+		return nil, err
+	}
+	if err := block.Commit(); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (d *Dev) loop() {
+	logging.Console().WithFields(logrus.Fields{
+		"interval": d.interval,
+	}).Info("Started Dev Mining.")
+	ticker := d.clock.NewTicker(miningTickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C():
+			d.mintBlock(now)
+		case <-d.quitCh:
+			logging.Console().Info("Stopped Dev Mining.")
+			return
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package dev_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/consensus/dev"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/signer"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/util/clock"
+	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMintOnClock(t *testing.T) {
+	conf, dynasties, _ := testutil.NewTestGenesisConf(t)
+	conf.Meta.DynastySize = 1
+	conf.Consensus.Dpos.Dynasty = conf.Consensus.Dpos.Dynasty[:1]
+	start := time.Unix(1500000000, 0)
+	clk := clock.NewManual(start)
+	core.SetClock(clk)
+	defer core.SetClock(clock.System)
+
+	privKey, err := dynasties[0].PrivKey.Encoded()
+	require.NoError(t, err)
+	cfg := medlet.DefaultConfig()
+	cfg.Chain.Consensus = "dev"
+	cfg.Chain.StartMine = true
+	cfg.Chain.Coinbase = dynasties[0].Addr.Hex()
+	cfg.Chain.Miner = dynasties[0].Addr.Hex()
+	cfg.Chain.Privkey = byteutils.Bytes2Hex(privKey)
+	cfg.Chain.DevBlockInterval = 1000
+	d, err := dev.New(cfg)
+	require.NoError(t, err)
+	bm, err := core.NewBlockManager(cfg)
+	require.NoError(t, err)
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	require.NoError(t, bm.Setup(conf, stor, nil, d))
+	require.NoError(t, d.Setup(conf, bm, core.NewTransactionManager(cfg)))
+
+	d.Start()
+	defer d.Stop()
+	waitFor(t, func() bool { return clk.Tickers() == 1 })
+
+	// A block is minted on the first tick and then every interval, stamped by the clock.
+	for i := int64(0); i < 3; i++ {
+		for j := 0; j < 10; j++ {
+			clk.Advance(100 * time.Millisecond)
+			waitFor(t, func() bool { return clk.PendingTicks() == 0 })
+			if j == 0 {
+				waitFor(t, func() bool { return bm.TailBlock().Height() == core.GenesisHeight+uint64(i)+1 })
+				assert.Equal(t, start.Unix()+i, bm.TailBlock().Timestamp())
+			}
+		}
+	}
+
+	// The miner has recorded its last block before signing it.
+	signed, err := signer.NewSlashingProtection(stor).LastSigned(dynasties[0].Addr)
+	require.NoError(t, err)
+	assert.Equal(t, bm.TailBlock().Hash(), signed.Hash)
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("timed out waiting for condition")
+}
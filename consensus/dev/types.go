@@ -0,0 +1,35 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package dev
+
+import (
+	"errors"
+	"time"
+)
+
+// Consensus properties.
+const (
+	miningTickInterval = 100 * time.Millisecond
+)
+
+// Error types of dev package.
+var (
+	ErrInvalidBlockProposer         = errors.New("invalid block proposer")
+	ErrInvalidBlockTimestamp        = errors.New("block timestamp must be later than parent's")
+	ErrInvalidProtoToConsensusState = errors.New("protobuf message cannot be converted into ConsensusState")
+	ErrNoAuthority                  = errors.New("genesis dynasty must have an authority")
+	ErrNotTimeToMint                = errors.New("cannot mint block now")
+)
@@ -36,24 +36,39 @@ type ConsensusState struct {
 	timestamp   int64
 	startTime   int64
 
+	liveness     *trie.Trie
+	prevLiveness *trie.Trie
+
+	params  *Params
 	storage storage.Storage
 }
 
 // NewConsensusState returns new ConsensusState instance
-func NewConsensusState(dynastyRootHash []byte, storage storage.Storage) (*ConsensusState, error) {
+func NewConsensusState(dynastyRootHash []byte, storage storage.Storage, params *Params) (*ConsensusState, error) {
 	t, err := trie.NewTrie(dynastyRootHash, storage)
 	if err != nil {
 		return nil, err
 	}
+	liveness, err := trie.NewTrie(nil, storage)
+	if err != nil {
+		return nil, err
+	}
+	prevLiveness, err := trie.NewTrie(nil, storage)
+	if err != nil {
+		return nil, err
+	}
 	return &ConsensusState{
-		dynasty: t,
-		storage: storage,
+		dynasty:      t,
+		liveness:     liveness,
+		prevLiveness: prevLiveness,
+		params:       params,
+		storage:      storage,
 	}, nil
 }
 
 // LoadConsensusState returns consensus state made from root bytes
-func LoadConsensusState(rootBytes []byte, storage storage.Storage) (*ConsensusState, error) {
-	cs, err := NewConsensusState(nil, storage)
+func LoadConsensusState(rootBytes []byte, storage storage.Storage, params *Params) (*ConsensusState, error) {
+	cs, err := NewConsensusState(nil, storage, params)
 	if err != nil {
 		return nil, err
 	}
@@ -95,11 +110,17 @@ func (cs *ConsensusState) InitDynasty(miners []*common.Address, dynastySize int,
 			return err
 		}
 	}
+	liveness, err := trie.NewTrie(nil, cs.storage)
+	if err != nil {
+		return err
+	}
 	cs.dynasty = t
 	cs.dynastySize = dynastySize
 	cs.startTime = startTime
 	cs.timestamp = startTime
-	cs.proposer, err = FindProposer(startTime, miners)
+	cs.prevLiveness = cs.liveness
+	cs.liveness = liveness
+	cs.proposer, err = cs.params.FindProposer(startTime, miners)
 	if err != nil {
 		logging.Console().WithFields(logrus.Fields{
 			"err":    err,
@@ -108,7 +129,7 @@ func (cs *ConsensusState) InitDynasty(miners []*common.Address, dynastySize int,
 		}).Error("Failed to find proposer.")
 		return err
 	}
-	return nil
+	return cs.addLiveness(cs.proposer, true)
 }
 
 // Dynasty returns all witnesses in the dynasty
@@ -128,67 +149,177 @@ func (cs *ConsensusState) GetNextState(at int64) (core.ConsensusState, error) {
 
 // GetNextStateAfterGenesis returns consensus state after genesis block
 func (cs *ConsensusState) GetNextStateAfterGenesis(timestamp int64) (core.ConsensusState, error) {
-	deadline := mintDeadline(time.Unix(timestamp, 0))
+	deadline := cs.params.mintDeadline(time.Unix(timestamp, 0))
 	dynastyTrie, err := cs.dynasty.Clone()
 	if err != nil {
 		return nil, err
 	}
+	liveness, err := trie.NewTrie(nil, cs.storage)
+	if err != nil {
+		return nil, err
+	}
+	prevLiveness, err := trie.NewTrie(nil, cs.storage)
+	if err != nil {
+		return nil, err
+	}
 	consensusState := &ConsensusState{
-		dynasty:     dynastyTrie,
-		dynastySize: cs.dynastySize,
-		timestamp:   deadline.Unix(),
-		startTime:   deadline.Unix(),
-		storage:     cs.storage,
+		dynasty:      dynastyTrie,
+		dynastySize:  cs.dynastySize,
+		timestamp:    deadline.Unix(),
+		startTime:    deadline.Unix(),
+		liveness:     liveness,
+		prevLiveness: prevLiveness,
+		params:       cs.params,
+		storage:      cs.storage,
 	}
 	miners, err := TraverseDynasty(dynastyTrie)
 	if err != nil {
 		return nil, err
 	}
-	consensusState.proposer, err = FindProposer(deadline.Unix(), miners)
+	consensusState.proposer, err = cs.params.FindProposer(deadline.Unix(), miners)
 	if err != nil {
 		return nil, err
 	}
+	if err := consensusState.addLiveness(consensusState.proposer, true); err != nil {
+		return nil, err
+	}
 	return consensusState, nil
 }
 
 // GetNextStateAfter returns consensus state after certain amount of time
 func (cs *ConsensusState) GetNextStateAfter(elapsedTime int64) (core.ConsensusState, error) {
-	if cs.startTime+int64(DynastyInterval/time.Millisecond) < cs.timestamp+elapsedTime {
+	if cs.startTime+cs.params.dynastyIntervalSec() < cs.timestamp+elapsedTime {
 		return nil, core.ErrDynastyExpired
 	}
-	if elapsedTime < 0 || elapsedTime%int64(BlockInterval/time.Second) != 0 {
+	if elapsedTime < 0 || elapsedTime%cs.params.blockIntervalSec() != 0 {
 		return nil, ErrInvalidBlockForgeTime
 	}
 	dynastyTrie, err := cs.dynasty.Clone()
 	if err != nil {
 		return nil, err
 	}
+	liveness, err := cs.liveness.Clone()
+	if err != nil {
+		return nil, err
+	}
+	prevLiveness, err := cs.prevLiveness.Clone()
+	if err != nil {
+		return nil, err
+	}
 	consensusState := &ConsensusState{
-		dynasty:     dynastyTrie,
-		dynastySize: cs.dynastySize,
-		timestamp:   cs.timestamp + elapsedTime,
-		startTime:   cs.startTime,
-		storage:     cs.storage,
+		dynasty:      dynastyTrie,
+		dynastySize:  cs.dynastySize,
+		timestamp:    cs.timestamp + elapsedTime,
+		startTime:    cs.startTime,
+		liveness:     liveness,
+		prevLiveness: prevLiveness,
+		params:       cs.params,
+		storage:      cs.storage,
 	}
 	miners, err := TraverseDynasty(dynastyTrie)
 	if err != nil {
 		return nil, err
 	}
-	consensusState.proposer, err = FindProposer(consensusState.timestamp, miners)
+	consensusState.proposer, err = cs.params.FindProposer(consensusState.timestamp, miners)
 	if err != nil {
 		return nil, err
 	}
+	if elapsedTime > 0 {
+		if err := consensusState.recordSlots(cs.timestamp, miners); err != nil {
+			return nil, err
+		}
+	}
 	return consensusState, nil
 }
 
+// recordSlots counts slots after the last block as missed and the slot of current block as produced
+func (cs *ConsensusState) recordSlots(lastTimestamp int64, miners []*common.Address) error {
+	interval := cs.params.blockIntervalSec()
+	for ts := lastTimestamp + interval; ts < cs.timestamp; ts += interval {
+		proposer, err := cs.params.FindProposer(ts, miners)
+		if err != nil {
+			return err
+		}
+		if err := cs.addLiveness(proposer, false); err != nil {
+			return err
+		}
+	}
+	return cs.addLiveness(cs.proposer, true)
+}
+
+func (cs *ConsensusState) addLiveness(proposer common.Address, produced bool) error {
+	pbLiveness := &consensuspb.Liveness{
+		Address: proposer.Bytes(),
+	}
+	b, err := cs.liveness.Get(proposer.Bytes())
+	if err != nil && err != trie.ErrNotFound {
+		return err
+	}
+	if err == nil {
+		if err := proto.Unmarshal(b, pbLiveness); err != nil {
+			return err
+		}
+	}
+	if produced {
+		pbLiveness.Produced++
+	} else {
+		pbLiveness.Missed++
+	}
+	b, err = proto.Marshal(pbLiveness)
+	if err != nil {
+		return err
+	}
+	return cs.liveness.Put(proposer.Bytes(), b)
+}
+
+// Liveness returns the number of produced and missed blocks of each proposer in current dynasty
+func (cs *ConsensusState) Liveness() ([]*core.ProposerLiveness, error) {
+	return traverseLiveness(cs.liveness)
+}
+
+// PrevLiveness returns the number of produced and missed blocks of each proposer in previous dynasty
+func (cs *ConsensusState) PrevLiveness() ([]*core.ProposerLiveness, error) {
+	return traverseLiveness(cs.prevLiveness)
+}
+
+func traverseLiveness(liveness *trie.Trie) ([]*core.ProposerLiveness, error) {
+	records := []*core.ProposerLiveness{}
+	iter, err := liveness.Iterator(nil)
+	if err == storage.ErrKeyNotFound {
+		return records, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	exist, err := iter.Next()
+	for exist {
+		pbLiveness := new(consensuspb.Liveness)
+		if err := proto.Unmarshal(iter.Value(), pbLiveness); err != nil {
+			return nil, err
+		}
+		records = append(records, &core.ProposerLiveness{
+			Address:  common.BytesToAddress(pbLiveness.Address),
+			Produced: pbLiveness.Produced,
+			Missed:   pbLiveness.Missed,
+		})
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
 // ToProto returns protobuf version of consensus state
 func (cs *ConsensusState) ToProto() proto.Message {
 	return &consensuspb.ConsensusState{
-		DynastyRoot: cs.dynasty.RootHash(),
-		DynastySize: int64(cs.dynastySize),
-		Proposer:    cs.proposer.Bytes(),
-		StartTime:   cs.startTime,
-		Timestamp:   cs.timestamp,
+		DynastyRoot:      cs.dynasty.RootHash(),
+		DynastySize:      int64(cs.dynastySize),
+		Proposer:         cs.proposer.Bytes(),
+		StartTime:        cs.startTime,
+		Timestamp:        cs.timestamp,
+		LivenessRoot:     cs.liveness.RootHash(),
+		PrevLivenessRoot: cs.prevLiveness.RootHash(),
 	}
 }
 
@@ -204,6 +335,12 @@ func (cs *ConsensusState) FromProto(msg proto.Message) error {
 		cs.proposer = common.BytesToAddress(msg.Proposer)
 		cs.timestamp = msg.Timestamp
 		cs.startTime = msg.StartTime
+		if cs.liveness, err = trie.NewTrie(msg.LivenessRoot, cs.storage); err != nil {
+			return err
+		}
+		if cs.prevLiveness, err = trie.NewTrie(msg.PrevLivenessRoot, cs.storage); err != nil {
+			return err
+		}
 		return nil
 	}
 	return ErrInvalidProtoToConsensusState
@@ -216,7 +353,7 @@ func (cs *ConsensusState) RootBytes() ([]byte, error) {
 
 // Clone returns a clone of consensus state
 func (cs *ConsensusState) Clone() (core.ConsensusState, error) {
-	clone, err := NewConsensusState(nil, cs.storage)
+	clone, err := NewConsensusState(nil, cs.storage, cs.params)
 	if err != nil {
 		return nil, err
 	}
@@ -226,25 +363,6 @@ func (cs *ConsensusState) Clone() (core.ConsensusState, error) {
 	return clone, nil
 }
 
-// FindProposer return proposer at the given time
-func FindProposer(ts int64, miners []*common.Address) (common.Address, error) {
-	now := time.Duration(ts) * time.Second
-	if now%BlockInterval != 0 {
-		return common.Address{}, ErrInvalidBlockForgeTime
-	}
-	offsetInDynastyInterval := now % DynastyInterval
-	offsetInDynasty := int(offsetInDynastyInterval/BlockInterval) % len(miners)
-
-	if int(offsetInDynasty) >= len(miners) {
-		logging.WithFields(logrus.Fields{
-			"offset": offsetInDynasty,
-			"miners": len(miners),
-		}).Error("No proposer selected for this turn.")
-		return common.Address{}, ErrFoundNilProposer
-	}
-	return *(miners[offsetInDynasty]), nil
-}
-
 // TraverseDynasty traverses dynasty trie and return all miners found
 func TraverseDynasty(dynasty *trie.Trie) (miners []*common.Address, err error) {
 	members := []*common.Address{}
@@ -19,20 +19,23 @@ import (
 	"testing"
 	"time"
 
+	"github.com/medibloc/go-medibloc/common"
 	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/core"
 	"github.com/medibloc/go-medibloc/util/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLoadConsensusState(t *testing.T) {
 	genesis, _, _ := testutil.NewTestGenesisBlock(t)
-	cs, err := dpos.NewConsensusState(nil, genesis.Storage())
+	cs, err := dpos.NewConsensusState(nil, genesis.Storage(), dpos.DefaultParams())
 	assert.NoError(t, err)
 
 	time.Sleep(10)
 
 	root1, err := cs.RootBytes()
-	newCs, err := dpos.LoadConsensusState(root1, genesis.Storage())
+	newCs, err := dpos.LoadConsensusState(root1, genesis.Storage(), dpos.DefaultParams())
 	assert.NoError(t, err)
 	root2, err := newCs.RootBytes()
 	assert.NoError(t, err)
@@ -41,10 +44,51 @@ func TestLoadConsensusState(t *testing.T) {
 
 func TestClone(t *testing.T) {
 	genesis, _, _ := testutil.NewTestGenesisBlock(t)
-	cs, err := dpos.NewConsensusState(nil, genesis.Storage())
+	cs, err := dpos.NewConsensusState(nil, genesis.Storage(), dpos.DefaultParams())
 	assert.NoError(t, err)
 
 	clone, err := cs.Clone()
 	assert.NoError(t, err)
 	assert.Equal(t, cs, clone)
 }
+
+func TestLiveness(t *testing.T) {
+	genesis, _, _ := testutil.NewTestGenesisBlock(t)
+	block := testutil.NewTestBlock(t, genesis)
+	st, err := block.State().Clone()
+	require.NoError(t, err)
+
+	countOf := func(liveness []*core.ProposerLiveness) map[common.Address][2]uint64 {
+		counts := make(map[common.Address][2]uint64)
+		for _, l := range liveness {
+			counts[l.Address] = [2]uint64{l.Produced, l.Missed}
+		}
+		return counts
+	}
+	before, err := st.Liveness()
+	require.NoError(t, err)
+	expected := countOf(before)
+
+	members, err := st.Dynasty()
+	require.NoError(t, err)
+	interval := int64(dpos.DefaultBlockInterval / time.Second)
+	for i := int64(1); i <= 3; i++ {
+		proposer, err := dpos.DefaultParams().FindProposer(block.Timestamp()+i*interval, members)
+		require.NoError(t, err)
+		count := expected[proposer]
+		if i == 3 {
+			count[0]++
+		} else {
+			count[1]++
+		}
+		expected[proposer] = count
+	}
+
+	require.NoError(t, st.TransitionDynasty(block.Timestamp()+3*interval))
+	after, err := st.Liveness()
+	require.NoError(t, err)
+	assert.Equal(t, expected, countOf(after))
+	prev, err := st.PrevLiveness()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(prev))
+}
@@ -16,18 +16,23 @@
 package dpos
 
 import (
+	"bytes"
+	"fmt"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/consensus/dpos/pb"
 	"github.com/medibloc/go-medibloc/core"
 	"github.com/medibloc/go-medibloc/core/pb"
 	"github.com/medibloc/go-medibloc/crypto"
-	"github.com/medibloc/go-medibloc/crypto/signature"
 	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
-	"github.com/medibloc/go-medibloc/crypto/signature/secp256k1"
 	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/metrics"
+	"github.com/medibloc/go-medibloc/signer"
 	"github.com/medibloc/go-medibloc/storage"
 	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/util/clock"
 	"github.com/medibloc/go-medibloc/util/logging"
 	"github.com/sirupsen/logrus"
 )
@@ -36,67 +41,98 @@ import (
 type Dpos struct {
 	coinbase common.Address
 	miner    common.Address
-	minerKey signature.PrivateKey
+	signer   signer.Signer
+
+	protection *signer.SlashingProtection
+
+	// standbyMissedSlots is the number of consecutive slots the primary may miss before a standby takes over.
+	standbyMissedSlots int
+	takenOver          bool
+	lastMinted         []byte
 
 	bm *core.BlockManager
 	tm *core.TransactionManager
 
-	genesis       *corepb.Genesis
-	dynastySize   int
-	consensusSize int
+	genesis *corepb.Genesis
+	params  *Params
+
+	clock clock.Clock
 
 	quitCh chan int
 }
 
-// New returns dpos consensus.
-func New(cfg *medletpb.Config) (*Dpos, error) {
+// New returns dpos consensus with properties given by genesis. Default properties are used if genesis is nil.
+func New(cfg *medletpb.Config, genesis *corepb.Genesis) (*Dpos, error) {
+	params, err := NewParams(genesis)
+	if err != nil {
+		return nil, err
+	}
 	dpos := &Dpos{
+		params: params,
+		clock:  core.Clock(),
 		quitCh: make(chan int, 1),
 	}
 
 	if cfg.Chain.StartMine {
 		dpos.coinbase = common.HexToAddress(cfg.Chain.Coinbase)
 		dpos.miner = common.HexToAddress(cfg.Chain.Miner)
-		minerKey, err := secp256k1.NewPrivateKeyFromHex(cfg.Chain.Privkey)
+		dpos.standbyMissedSlots = int(cfg.Chain.StandbyMissedSlots)
+		// Only a signer shared with the primary can guarantee that both never sign the same slot.
+		if dpos.standbyMissedSlots > 0 && cfg.Chain.RemoteSigner == "" {
+			return nil, ErrStandbyWithoutRemoteSigner
+		}
+		s, err := signer.New(cfg)
 		if err != nil {
-			logging.Console().WithFields(logrus.Fields{
-				"err": err,
-			}).Error("Invalid miner private key.")
 			return nil, err
 		}
-		dpos.minerKey = minerKey
+		dpos.signer = s
 	}
 	return dpos, nil
 }
 
 // NewConsensusState generates new consensus state
 func (d *Dpos) NewConsensusState(rootHash []byte, storage storage.Storage) (core.ConsensusState, error) {
-	return NewConsensusState(rootHash, storage)
+	return NewConsensusState(rootHash, storage, d.params)
 }
 
 // LoadConsensusState loads a consensus state from marshalled bytes
 func (d *Dpos) LoadConsensusState(rootBytes []byte, storage storage.Storage) (core.ConsensusState, error) {
-	return LoadConsensusState(rootBytes, storage)
+	return LoadConsensusState(rootBytes, storage, d.params)
 }
 
 // Setup sets up dpos.
 func (d *Dpos) Setup(genesis *corepb.Genesis, bm *core.BlockManager, tm *core.TransactionManager) error {
 	d.genesis = genesis
-
-	d.dynastySize = int(d.genesis.Meta.DynastySize)
-	if d.dynastySize < 3 || d.dynastySize > 21 || d.dynastySize%3 != 0 {
-		logging.Console().WithFields(logrus.Fields{
-			"dynastySize": d.dynastySize,
-		}).Error("Dynasty size should be a multiple of three.")
-		return ErrInvalidDynastySize
-	}
-
-	d.consensusSize = d.dynastySize*2/3 + 1
 	d.bm = bm
 	d.tm = tm
+	if local, ok := d.signer.(*signer.LocalSigner); ok {
+		d.protection = signer.NewSlashingProtection(bm.Storage())
+		local.SetProtection(d.protection)
+	}
+	if d.signer != nil {
+		bm.SetFinalitySigner(d.signer)
+	}
+	if d.standbyMissedSlots > 0 {
+		return d.loadStandbyState()
+	}
 	return nil
 }
 
+// Params returns consensus properties.
+func (d *Dpos) Params() *Params {
+	return d.params
+}
+
+// TakenOver returns whether the node, as a standby, has taken over minting from the primary.
+func (d *Dpos) TakenOver() bool {
+	return d.takenOver
+}
+
+// SetClock replaces the clock which schedules mining. It is the clock of core by default.
+func (d *Dpos) SetClock(c clock.Clock) {
+	d.clock = c
+}
+
 // Start starts miner.
 func (d *Dpos) Start() {
 	go d.loop()
@@ -108,6 +144,11 @@ func (d *Dpos) Stop() {
 }
 
 // ForkChoice chooses fork.
+//
+// Among the tails not forked before LIB, it prefers the highest one. Ties are
+// broken by the number of distinct proposers since LIB and then by the lowest
+// block hash, so that every node picks the same tail regardless of the order
+// in which the tails are iterated.
 func (d *Dpos) ForkChoice(bc *core.BlockChain) (newTail *core.Block) {
 	newTail = bc.MainTailBlock()
 	tails := bc.TailBlocks()
@@ -119,7 +160,7 @@ func (d *Dpos) ForkChoice(bc *core.BlockChain) (newTail *core.Block) {
 			}).Debug("Blocks forked before LIB can not be selected.")
 			continue
 		}
-		if block.Height() > newTail.Height() {
+		if preferTail(bc, block, newTail) {
 			newTail = block
 		}
 	}
@@ -127,6 +168,31 @@ func (d *Dpos) ForkChoice(bc *core.BlockChain) (newTail *core.Block) {
 	return newTail
 }
 
+// preferTail reports whether tail a should be chosen over tail b.
+func preferTail(bc *core.BlockChain, a *core.Block, b *core.Block) bool {
+	if a.Height() != b.Height() {
+		return a.Height() > b.Height()
+	}
+	if byteutils.Equal(a.Hash(), b.Hash()) {
+		return false
+	}
+	pa, pb := distinctProposersSinceLIB(bc, a), distinctProposersSinceLIB(bc, b)
+	if pa != pb {
+		return pa > pb
+	}
+	return bytes.Compare(a.Hash(), b.Hash()) < 0
+}
+
+// distinctProposersSinceLIB counts distinct proposers of the blocks between LIB and tail.
+func distinctProposersSinceLIB(bc *core.BlockChain, tail *core.Block) int {
+	lib := bc.LIB()
+	proposers := make(map[common.Address]bool)
+	for cur := tail; cur != nil && cur.Height() > lib.Height(); cur = bc.BlockByHash(cur.ParentHash()) {
+		proposers[cur.State().Proposer()] = true
+	}
+	return len(proposers)
+}
+
 // FindLIB finds new LIB.
 func (d *Dpos) FindLIB(bc *core.BlockChain) (newLIB *core.Block) {
 	lib := bc.LIB()
@@ -143,11 +209,14 @@ func (d *Dpos) FindLIB(bc *core.BlockChain) (newLIB *core.Block) {
 		return lib
 	}
 	dynastyGen := int64(-1)
+	consensusSize := 0
 
 	for !byteutils.Equal(cur.Hash(), lib.Hash()) {
-		if gen := dynastyGenByTime(cur.Timestamp()); dynastyGen != gen {
+		if gen := d.params.dynastyGenByTime(cur.Timestamp()); dynastyGen != gen {
 			dynastyGen = gen
 			confirmed = make(map[string]bool)
+			// Dynasty size is changed by governance and applied from the next dynasty.
+			consensusSize = cur.State().DynastySize()*2/3 + 1
 			members, err = cur.State().Dynasty()
 			if err != nil {
 				logging.Console().WithFields(logrus.Fields{
@@ -158,11 +227,11 @@ func (d *Dpos) FindLIB(bc *core.BlockChain) (newLIB *core.Block) {
 			}
 		}
 
-		if cur.Height()-lib.Height() < uint64(d.consensusSize-len(confirmed)) {
+		if cur.Height()-lib.Height() < uint64(consensusSize-len(confirmed)) {
 			return lib
 		}
 
-		proposer, err := FindProposer(cur.Timestamp(), members)
+		proposer, err := d.params.FindProposer(cur.Timestamp(), members)
 		if err != nil {
 			logging.Console().WithFields(logrus.Fields{
 				"block":     cur,
@@ -174,7 +243,7 @@ func (d *Dpos) FindLIB(bc *core.BlockChain) (newLIB *core.Block) {
 		}
 
 		confirmed[proposer.Hex()] = true
-		if len(confirmed) >= d.consensusSize {
+		if len(confirmed) >= consensusSize {
 			return cur
 		}
 
@@ -190,22 +259,75 @@ func (d *Dpos) FindLIB(bc *core.BlockChain) (newLIB *core.Block) {
 	return lib
 }
 
-func dynastyGenByTime(ts int64) int64 {
-	now := time.Duration(ts) * time.Second
-	return int64(now / DynastyInterval)
-}
-
 // VerifyProposer verifies block proposer.
+//
+// The block is verified against the state of its parent so that blocks on a
+// fork lower than the current tail are checked with the dynasty they were
+// produced in. If the parent is not on the chain yet, the dynasty of the main
+// tail is used.
 func (d *Dpos) VerifyProposer(bc *core.BlockChain, block *core.BlockData) error {
-	// TODO @cl9200 Handling when tail height is higher than block height.
-	tail := bc.MainTailBlock()
+	var proposer common.Address
+	var err error
+	if parent := bc.BlockByHash(block.ParentHash()); parent != nil {
+		proposer, err = d.proposerAfterParent(parent, block)
+	} else {
+		proposer, err = d.proposerByTail(bc.MainTailBlock(), block)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = verifyBlockSign(&proposer, block)
+	if err != nil {
+		logging.WithFields(logrus.Fields{
+			"err":      err,
+			"proposer": proposer,
+			"block":    block,
+		}).Debug("Failed to verify a block sign.")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dpos) proposerAfterParent(parent *core.Block, block *core.BlockData) (common.Address, error) {
+	elapsed := time.Duration(block.Timestamp()-parent.Timestamp()) * time.Second
+	if elapsed <= 0 || elapsed%d.params.BlockInterval != 0 {
+		logging.WithFields(logrus.Fields{
+			"block":     block,
+			"timestamp": block.Timestamp(),
+			"parent":    parent,
+		}).Debug("Invalid block interval.")
+		return common.Address{}, ErrInvalidBlockInterval
+	}
+
+	st, err := parent.State().Clone()
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err":   err,
+			"block": parent,
+		}).Error("Failed to clone state of parent block.")
+		return common.Address{}, err
+	}
+	if err := st.TransitionDynasty(block.Timestamp()); err != nil {
+		logging.WithFields(logrus.Fields{
+			"err":       err,
+			"blockTime": block.Timestamp(),
+			"parent":    parent,
+		}).Debug("Failed to find a block proposer.")
+		return common.Address{}, err
+	}
+	return st.Proposer(), nil
+}
+
+func (d *Dpos) proposerByTail(tail *core.Block, block *core.BlockData) (common.Address, error) {
 	elapsed := time.Duration(block.Timestamp()-tail.Timestamp()) * time.Second
-	if elapsed%BlockInterval != 0 {
+	if elapsed%d.params.BlockInterval != 0 {
 		logging.WithFields(logrus.Fields{
 			"block":     block,
 			"timestamp": block.Timestamp(),
 		}).Debug("Invalid block interval.")
-		return ErrInvalidBlockInterval
+		return common.Address{}, ErrInvalidBlockInterval
 	}
 
 	members, err := tail.State().Dynasty()
@@ -214,30 +336,19 @@ func (d *Dpos) VerifyProposer(bc *core.BlockChain, block *core.BlockData) error
 			"err":   err,
 			"block": tail,
 		}).Error("Failed to get members of dynasty.")
-		return err
+		return common.Address{}, err
 	}
 
-	proposer, err := FindProposer(block.Timestamp(), members)
+	proposer, err := d.params.FindProposer(block.Timestamp(), members)
 	if err != nil {
 		logging.Console().WithFields(logrus.Fields{
 			"err":       err,
 			"blockTime": block.Timestamp(),
 			"members":   members,
 		}).Debug("Failed to find a block proposer.")
-		return err
-	}
-
-	err = verifyBlockSign(&proposer, block)
-	if err != nil {
-		logging.WithFields(logrus.Fields{
-			"err":      err,
-			"proposer": proposer,
-			"block":    block,
-		}).Debug("Failed to verify a block sign.")
-		return err
+		return common.Address{}, err
 	}
-
-	return nil
+	return proposer, nil
 }
 
 func verifyBlockSign(proposer *common.Address, block *core.BlockData) error {
@@ -294,11 +405,11 @@ func recoverSignerFromSignature(alg algorithm.Algorithm, plainText []byte, ciphe
 func (d *Dpos) mintBlock(now time.Time) error {
 	tail := d.bm.TailBlock()
 
-	deadline, err := CheckDeadline(tail, now)
+	deadline, err := d.params.CheckDeadline(tail, now)
 	if err != nil {
 		logging.WithFields(logrus.Fields{
-			"lastSlot": lastMintSlot(now),
-			"nextSlot": nextMintSlot(now),
+			"lastSlot": d.params.lastMintSlot(now),
+			"nextSlot": d.params.nextMintSlot(now),
 			"now":      now,
 			"err":      err,
 		}).Debug("It's not time to mint.")
@@ -329,6 +440,12 @@ func (d *Dpos) mintBlock(now time.Time) error {
 		return ErrInvalidBlockProposer
 	}
 
+	if d.standbyMissedSlots > 0 {
+		if err := d.checkStandby(tail, deadline); err != nil {
+			return err
+		}
+	}
+
 	block, err := d.makeBlock(tail, deadline)
 	if err != nil {
 		logging.Console().WithFields(logrus.Fields{
@@ -348,26 +465,23 @@ func (d *Dpos) mintBlock(now time.Time) error {
 		return err
 	}
 
-	sig, err := crypto.NewSignature(algorithm.SECP256K1)
-	if err != nil {
-		logging.Console().WithFields(logrus.Fields{
-			"err": err,
-		}).Error("Failed to create new crypto signature.")
-		return err
-	}
-	sig.InitSign(d.minerKey)
-
-	err = block.SignThis(sig)
+	err = d.signer.SignBlock(block)
 	if err != nil {
 		logging.Console().WithFields(logrus.Fields{
 			"err": err,
 		}).Error("Failed to sign block.")
 		return err
 	}
+	d.lastMinted = block.Hash()
+	if d.standbyMissedSlots > 0 {
+		if err := d.saveStandbyState(); err != nil {
+			return err
+		}
+	}
 
 	// TODO @cl9200 Return transactions if an error condition.
 
-	time.Sleep(deadline.Sub(time.Now()))
+	d.clock.Sleep(deadline.Sub(d.clock.Now()))
 
 	logging.Console().WithFields(logrus.Fields{
 		"proposer": proposer,
@@ -406,7 +520,7 @@ func (d *Dpos) makeBlock(tail *core.Block, deadline time.Time) (*core.Block, err
 		return nil, err
 	}
 
-	for deadline.Sub(time.Now()) > 0 {
+	for deadline.Sub(d.clock.Now()) > 0 {
 		err = block.BeginBatch()
 		if err != nil {
 			logging.Console().WithFields(logrus.Fields{
@@ -418,6 +532,7 @@ func (d *Dpos) makeBlock(tail *core.Block, deadline time.Time) (*core.Block, err
 
 		tx := d.tm.Pop()
 		if tx == nil {
+			block.RollBack()
 			break
 		}
 		err = block.ExecuteTransaction(tx)
@@ -478,50 +593,182 @@ func (d *Dpos) makeBlock(tail *core.Block, deadline time.Time) (*core.Block, err
 			return nil, err
 		}
 	}
+
+	if err := block.BeginBatch(); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Error("Failed to begin batch of new block.")
+		return nil, err
+	}
+	if err := block.ExecuteReservedTasks(); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Error("Failed to execute reserved tasks.")
+		block.RollBack()
+		return nil, err
+	}
+	if err := block.PayReward(); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Error("Failed to pay block reward.")
+		block.RollBack()
+		return nil, err
+	}
+	if err := block.Commit(); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Error("Failed to commit new block.")
+		return nil, err
+	}
 	return block, nil
 }
 
-func lastMintSlot(ts time.Time) time.Time {
-	now := time.Duration(ts.Unix()) * time.Second
-	last := ((now - time.Second) / BlockInterval) * BlockInterval
-	return time.Unix(int64(last/time.Second), 0)
+// checkStandby decides whether a standby mints in its slot. It takes over after the primary has
+// missed standbyMissedSlots slots in a row, and steps back as soon as a block of the same miner
+// which it did not sign shows up on the chain.
+func (d *Dpos) checkStandby(tail *core.Block, deadline time.Time) error {
+	missed, last, err := d.missedSlots(tail, deadline)
+	if err != nil {
+		return err
+	}
+
+	if last != nil && !byteutils.Equal(last.Hash(), d.lastMinted) {
+		if d.takenOver {
+			logging.Console().WithFields(logrus.Fields{
+				"block": last,
+			}).Warn("Primary miner is back. Standby stops minting.")
+			d.takenOver = false
+			if err := d.saveStandbyState(); err != nil {
+				return err
+			}
+		}
+		// Never sign a block at or below the ones the primary has signed.
+		if d.protection != nil {
+			err := d.protection.Import(&signer.SignedBlock{
+				Address:   d.miner,
+				Height:    last.Height(),
+				Timestamp: last.Timestamp(),
+				Hash:      last.Hash(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.takenOver {
+		return nil
+	}
+	if missed < d.standbyMissedSlots {
+		logging.WithFields(logrus.Fields{
+			"missed":   missed,
+			"deadline": deadline,
+		}).Debug("Primary miner is alive. Standby does not mint.")
+		return ErrPrimaryAlive
+	}
+	logging.Console().WithFields(logrus.Fields{
+		"missed":   missed,
+		"deadline": deadline,
+	}).Warn("Primary miner missed its slots. Standby takes over minting.")
+	d.takenOver = true
+	return d.saveStandbyState()
+}
+
+// loadStandbyState restores whether the standby has taken over and the last block it minted,
+// so that a restarted standby does not take its own blocks for the primary's.
+func (d *Dpos) loadStandbyState() error {
+	value, err := d.bm.Storage().Get(d.standbyStateKey())
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	pbState := new(consensuspb.StandbyState)
+	if err := proto.Unmarshal(value, pbState); err != nil {
+		return err
+	}
+	d.takenOver = pbState.TakenOver
+	d.lastMinted = pbState.LastMinted
+	return nil
 }
 
-func nextMintSlot(ts time.Time) time.Time {
-	now := time.Duration(ts.Unix()) * time.Second
-	next := ((now + BlockInterval - time.Second) / BlockInterval) * BlockInterval
-	return time.Unix(int64(next/time.Second), 0)
+func (d *Dpos) saveStandbyState() error {
+	value, err := proto.Marshal(&consensuspb.StandbyState{
+		TakenOver:  d.takenOver,
+		LastMinted: d.lastMinted,
+	})
+	if err != nil {
+		return err
+	}
+	if stor, ok := d.bm.Storage().(storage.SyncStorage); ok {
+		return stor.PutSync(d.standbyStateKey(), value)
+	}
+	return d.bm.Storage().Put(d.standbyStateKey(), value)
 }
 
-func mintDeadline(ts time.Time) time.Time {
-	// TODO @cl9200 Do we need MaxMintDuration?
-	return nextMintSlot(ts)
+func (d *Dpos) standbyStateKey() []byte {
+	return append([]byte(standbyStateKeyPrefix), d.miner.Bytes()...)
 }
 
-// CheckDeadline gets deadline time of the next block to produce
-func CheckDeadline(tail *core.Block, ts time.Time) (deadline time.Time, err error) {
-	last := lastMintSlot(ts)
-	next := nextMintSlot(ts)
-	if tail.Timestamp() >= next.Unix() {
-		return time.Time{}, ErrBlockMintedInNextSlot
+// missedSlots counts the slots of the miner before the deadline since its last block on the chain.
+// It stops counting once the count reaches standbyMissedSlots. Slots before the first block are not counted.
+func (d *Dpos) missedSlots(tail *core.Block, deadline time.Time) (int, *core.Block, error) {
+	interval := d.params.blockIntervalSec()
+	missed := 0
+	end := deadline.Unix()
+	for b := tail; b != nil && b.Height() > core.GenesisHeight; b = d.bm.BlockByHash(b.ParentHash()) {
+		if b.State().Proposer().Equals(d.miner) {
+			return missed, b, nil
+		}
+		members, err := b.State().Dynasty()
+		if err != nil {
+			return 0, nil, err
+		}
+		for ts := b.Timestamp() + interval; ts < end; ts += interval {
+			proposer, err := d.params.FindProposer(ts, members)
+			if err != nil {
+				return 0, nil, err
+			}
+			if proposer.Equals(d.miner) {
+				missed++
+			}
+			if missed >= d.standbyMissedSlots {
+				return missed, nil, nil
+			}
+		}
+		end = b.Timestamp()
 	}
-	if tail.Timestamp() == last.Unix() {
-		return mintDeadline(ts), nil
+	return missed, nil, nil
+}
+
+func (d *Dpos) updateLivenessMetrics() {
+	liveness, err := d.bm.TailBlock().State().Liveness()
+	if err != nil {
+		logging.WithFields(logrus.Fields{
+			"err": err,
+		}).Debug("Failed to get liveness of proposers.")
+		return
 	}
-	if next.Sub(ts) < MinMintDuration {
-		return mintDeadline(ts), nil
+	for _, l := range liveness {
+		metrics.NewGauge(fmt.Sprintf("med.dpos.liveness.%s.produced", l.Address.Hex())).Update(int64(l.Produced))
+		metrics.NewGauge(fmt.Sprintf("med.dpos.liveness.%s.missed", l.Address.Hex())).Update(int64(l.Missed))
 	}
-	return time.Time{}, ErrWaitingBlockInLastSlot
 }
 
 func (d *Dpos) loop() {
 	logging.Console().Info("Started Dpos Mining.")
-	ticker := time.NewTicker(miningTickInterval)
+	ticker := d.clock.NewTicker(d.params.MiningTickInterval)
 	defer ticker.Stop()
 	for {
 		select {
-		case now := <-ticker.C:
+		case now := <-ticker.C():
 			d.mintBlock(now)
+			d.updateLivenessMetrics()
 		case <-d.quitCh:
 			logging.Console().Info("Stopped Dpos Mining.")
 			return
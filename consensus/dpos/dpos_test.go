@@ -0,0 +1,408 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package dpos_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/signer"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/util/clock"
+	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type simNode struct {
+	dpos *dpos.Dpos
+	bm   *core.BlockManager
+}
+
+func newSimNode(t *testing.T, conf *corepb.Genesis, miner *testutil.AddrKeyPair, clk clock.Clock, opts ...func(*medletpb.Config)) *simNode {
+	privKey, err := miner.PrivKey.Encoded()
+	require.NoError(t, err)
+
+	cfg := medlet.DefaultConfig()
+	cfg.Chain.StartMine = true
+	cfg.Chain.Coinbase = miner.Addr.Hex()
+	cfg.Chain.Miner = miner.Addr.Hex()
+	cfg.Chain.Privkey = byteutils.Bytes2Hex(privKey)
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	d, err := dpos.New(cfg, conf)
+	require.NoError(t, err)
+	d.SetClock(clk)
+	bm, err := core.NewBlockManager(cfg)
+	require.NoError(t, err)
+	tm := core.NewTransactionManager(cfg)
+	require.NoError(t, bm.Setup(conf, stor, nil, d))
+	tm.Setup(nil)
+	require.NoError(t, d.Setup(conf, bm, tm))
+	return &simNode{dpos: d, bm: bm}
+}
+
+// relay pushes tail block of src to dst as if it is received from network.
+func relay(t *testing.T, src, dst *simNode) {
+	tail := src.bm.TailBlock()
+	if dst.bm.BlockByHash(tail.Hash()) != nil {
+		return
+	}
+	relayBlockData(t, tail.GetBlockData(), dst)
+}
+
+func TestSimulateMissedSlots(t *testing.T) {
+	conf, dynasties, _ := testutil.NewTestGenesisConf(t)
+	conf.Meta.DynastySize = 3
+	conf.Consensus.Dpos.Dynasty = conf.Consensus.Dpos.Dynasty[:3]
+	conf.Consensus.Dpos.BlockInterval = 1
+	conf.Consensus.Dpos.DynastyInterval = 6
+	conf.Consensus.Dpos.MinMintDuration = 500
+	conf.Consensus.Dpos.MiningTickInterval = 100
+	params, err := dpos.NewParams(conf)
+	require.NoError(t, err)
+
+	start := time.Unix(1500000000, 0)
+	clk := clock.NewManual(start.Add(-params.MiningTickInterval))
+	core.SetClock(clk)
+	defer core.SetClock(clock.System)
+
+	var nodes []*simNode
+	for _, d := range dynasties[:3] {
+		nodes = append(nodes, newSimNode(t, conf, d, clk))
+	}
+	// The last dynasty member is offline and misses all its slots.
+	online := nodes[:2]
+	offline := dynasties[2].Addr
+	for _, n := range online {
+		n.dpos.Start()
+		defer n.dpos.Stop()
+	}
+	waitFor(t, func() bool { return clk.Tickers() == len(online) })
+
+	members, err := nodes[0].bm.TailBlock().State().Dynasty()
+	require.NoError(t, err)
+
+	// Each slot is minted on the tick at its beginning.
+	const slots = 13
+	ticksPerSlot := int(params.BlockInterval / params.MiningTickInterval)
+	height := nodes[0].bm.TailBlock().Height()
+	missed := 0
+	var lastProduced int64
+	for i := int64(0); i < slots; i++ {
+		for j := 0; j < ticksPerSlot; j++ {
+			clk.Advance(params.MiningTickInterval)
+			waitFor(t, func() bool { return clk.PendingTicks() == 0 })
+			if j > 0 {
+				continue
+			}
+			proposer, err := params.FindProposer(start.Unix()+i, members)
+			require.NoError(t, err)
+			if proposer.Equals(offline) {
+				missed++
+				continue
+			}
+			height++
+			lastProduced = start.Unix() + i
+			waitForHeight(t, online, height)
+		}
+	}
+	require.NotZero(t, missed)
+
+	tail := nodes[0].bm.TailBlock()
+	assert.Equal(t, tail.Hash(), nodes[1].bm.TailBlock().Hash())
+	assert.Equal(t, uint64(slots-missed), tail.Height()-core.GenesisHeight)
+	assert.Equal(t, lastProduced, tail.Timestamp())
+	for b := tail; b.Height() > core.GenesisHeight; b = nodes[0].bm.BlockByHash(b.ParentHash()) {
+		assert.False(t, b.State().Proposer().Equals(offline))
+	}
+
+	// Each miner has recorded its last block before signing it.
+	for i, n := range online {
+		signed, err := signer.NewSlashingProtection(n.bm.Storage()).LastSigned(dynasties[i].Addr)
+		require.NoError(t, err)
+		b := n.bm.BlockByHash(signed.Hash)
+		require.NotNil(t, b)
+		assert.Equal(t, b.Height(), signed.Height)
+		assert.Equal(t, b.Timestamp(), signed.Timestamp)
+		assert.True(t, b.State().Proposer().Equals(dynasties[i].Addr))
+	}
+}
+
+func TestStandbyTakesOver(t *testing.T) {
+	conf, dynasties, _ := testutil.NewTestGenesisConf(t)
+	conf.Meta.DynastySize = 3
+	conf.Consensus.Dpos.Dynasty = conf.Consensus.Dpos.Dynasty[:3]
+	conf.Consensus.Dpos.BlockInterval = 1
+	conf.Consensus.Dpos.DynastyInterval = 6
+	conf.Consensus.Dpos.MinMintDuration = 500
+	conf.Consensus.Dpos.MiningTickInterval = 100
+	params, err := dpos.NewParams(conf)
+	require.NoError(t, err)
+
+	start := time.Unix(1500000000, 0)
+	clk := clock.NewManual(start.Add(-params.MiningTickInterval))
+	core.SetClock(clk)
+	defer core.SetClock(clock.System)
+
+	// The standby shares a remote signer with the primary.
+	secretFile, err := ioutil.TempFile("", "signer_secret")
+	require.NoError(t, err)
+	defer os.Remove(secretFile.Name())
+	_, err = secretFile.WriteString("secret")
+	require.NoError(t, err)
+	require.NoError(t, secretFile.Close())
+
+	certFile, keyFile, cleanup := testutil.NewTestTLSCert(t)
+	defer cleanup()
+
+	signerStor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	server, err := signer.NewServer(dynasties[0].PrivKey, signerStor, []byte("secret"), certFile, keyFile)
+	require.NoError(t, err)
+	require.NoError(t, server.Start("localhost:0"))
+	defer server.Stop()
+	useSigner := func(cfg *medletpb.Config) {
+		cfg.Chain.RemoteSigner = server.Addr()
+		cfg.Chain.RemoteSignerSecretFile = secretFile.Name()
+		cfg.Chain.RemoteSignerCertFile = certFile
+	}
+
+	const missedSlots = 2
+	standbyConf := func(cfg *medletpb.Config) {
+		useSigner(cfg)
+		cfg.Chain.StandbyMissedSlots = missedSlots
+	}
+	primary := newSimNode(t, conf, dynasties[0], clk, useSigner)
+	standby := newSimNode(t, conf, dynasties[0], clk, standbyConf)
+	others := []*simNode{
+		newSimNode(t, conf, dynasties[1], clk),
+		newSimNode(t, conf, dynasties[2], clk),
+	}
+	online := append([]*simNode{primary, standby}, others...)
+	for _, n := range online {
+		n.dpos.Start()
+	}
+	for _, n := range others {
+		defer n.dpos.Stop()
+	}
+	defer standby.dpos.Stop()
+	waitFor(t, func() bool { return clk.Tickers() == len(online) })
+
+	members, err := primary.bm.TailBlock().State().Dynasty()
+	require.NoError(t, err)
+	ticksPerSlot := int(params.BlockInterval / params.MiningTickInterval)
+	height := primary.bm.TailBlock().Height()
+	slot := start.Unix()
+
+	// runSlots runs slots and returns how many slots of the primary's key are left empty.
+	runSlots := func(n int, primaryOnline bool) (missed int) {
+		for i := 0; i < n; i, slot = i+1, slot+1 {
+			proposer, err := params.FindProposer(slot, members)
+			require.NoError(t, err)
+			minted := !proposer.Equals(dynasties[0].Addr) || primaryOnline || missed >= missedSlots
+			for j := 0; j < ticksPerSlot; j++ {
+				clk.Advance(params.MiningTickInterval)
+				waitFor(t, func() bool { return clk.PendingTicks() == 0 })
+			}
+			if !minted {
+				missed++
+				continue
+			}
+			height++
+			waitForHeight(t, online, height)
+		}
+		return missed
+	}
+
+	// While the primary mints, the standby does not.
+	assert.Zero(t, runSlots(6, true))
+	assert.False(t, standby.dpos.TakenOver())
+	signed, err := signer.NewSlashingProtection(signerStor).LastSigned(dynasties[0].Addr)
+	require.NoError(t, err)
+	b := standby.bm.BlockByHash(signed.Hash)
+	require.NotNil(t, b)
+	assert.True(t, b.State().Proposer().Equals(dynasties[0].Addr))
+
+	// The standby mints after the primary has missed its slots.
+	primary.dpos.Stop()
+	online = online[1:]
+	waitFor(t, func() bool { return clk.Tickers() == len(online) })
+	failover := slot
+	assert.Equal(t, missedSlots, runSlots(12, false))
+
+	taken := 0
+	for b := standby.bm.TailBlock(); b.Timestamp() >= failover; b = standby.bm.BlockByHash(b.ParentHash()) {
+		if b.State().Proposer().Equals(dynasties[0].Addr) {
+			taken++
+		}
+	}
+	assert.Equal(t, 12/len(members)-missedSlots, taken)
+	assert.True(t, standby.dpos.TakenOver())
+
+	// The takeover survives a restart of the standby.
+	cfg := medlet.DefaultConfig()
+	cfg.Chain.StartMine = true
+	cfg.Chain.Coinbase = dynasties[0].Addr.Hex()
+	cfg.Chain.Miner = dynasties[0].Addr.Hex()
+	standbyConf(cfg)
+	restarted, err := dpos.New(cfg, conf)
+	require.NoError(t, err)
+	require.NoError(t, restarted.Setup(conf, standby.bm, core.NewTransactionManager(cfg)))
+	assert.True(t, restarted.TakenOver())
+}
+
+func TestStandbyRequiresRemoteSigner(t *testing.T) {
+	conf, dynasties, _ := testutil.NewTestGenesisConf(t)
+	privKey, err := dynasties[0].PrivKey.Encoded()
+	require.NoError(t, err)
+
+	cfg := medlet.DefaultConfig()
+	cfg.Chain.StartMine = true
+	cfg.Chain.Coinbase = dynasties[0].Addr.Hex()
+	cfg.Chain.Miner = dynasties[0].Addr.Hex()
+	cfg.Chain.Privkey = byteutils.Bytes2Hex(privKey)
+	cfg.Chain.StandbyMissedSlots = 2
+	_, err = dpos.New(cfg, conf)
+	assert.Equal(t, dpos.ErrStandbyWithoutRemoteSigner, err)
+}
+
+// waitForHeight relays blocks among nodes until all of them reach the height.
+func waitForHeight(t *testing.T, nodes []*simNode, height uint64) {
+	waitFor(t, func() bool {
+		for _, src := range nodes {
+			for _, dst := range nodes {
+				if src != dst {
+					relay(t, src, dst)
+				}
+			}
+		}
+		for _, n := range nodes {
+			if n.bm.TailBlock().Height() < height {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("timed out waiting for condition")
+}
+
+func newForkBlock(t *testing.T, parent *core.Block, ts int64, dynasties testutil.Dynasties) *core.BlockData {
+	block, err := core.NewBlock(testutil.ChainID, dynasties[0].Addr, parent)
+	require.NoError(t, err)
+	require.NoError(t, block.SetTimestamp(ts))
+	require.NoError(t, block.State().TransitionDynasty(ts))
+	require.NoError(t, block.ExecuteAll())
+	require.NoError(t, block.Seal())
+
+	proposer := block.State().Proposer()
+	var key signature.PrivateKey
+	for _, d := range dynasties {
+		if d.Addr.Equals(proposer) {
+			key = d.PrivKey
+		}
+	}
+	require.NotNil(t, key)
+	sig, err := crypto.NewSignature(algorithm.SECP256K1)
+	require.NoError(t, err)
+	sig.InitSign(key)
+	require.NoError(t, block.SignThis(sig))
+	return block.GetBlockData()
+}
+
+func TestForkChoice(t *testing.T) {
+	conf, dynasties, _ := testutil.NewTestGenesisConf(t)
+	conf.Meta.DynastySize = 3
+	conf.Consensus.Dpos.Dynasty = conf.Consensus.Dpos.Dynasty[:3]
+	conf.Consensus.Dpos.BlockInterval = 1
+	conf.Consensus.Dpos.DynastyInterval = 60
+	conf.Consensus.Dpos.MinMintDuration = 500
+	conf.Consensus.Dpos.MiningTickInterval = 100
+
+	// Both forks have the same height. The first one is produced by two
+	// proposers and the second one by a single proposer.
+	src := newSimNode(t, conf, dynasties[0], clock.System)
+	genesis := src.bm.TailBlock()
+	g := genesis.Timestamp()
+	var forks [2][]*core.BlockData
+	for i, slots := range [][]int64{{1, 2}, {4, 7}} {
+		parent := genesis
+		for _, slot := range slots {
+			bd := newForkBlock(t, parent, g+slot, dynasties)
+			require.NoError(t, src.bm.PushBlockData(bd))
+			parent = src.bm.BlockByHash(bd.Hash())
+			forks[i] = append(forks[i], bd)
+		}
+	}
+	expected := forks[0][1].Hash()
+	assert.Equal(t, expected, src.bm.TailBlock().Hash())
+
+	// The choice does not depend on the order of arrival.
+	dst := newSimNode(t, conf, dynasties[0], clock.System)
+	for _, bd := range append(forks[1], forks[0]...) {
+		relayBlockData(t, bd, dst)
+	}
+	assert.Equal(t, expected, dst.bm.TailBlock().Hash())
+
+	// Forks with the same height and the same number of proposers are
+	// chosen by the lowest hash.
+	a := newForkBlock(t, genesis, g+10, dynasties)
+	b := newForkBlock(t, genesis, g+11, dynasties)
+	expected = a.Hash()
+	if byteutils.Bytes2Hex(b.Hash()) < byteutils.Bytes2Hex(a.Hash()) {
+		expected = b.Hash()
+	}
+	for _, order := range [][]*core.BlockData{{a, b}, {b, a}} {
+		n := newSimNode(t, conf, dynasties[0], clock.System)
+		for _, bd := range order {
+			relayBlockData(t, bd, n)
+		}
+		assert.Equal(t, expected, n.bm.TailBlock().Hash())
+	}
+}
+
+func relayBlockData(t *testing.T, bd *core.BlockData, dst *simNode) {
+	pb, err := bd.ToProto()
+	require.NoError(t, err)
+	received := new(core.BlockData)
+	require.NoError(t, received.FromProto(pb))
+	require.NoError(t, dst.bm.PushBlockData(received))
+}
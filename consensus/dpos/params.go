@@ -0,0 +1,160 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package dpos
+
+import (
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Params is a set of consensus properties of a chain
+type Params struct {
+	BlockInterval      time.Duration
+	DynastyInterval    time.Duration
+	MinMintDuration    time.Duration
+	MiningTickInterval time.Duration
+}
+
+// DefaultParams returns default consensus properties
+func DefaultParams() *Params {
+	return &Params{
+		BlockInterval:      DefaultBlockInterval,
+		DynastyInterval:    DefaultDynastyInterval,
+		MinMintDuration:    DefaultMinMintDuration,
+		MiningTickInterval: DefaultMiningTickInterval,
+	}
+}
+
+// NewParams returns consensus properties of genesis. Omitted fields are set to default values.
+// Dynasty size is not a consensus property since it is a chain parameter which governance can change,
+// but a dynasty interval must have a slot for each member of the genesis dynasty.
+func NewParams(genesis *corepb.Genesis) (*Params, error) {
+	params := DefaultParams()
+	if genesis == nil {
+		return params, nil
+	}
+	dynastySize := int(genesis.GetMeta().GetDynastySize())
+	if dynastySize <= 0 {
+		return nil, ErrInvalidDynastySize
+	}
+	conf := genesis.GetConsensus().GetDpos()
+	if conf.GetBlockInterval() != 0 {
+		params.BlockInterval = time.Duration(conf.GetBlockInterval()) * time.Second
+	}
+	if conf.GetDynastyInterval() != 0 {
+		params.DynastyInterval = time.Duration(conf.GetDynastyInterval()) * time.Second
+	}
+	if conf.GetMinMintDuration() != 0 {
+		params.MinMintDuration = time.Duration(conf.GetMinMintDuration()) * time.Millisecond
+	}
+	if conf.GetMiningTickInterval() != 0 {
+		params.MiningTickInterval = time.Duration(conf.GetMiningTickInterval()) * time.Millisecond
+	}
+	if err := params.verify(dynastySize); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"params": params,
+			"err":    err,
+		}).Error("Invalid consensus parameters.")
+		return nil, err
+	}
+	return params, nil
+}
+
+func (p *Params) verify(dynastySize int) error {
+	if p.BlockInterval < time.Second || p.BlockInterval%time.Second != 0 {
+		return ErrInvalidConsensusParams
+	}
+	if p.DynastyInterval%p.BlockInterval != 0 || int(p.DynastyInterval/p.BlockInterval) < dynastySize {
+		return ErrInvalidConsensusParams
+	}
+	if p.MinMintDuration <= 0 || p.MinMintDuration >= p.BlockInterval {
+		return ErrInvalidConsensusParams
+	}
+	if p.MiningTickInterval <= 0 || p.MiningTickInterval > p.MinMintDuration {
+		return ErrInvalidConsensusParams
+	}
+	return nil
+}
+
+func (p *Params) blockIntervalSec() int64 {
+	return int64(p.BlockInterval / time.Second)
+}
+
+func (p *Params) dynastyIntervalSec() int64 {
+	return int64(p.DynastyInterval / time.Second)
+}
+
+// FindProposer return proposer at the given time
+func (p *Params) FindProposer(ts int64, miners []*common.Address) (common.Address, error) {
+	now := time.Duration(ts) * time.Second
+	if now%p.BlockInterval != 0 {
+		return common.Address{}, ErrInvalidBlockForgeTime
+	}
+	offsetInDynastyInterval := now % p.DynastyInterval
+	offsetInDynasty := int(offsetInDynastyInterval/p.BlockInterval) % len(miners)
+
+	if int(offsetInDynasty) >= len(miners) {
+		logging.WithFields(logrus.Fields{
+			"offset": offsetInDynasty,
+			"miners": len(miners),
+		}).Error("No proposer selected for this turn.")
+		return common.Address{}, ErrFoundNilProposer
+	}
+	return *(miners[offsetInDynasty]), nil
+}
+
+func (p *Params) dynastyGenByTime(ts int64) int64 {
+	now := time.Duration(ts) * time.Second
+	return int64(now / p.DynastyInterval)
+}
+
+func (p *Params) lastMintSlot(ts time.Time) time.Time {
+	now := time.Duration(ts.Unix()) * time.Second
+	last := ((now - time.Second) / p.BlockInterval) * p.BlockInterval
+	return time.Unix(int64(last/time.Second), 0)
+}
+
+func (p *Params) nextMintSlot(ts time.Time) time.Time {
+	now := time.Duration(ts.Unix()) * time.Second
+	next := ((now + p.BlockInterval - time.Second) / p.BlockInterval) * p.BlockInterval
+	return time.Unix(int64(next/time.Second), 0)
+}
+
+func (p *Params) mintDeadline(ts time.Time) time.Time {
+	// TODO @cl9200 Do we need MaxMintDuration?
+	return p.nextMintSlot(ts)
+}
+
+// CheckDeadline gets deadline time of the next block to produce
+func (p *Params) CheckDeadline(tail *core.Block, ts time.Time) (deadline time.Time, err error) {
+	last := p.lastMintSlot(ts)
+	next := p.nextMintSlot(ts)
+	if tail.Timestamp() >= next.Unix() {
+		return time.Time{}, ErrBlockMintedInNextSlot
+	}
+	if tail.Timestamp() == last.Unix() {
+		return p.mintDeadline(ts), nil
+	}
+	if next.Sub(ts) < p.MinMintDuration {
+		return p.mintDeadline(ts), nil
+	}
+	return time.Time{}, ErrWaitingBlockInLastSlot
+}
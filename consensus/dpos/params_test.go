@@ -0,0 +1,91 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package dpos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewParams(t *testing.T) {
+	conf, _, _ := testutil.NewTestGenesisConf(t)
+	params, err := dpos.NewParams(conf)
+	require.NoError(t, err)
+	assert.Equal(t, dpos.DefaultParams(), params)
+
+	conf.Meta.DynastySize = 30
+	conf.Consensus.Dpos.BlockInterval = 1
+	conf.Consensus.Dpos.DynastyInterval = 60
+	conf.Consensus.Dpos.MinMintDuration = 500
+	conf.Consensus.Dpos.MiningTickInterval = 100
+	params, err = dpos.NewParams(conf)
+	require.NoError(t, err)
+	assert.Equal(t, &dpos.Params{
+		BlockInterval:      time.Second,
+		DynastyInterval:    time.Minute,
+		MinMintDuration:    500 * time.Millisecond,
+		MiningTickInterval: 100 * time.Millisecond,
+	}, params)
+
+	invalid := []func(c *corepb.GenesisConsensusDpos){
+		func(c *corepb.GenesisConsensusDpos) { c.BlockInterval = 7 },
+		func(c *corepb.GenesisConsensusDpos) { c.DynastyInterval = 29 },
+		func(c *corepb.GenesisConsensusDpos) { c.MinMintDuration = 1000 },
+		func(c *corepb.GenesisConsensusDpos) { c.MiningTickInterval = 600 },
+	}
+	for _, fn := range invalid {
+		c := *conf.Consensus.Dpos
+		fn(&c)
+		_, err := dpos.NewParams(&corepb.Genesis{
+			Meta:      conf.Meta,
+			Consensus: &corepb.GenesisConsensus{Dpos: &c},
+		})
+		assert.Equal(t, dpos.ErrInvalidConsensusParams, err)
+	}
+
+	conf.Meta.DynastySize = 0
+	_, err = dpos.NewParams(conf)
+	assert.Equal(t, dpos.ErrInvalidDynastySize, err)
+}
+
+func TestCheckDeadline(t *testing.T) {
+	genesis, _, _ := testutil.NewTestGenesisBlock(t)
+	params := &dpos.Params{
+		BlockInterval:      time.Second,
+		DynastyInterval:    time.Minute,
+		MinMintDuration:    500 * time.Millisecond,
+		MiningTickInterval: 100 * time.Millisecond,
+	}
+	now := time.Unix(genesis.Timestamp()+10, 0)
+	deadline, err := params.CheckDeadline(genesis, now)
+	require.NoError(t, err)
+	assert.Equal(t, genesis.Timestamp()+10, deadline.Unix())
+
+	members, err := genesis.State().Dynasty()
+	require.NoError(t, err)
+	for i := int64(0); i < 3; i++ {
+		ts := genesis.Timestamp() + i
+		proposer, err := params.FindProposer(ts, members)
+		require.NoError(t, err)
+		assert.Equal(t, *members[int(ts%60)%len(members)], proposer)
+	}
+}
@@ -9,6 +9,8 @@ It is generated from these files:
 
 It has these top-level messages:
 	ConsensusState
+	Liveness
+	StandbyState
 */
 package consensuspb
 
@@ -28,11 +30,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type ConsensusState struct {
-	DynastyRoot []byte `protobuf:"bytes,1,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
-	DynastySize int64  `protobuf:"varint,2,opt,name=dynasty_size,json=dynastySize,proto3" json:"dynasty_size,omitempty"`
-	Proposer    []byte `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	Timestamp   int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	StartTime   int64  `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	DynastyRoot      []byte `protobuf:"bytes,1,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
+	DynastySize      int64  `protobuf:"varint,2,opt,name=dynasty_size,json=dynastySize,proto3" json:"dynasty_size,omitempty"`
+	Proposer         []byte `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Timestamp        int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	StartTime        int64  `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	LivenessRoot     []byte `protobuf:"bytes,6,opt,name=liveness_root,json=livenessRoot,proto3" json:"liveness_root,omitempty"`
+	PrevLivenessRoot []byte `protobuf:"bytes,7,opt,name=prev_liveness_root,json=prevLivenessRoot,proto3" json:"prev_liveness_root,omitempty"`
 }
 
 func (m *ConsensusState) Reset()                    { *m = ConsensusState{} }
@@ -75,23 +79,105 @@ func (m *ConsensusState) GetStartTime() int64 {
 	return 0
 }
 
+func (m *ConsensusState) GetLivenessRoot() []byte {
+	if m != nil {
+		return m.LivenessRoot
+	}
+	return nil
+}
+
+func (m *ConsensusState) GetPrevLivenessRoot() []byte {
+	if m != nil {
+		return m.PrevLivenessRoot
+	}
+	return nil
+}
+
+type Liveness struct {
+	Address  []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Produced uint64 `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`
+	Missed   uint64 `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (m *Liveness) Reset()                    { *m = Liveness{} }
+func (m *Liveness) String() string            { return proto.CompactTextString(m) }
+func (*Liveness) ProtoMessage()               {}
+func (*Liveness) Descriptor() ([]byte, []int) { return fileDescriptorConsensus, []int{1} }
+
+func (m *Liveness) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Liveness) GetProduced() uint64 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
+}
+
+func (m *Liveness) GetMissed() uint64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+type StandbyState struct {
+	// Whether the standby has taken over minting from the primary.
+	TakenOver bool `protobuf:"varint,1,opt,name=taken_over,json=takenOver,proto3" json:"taken_over,omitempty"`
+	// Hash of the last block minted by the standby.
+	LastMinted []byte `protobuf:"bytes,2,opt,name=last_minted,json=lastMinted,proto3" json:"last_minted,omitempty"`
+}
+
+func (m *StandbyState) Reset()                    { *m = StandbyState{} }
+func (m *StandbyState) String() string            { return proto.CompactTextString(m) }
+func (*StandbyState) ProtoMessage()               {}
+func (*StandbyState) Descriptor() ([]byte, []int) { return fileDescriptorConsensus, []int{2} }
+
+func (m *StandbyState) GetTakenOver() bool {
+	if m != nil {
+		return m.TakenOver
+	}
+	return false
+}
+
+func (m *StandbyState) GetLastMinted() []byte {
+	if m != nil {
+		return m.LastMinted
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConsensusState)(nil), "consensuspb.ConsensusState")
+	proto.RegisterType((*Liveness)(nil), "consensuspb.Liveness")
+	proto.RegisterType((*StandbyState)(nil), "consensuspb.StandbyState")
 }
 
 func init() { proto.RegisterFile("consensus.proto", fileDescriptorConsensus) }
 
 var fileDescriptorConsensus = []byte{
-	// 170 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xcf, 0x31, 0x0e, 0xc2, 0x30,
-	0x0c, 0x05, 0x50, 0x85, 0x02, 0x02, 0xb7, 0x02, 0x29, 0x53, 0x84, 0x40, 0x2a, 0x4c, 0x9d, 0x58,
-	0x38, 0x02, 0x37, 0x68, 0xd9, 0xab, 0x14, 0x3c, 0x64, 0x68, 0x1d, 0xc5, 0x66, 0x68, 0x2f, 0xc5,
-	0x15, 0x11, 0x01, 0x0a, 0xe3, 0x7f, 0xfe, 0xb6, 0x64, 0x58, 0x5f, 0xa9, 0x63, 0xec, 0xf8, 0xce,
-	0x47, 0x1f, 0x48, 0x48, 0xa7, 0x23, 0xf8, 0xe6, 0xf0, 0x50, 0xb0, 0x3a, 0x7f, 0x73, 0x25, 0x56,
-	0x50, 0xef, 0x21, 0xbb, 0xf5, 0x9d, 0x65, 0xe9, 0xeb, 0x40, 0x24, 0x46, 0xe5, 0xaa, 0xc8, 0xca,
-	0xf4, 0x63, 0x25, 0x91, 0xfc, 0x57, 0xd8, 0x0d, 0x68, 0x26, 0xb9, 0x2a, 0x92, 0xb1, 0x52, 0xb9,
-	0x01, 0xf5, 0x06, 0x16, 0x3e, 0x90, 0x27, 0xc6, 0x60, 0x92, 0x78, 0x61, 0xcc, 0x7a, 0x0b, 0x4b,
-	0x71, 0x2d, 0xb2, 0xd8, 0xd6, 0x9b, 0x69, 0xdc, 0xfd, 0x81, 0xde, 0x01, 0xb0, 0xd8, 0x20, 0xf5,
-	0x8b, 0xcc, 0xec, 0x3d, 0x8e, 0x72, 0x71, 0x2d, 0x36, 0xf3, 0xf8, 0xc5, 0xe9, 0x19, 0x00, 0x00,
-	0xff, 0xff, 0x20, 0x0a, 0x6c, 0x93, 0xd8, 0x00, 0x00, 0x00,
+	// 294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xbb, 0x4f, 0x03, 0x31,
+	0x0c, 0xc6, 0xd5, 0x07, 0x7d, 0xb8, 0xc7, 0x43, 0x19, 0xd0, 0x09, 0x51, 0x51, 0xca, 0xd2, 0x01,
+	0xb1, 0xf0, 0x27, 0xb0, 0xf2, 0x90, 0x52, 0x06, 0xb6, 0x53, 0xda, 0x78, 0x88, 0xe8, 0x25, 0x51,
+	0xec, 0x9e, 0xd4, 0x8e, 0xfc, 0xe5, 0xa8, 0xee, 0xdd, 0x51, 0xc6, 0xef, 0xe7, 0x2f, 0xb6, 0xbf,
+	0x18, 0x2e, 0xd7, 0xc1, 0x13, 0x7a, 0xda, 0xd2, 0x53, 0x4c, 0x81, 0x83, 0x9a, 0xb4, 0x20, 0xae,
+	0xe6, 0x3f, 0x5d, 0xb8, 0x78, 0x69, 0xf4, 0x92, 0x0d, 0xa3, 0xba, 0x87, 0xcc, 0xee, 0xbc, 0x21,
+	0xde, 0x15, 0x29, 0x04, 0xce, 0x3b, 0xb3, 0xce, 0x22, 0xd3, 0x93, 0x9a, 0xe9, 0x10, 0xf8, 0xd4,
+	0x42, 0x6e, 0x8f, 0x79, 0x77, 0xd6, 0x59, 0xf4, 0x5a, 0xcb, 0xd2, 0xed, 0x51, 0xdd, 0xc0, 0x28,
+	0xa6, 0x10, 0x03, 0x61, 0xca, 0x7b, 0xd2, 0xa1, 0xd5, 0xea, 0x16, 0xc6, 0xec, 0x4a, 0x24, 0x36,
+	0x65, 0xcc, 0xfb, 0xf2, 0xf6, 0x0f, 0xa8, 0x29, 0x00, 0xb1, 0x49, 0x5c, 0x1c, 0x50, 0x7e, 0x76,
+	0x2c, 0x0b, 0xf9, 0x74, 0x25, 0xaa, 0x07, 0x38, 0xdf, 0xb8, 0x0a, 0x3d, 0x12, 0x1d, 0xf7, 0x1b,
+	0x48, 0xf7, 0xac, 0x81, 0xb2, 0xe0, 0x23, 0xa8, 0x98, 0xb0, 0x2a, 0xfe, 0x3b, 0x87, 0xe2, 0xbc,
+	0x3a, 0x54, 0x5e, 0x4f, 0xdc, 0xf3, 0x2f, 0x18, 0x35, 0x5a, 0xe5, 0x30, 0x34, 0xd6, 0x26, 0x24,
+	0xaa, 0x83, 0x37, 0xb2, 0x4e, 0x64, 0xb7, 0x6b, 0xb4, 0x12, 0xb8, 0xaf, 0x5b, 0xad, 0xae, 0x61,
+	0x50, 0x3a, 0x22, 0xb4, 0x92, 0xb5, 0xaf, 0x6b, 0x35, 0x7f, 0x87, 0x6c, 0xc9, 0xc6, 0xdb, 0xd5,
+	0xee, 0xf8, 0xb7, 0x53, 0x00, 0x36, 0xdf, 0xe8, 0x8b, 0x50, 0x61, 0x92, 0x01, 0x23, 0x3d, 0x16,
+	0xf2, 0x51, 0x61, 0x52, 0x77, 0x30, 0xd9, 0x18, 0xe2, 0xa2, 0x74, 0x9e, 0xeb, 0x29, 0x99, 0x86,
+	0x03, 0x7a, 0x13, 0xb2, 0x1a, 0xc8, 0x09, 0x9f, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xee, 0x2f,
+	0x87, 0xca, 0xd5, 0x01, 0x00, 0x00,
 }
@@ -20,16 +20,17 @@ import (
 	"time"
 )
 
-// Consensus properties.
+// Default consensus properties used when genesis does not specify them.
 const (
-	BlockInterval   = 15 * time.Second
-	DynastyInterval = 210 * BlockInterval
-	DynastySize     = 21
-	MinMintDuration = 2 * time.Second
-
-	miningTickInterval = time.Second
+	DefaultBlockInterval      = 15 * time.Second
+	DefaultDynastyInterval    = 210 * DefaultBlockInterval
+	DefaultDynastySize        = 21
+	DefaultMinMintDuration    = 2 * time.Second
+	DefaultMiningTickInterval = time.Second
 )
 
+const standbyStateKeyPrefix = "dpos_standby_state_"
+
 // Error types of dpos package.
 var (
 	ErrInvalidBlockInterval         = errors.New("invalid block interval")
@@ -40,4 +41,7 @@ var (
 	ErrBlockMintedInNextSlot        = errors.New("cannot mint block now, there is a block minted in current slot")
 	ErrWaitingBlockInLastSlot       = errors.New("cannot mint block now, waiting for last block")
 	ErrInvalidDynastySize           = errors.New("invalid dynasty size")
+	ErrInvalidConsensusParams       = errors.New("invalid consensus parameters")
+	ErrPrimaryAlive                 = errors.New("cannot mint block now, primary miner is alive")
+	ErrStandbyWithoutRemoteSigner   = errors.New("standby miner requires a remote signer shared with the primary")
 )
@@ -0,0 +1,193 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package poa
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/consensus/poa/pb"
+	"github.com/medibloc/go-medibloc/core"
+)
+
+// ConsensusState represents state of validators which propose blocks in turn.
+// Validators are replaced by chain parameters when an epoch expires.
+type ConsensusState struct {
+	validators []common.Address
+	proposer   common.Address
+	startTime  int64
+	timestamp  int64
+
+	params *Params
+}
+
+// NewConsensusState returns new ConsensusState instance
+func NewConsensusState(params *Params) *ConsensusState {
+	return &ConsensusState{params: params}
+}
+
+// LoadConsensusState returns consensus state made from root bytes
+func LoadConsensusState(rootBytes []byte, params *Params) (*ConsensusState, error) {
+	pb := new(poapb.ConsensusState)
+	if err := proto.Unmarshal(rootBytes, pb); err != nil {
+		return nil, err
+	}
+	cs := NewConsensusState(params)
+	if err := cs.FromProto(pb); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// Timestamp returns timestamp
+func (cs *ConsensusState) Timestamp() int64 {
+	return cs.timestamp
+}
+
+// Proposer returns proposer
+func (cs *ConsensusState) Proposer() common.Address {
+	return cs.proposer
+}
+
+// InitDynasty sets validators of a new epoch in the given order
+func (cs *ConsensusState) InitDynasty(miners []*common.Address, dynastySize int, startTime int64) error {
+	if len(miners) == 0 {
+		return ErrNoValidators
+	}
+	validators := make([]common.Address, len(miners))
+	for i, m := range miners {
+		validators[i] = *m
+	}
+	proposer, err := cs.params.FindProposer(startTime, validators)
+	if err != nil && err != ErrInvalidBlockForgeTime {
+		return err
+	}
+	cs.validators = validators
+	cs.proposer = proposer
+	cs.startTime = startTime
+	cs.timestamp = startTime
+	return nil
+}
+
+// Dynasty returns validators
+func (cs *ConsensusState) Dynasty() ([]*common.Address, error) {
+	members := make([]*common.Address, len(cs.validators))
+	for i := range cs.validators {
+		v := cs.validators[i]
+		members[i] = &v
+	}
+	return members, nil
+}
+
+// DynastySize returns the number of validators
+func (cs *ConsensusState) DynastySize() int {
+	return len(cs.validators)
+}
+
+// Liveness returns nothing since validators are not elected
+func (cs *ConsensusState) Liveness() ([]*core.ProposerLiveness, error) {
+	return nil, nil
+}
+
+// PrevLiveness returns nothing since validators are not elected
+func (cs *ConsensusState) PrevLiveness() ([]*core.ProposerLiveness, error) {
+	return nil, nil
+}
+
+// GetNextStateAfterGenesis returns consensus state after genesis block
+func (cs *ConsensusState) GetNextStateAfterGenesis(timestamp int64) (core.ConsensusState, error) {
+	proposer, err := cs.params.FindProposer(timestamp, cs.validators)
+	if err != nil {
+		return nil, err
+	}
+	return &ConsensusState{
+		validators: cs.validators,
+		proposer:   proposer,
+		startTime:  timestamp,
+		timestamp:  timestamp,
+		params:     cs.params,
+	}, nil
+}
+
+// GetNextStateAfter returns consensus state after certain amount of time
+func (cs *ConsensusState) GetNextStateAfter(elapsedTime int64) (core.ConsensusState, error) {
+	if elapsedTime < 0 {
+		return nil, ErrInvalidBlockForgeTime
+	}
+	timestamp := cs.timestamp + elapsedTime
+	if cs.startTime+cs.params.epochIntervalSec() <= timestamp {
+		return nil, core.ErrDynastyExpired
+	}
+	proposer, err := cs.params.FindProposer(timestamp, cs.validators)
+	if err != nil {
+		return nil, err
+	}
+	return &ConsensusState{
+		validators: cs.validators,
+		proposer:   proposer,
+		startTime:  cs.startTime,
+		timestamp:  timestamp,
+		params:     cs.params,
+	}, nil
+}
+
+// Clone clone states
+func (cs *ConsensusState) Clone() (core.ConsensusState, error) {
+	return &ConsensusState{
+		validators: cs.validators,
+		proposer:   cs.proposer,
+		startTime:  cs.startTime,
+		timestamp:  cs.timestamp,
+		params:     cs.params,
+	}, nil
+}
+
+// RootBytes returns marshalled consensus state
+func (cs *ConsensusState) RootBytes() ([]byte, error) {
+	pb, err := cs.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// ToProto converts ConsensusState to poapb.ConsensusState
+func (cs *ConsensusState) ToProto() (proto.Message, error) {
+	validators := make([][]byte, len(cs.validators))
+	for i, v := range cs.validators {
+		validators[i] = v.Bytes()
+	}
+	return &poapb.ConsensusState{
+		Validators: validators,
+		Proposer:   cs.proposer.Bytes(),
+		StartTime:  cs.startTime,
+		Timestamp:  cs.timestamp,
+	}, nil
+}
+
+// FromProto converts poapb.ConsensusState to ConsensusState
+func (cs *ConsensusState) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*poapb.ConsensusState); ok {
+		cs.validators = make([]common.Address, len(msg.Validators))
+		for i, v := range msg.Validators {
+			cs.validators[i] = common.BytesToAddress(v)
+		}
+		cs.proposer = common.BytesToAddress(msg.Proposer)
+		cs.startTime = msg.StartTime
+		cs.timestamp = msg.Timestamp
+		return nil
+	}
+	return ErrInvalidProtoToConsensusState
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package poa_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/consensus/poa"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const genesisTimestamp = 1500000000
+
+func newTestPoa(t *testing.T, conf *corepb.Genesis) *poa.Poa {
+	cfg := medlet.DefaultConfig()
+	cfg.Chain.Consensus = "poa"
+	p, err := poa.New(cfg, conf)
+	require.NoError(t, err)
+	return p
+}
+
+func newTestGenesisConf(t *testing.T) (*corepb.Genesis, testutil.Dynasties) {
+	conf, dynasties, _ := testutil.NewTestGenesisConf(t)
+	conf.ChainParams.GenesisTimestamp = genesisTimestamp
+	conf.ChainParams.Validators = conf.Consensus.Dpos.Dynasty
+	conf.Consensus.Poa = &corepb.GenesisConsensusPoa{
+		BlockInterval: 2,
+		EpochInterval: 100,
+	}
+	return conf, dynasties
+}
+
+func newTestGenesis(t *testing.T, conf *corepb.Genesis) *core.Block {
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	genesis, err := core.NewGenesisBlock(conf, newTestPoa(t, conf), stor)
+	require.NoError(t, err)
+	return genesis
+}
+
+func TestFindProposer(t *testing.T) {
+	validators := []common.Address{
+		common.HexToAddress("02fc22ea22d02fc2469f5ec8fab44bc3de42dda2bf9ebc0c0055a9eb7df579056c"),
+		common.HexToAddress("03528fa3684218f32c9fd7726a2839cff3ddef49d89bf4904af11bc12335f7c939"),
+		common.HexToAddress("03e7b794e1de1851b52ab0b0b995cc87558963265a7b26630f26ea8bb9131a7e21"),
+	}
+	params := poa.DefaultParams()
+	interval := int64(params.BlockInterval / time.Second)
+	for i := int64(0); i < 6; i++ {
+		proposer, err := params.FindProposer(genesisTimestamp+i*interval, validators)
+		require.NoError(t, err)
+		assert.Equal(t, validators[(genesisTimestamp/interval+i)%3], proposer)
+	}
+	_, err := params.FindProposer(genesisTimestamp+1, validators)
+	assert.Equal(t, poa.ErrInvalidBlockForgeTime, err)
+	_, err = params.FindProposer(genesisTimestamp, nil)
+	assert.Equal(t, poa.ErrNoValidators, err)
+}
+
+func TestLoadConsensusState(t *testing.T) {
+	conf, _ := newTestGenesisConf(t)
+	genesis := newTestGenesis(t, conf)
+
+	params, err := poa.NewParams(conf)
+	require.NoError(t, err)
+	cs := poa.NewConsensusState(params)
+	members, err := genesis.State().Dynasty()
+	require.NoError(t, err)
+	require.NoError(t, cs.InitDynasty(members, len(members), genesisTimestamp))
+	root1, err := cs.RootBytes()
+	require.NoError(t, err)
+	newCs, err := poa.LoadConsensusState(root1, params)
+	require.NoError(t, err)
+	root2, err := newCs.RootBytes()
+	require.NoError(t, err)
+	assert.Equal(t, root1, root2)
+	loaded, err := newCs.Dynasty()
+	require.NoError(t, err)
+	assert.Equal(t, members, loaded)
+}
+
+func TestValidatorsReplacedAtEpoch(t *testing.T) {
+	conf, dynasties := newTestGenesisConf(t)
+	assert.NoError(t, newTestPoa(t, conf).Setup(conf, nil, nil))
+	conf.ChainParams.Validators = []string{dynasties[2].Addr.Hex(), dynasties[1].Addr.Hex()}
+	assert.Equal(t, poa.ErrValidatorsMismatch, newTestPoa(t, conf).Setup(conf, nil, nil))
+	genesis := newTestGenesis(t, conf)
+
+	st, err := genesis.State().Clone()
+	require.NoError(t, err)
+	params := newTestPoa(t, conf).Params()
+	interval := int64(params.BlockInterval / time.Second)
+	for i := int64(1); i <= 3; i++ {
+		ts := genesisTimestamp + i*interval
+		require.NoError(t, st.TransitionDynasty(ts))
+		members, err := st.Dynasty()
+		require.NoError(t, err)
+		require.Equal(t, len(dynasties), len(members))
+		expected := members[(ts/interval)%int64(len(members))]
+		assert.Equal(t, *expected, st.Proposer())
+	}
+
+	epochEnd := genesisTimestamp + interval + int64(params.EpochInterval/time.Second)
+	require.NoError(t, st.TransitionDynasty(epochEnd))
+	members, err := st.Dynasty()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(members))
+	assert.Equal(t, dynasties[2].Addr, *members[0])
+	assert.Equal(t, dynasties[1].Addr, *members[1])
+	assert.Equal(t, *members[(epochEnd/interval)%2], st.Proposer())
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package poa
+
+import (
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Params is a set of consensus properties of a chain
+type Params struct {
+	BlockInterval      time.Duration
+	EpochInterval      time.Duration
+	MiningTickInterval time.Duration
+}
+
+// DefaultParams returns default consensus properties
+func DefaultParams() *Params {
+	return &Params{
+		BlockInterval:      DefaultBlockInterval,
+		EpochInterval:      DefaultEpochInterval,
+		MiningTickInterval: DefaultMiningTickInterval,
+	}
+}
+
+// NewParams returns consensus properties of genesis. Omitted fields are set to default values.
+func NewParams(genesis *corepb.Genesis) (*Params, error) {
+	params := DefaultParams()
+	conf := genesis.GetConsensus().GetPoa()
+	if conf.GetBlockInterval() != 0 {
+		params.BlockInterval = time.Duration(conf.GetBlockInterval()) * time.Second
+	}
+	if conf.GetEpochInterval() != 0 {
+		params.EpochInterval = time.Duration(conf.GetEpochInterval()) * time.Second
+	}
+	if conf.GetMiningTickInterval() != 0 {
+		params.MiningTickInterval = time.Duration(conf.GetMiningTickInterval()) * time.Millisecond
+	}
+	if err := params.verify(); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"params": params,
+			"err":    err,
+		}).Error("Invalid consensus parameters.")
+		return nil, err
+	}
+	return params, nil
+}
+
+func (p *Params) verify() error {
+	if p.BlockInterval < time.Second || p.BlockInterval%time.Second != 0 {
+		return ErrInvalidConsensusParams
+	}
+	if p.EpochInterval <= 0 || p.EpochInterval%p.BlockInterval != 0 {
+		return ErrInvalidConsensusParams
+	}
+	if p.MiningTickInterval <= 0 || p.MiningTickInterval > p.BlockInterval {
+		return ErrInvalidConsensusParams
+	}
+	return nil
+}
+
+func (p *Params) blockIntervalSec() int64 {
+	return int64(p.BlockInterval / time.Second)
+}
+
+func (p *Params) epochIntervalSec() int64 {
+	return int64(p.EpochInterval / time.Second)
+}
+
+// currentSlot returns the beginning of the slot of the given time.
+func (p *Params) currentSlot(now time.Time) int64 {
+	interval := p.blockIntervalSec()
+	return now.Unix() / interval * interval
+}
+
+// FindProposer returns validator whose turn is the slot of the given time
+func (p *Params) FindProposer(ts int64, validators []common.Address) (common.Address, error) {
+	if len(validators) == 0 {
+		return common.Address{}, ErrNoValidators
+	}
+	interval := p.blockIntervalSec()
+	if ts%interval != 0 {
+		return common.Address{}, ErrInvalidBlockForgeTime
+	}
+	return validators[(ts/interval)%int64(len(validators))], nil
+}
@@ -0,0 +1,412 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package poa
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/signer"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/util/clock"
+	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Poa is a proof of authority consensus.
+// Validators given by chain parameters propose blocks in turn regardless of vesting and votes.
+type Poa struct {
+	coinbase common.Address
+	miner    common.Address
+	signer   signer.Signer
+	mining   bool
+
+	bm *core.BlockManager
+	tm *core.TransactionManager
+
+	params *Params
+
+	clock clock.Clock
+
+	quitCh chan int
+}
+
+// New returns poa consensus with properties given by genesis.
+func New(cfg *medletpb.Config, genesis *corepb.Genesis) (*Poa, error) {
+	params, err := NewParams(genesis)
+	if err != nil {
+		return nil, err
+	}
+	poa := &Poa{
+		params: params,
+		clock:  core.Clock(),
+		quitCh: make(chan int, 1),
+	}
+
+	if cfg.Chain.StartMine {
+		poa.mining = true
+		poa.coinbase = common.HexToAddress(cfg.Chain.Coinbase)
+		poa.miner = common.HexToAddress(cfg.Chain.Miner)
+		s, err := signer.New(cfg)
+		if err != nil {
+			return nil, err
+		}
+		poa.signer = s
+	}
+	return poa, nil
+}
+
+// NewConsensusState generates new consensus state
+func (p *Poa) NewConsensusState(rootHash []byte, storage storage.Storage) (core.ConsensusState, error) {
+	return NewConsensusState(p.params), nil
+}
+
+// LoadConsensusState loads a consensus state from marshalled bytes
+func (p *Poa) LoadConsensusState(rootBytes []byte, storage storage.Storage) (core.ConsensusState, error) {
+	return LoadConsensusState(rootBytes, p.params)
+}
+
+// Setup sets up poa. Genesis dynasty must be the validators of genesis chain parameters.
+func (p *Poa) Setup(genesis *corepb.Genesis, bm *core.BlockManager, tm *core.TransactionManager) error {
+	validators := genesis.GetChainParams().GetValidators()
+	if len(validators) == 0 {
+		return ErrNoValidators
+	}
+	dynasty := genesis.GetConsensus().GetDpos().GetDynasty()
+	if len(dynasty) != len(validators) {
+		return ErrValidatorsMismatch
+	}
+	for i := range dynasty {
+		if !common.HexToAddress(dynasty[i]).Equals(common.HexToAddress(validators[i])) {
+			return ErrValidatorsMismatch
+		}
+	}
+	p.bm = bm
+	p.tm = tm
+	if local, ok := p.signer.(*signer.LocalSigner); ok {
+		local.SetProtection(signer.NewSlashingProtection(bm.Storage()))
+	}
+	if p.signer != nil {
+		bm.SetFinalitySigner(p.signer)
+	}
+	return nil
+}
+
+// Params returns consensus properties.
+func (p *Poa) Params() *Params {
+	return p.params
+}
+
+// SetClock replaces the clock which schedules mining. It is the clock of core by default.
+func (p *Poa) SetClock(c clock.Clock) {
+	p.clock = c
+}
+
+// Start starts miner.
+func (p *Poa) Start() {
+	if !p.mining {
+		return
+	}
+	go p.loop()
+}
+
+// Stop stops miner.
+func (p *Poa) Stop() {
+	if !p.mining {
+		return
+	}
+	p.quitCh <- 0
+}
+
+// ForkChoice chooses the highest tail not forked before LIB. Ties are broken by the number of
+// distinct proposers since LIB and then by the lowest block hash, so that every node picks
+// the same tail regardless of the order in which the tails are iterated.
+func (p *Poa) ForkChoice(bc *core.BlockChain) (newTail *core.Block) {
+	newTail = bc.MainTailBlock()
+	for _, block := range bc.TailBlocks() {
+		if bc.IsForkedBeforeLIB(block) {
+			logging.WithFields(logrus.Fields{
+				"block": block,
+				"lib":   bc.LIB(),
+			}).Debug("Blocks forked before LIB can not be selected.")
+			continue
+		}
+		if preferTail(bc, block, newTail) {
+			newTail = block
+		}
+	}
+	return newTail
+}
+
+// preferTail reports whether tail a should be chosen over tail b.
+func preferTail(bc *core.BlockChain, a *core.Block, b *core.Block) bool {
+	if a.Height() != b.Height() {
+		return a.Height() > b.Height()
+	}
+	if byteutils.Equal(a.Hash(), b.Hash()) {
+		return false
+	}
+	pa, pb := distinctProposersSinceLIB(bc, a), distinctProposersSinceLIB(bc, b)
+	if pa != pb {
+		return pa > pb
+	}
+	return bytes.Compare(a.Hash(), b.Hash()) < 0
+}
+
+// distinctProposersSinceLIB counts distinct proposers of the blocks between LIB and tail.
+func distinctProposersSinceLIB(bc *core.BlockChain, tail *core.Block) int {
+	lib := bc.LIB()
+	proposers := make(map[common.Address]bool)
+	for cur := tail; cur != nil && cur.Height() > lib.Height(); cur = bc.BlockByHash(cur.ParentHash()) {
+		proposers[cur.State().Proposer()] = true
+	}
+	return len(proposers)
+}
+
+// FindLIB finds the latest block on top of which more than two thirds of validators have proposed.
+func (p *Poa) FindLIB(bc *core.BlockChain) (newLIB *core.Block) {
+	lib := bc.LIB()
+	tail := bc.MainTailBlock()
+	members, err := tail.State().Dynasty()
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err":  err,
+			"tail": tail,
+		}).Error("Failed to get validators.")
+		return lib
+	}
+	validators := make(map[common.Address]bool)
+	for _, m := range members {
+		validators[*m] = true
+	}
+	consensusSize := len(validators)*2/3 + 1
+
+	confirmed := make(map[common.Address]bool)
+	for cur := tail; !byteutils.Equal(cur.Hash(), lib.Hash()); {
+		proposer := cur.State().Proposer()
+		if validators[proposer] {
+			confirmed[proposer] = true
+		}
+		if len(confirmed) >= consensusSize {
+			return cur
+		}
+		cur = bc.BlockByHash(cur.ParentHash())
+		if cur == nil {
+			logging.Console().WithFields(logrus.Fields{
+				"tail": tail,
+				"lib":  lib,
+			}).Error("Failed to find a parent block.")
+			return lib
+		}
+	}
+	return lib
+}
+
+// VerifyProposer verifies that the block is signed by the validator of its slot.
+func (p *Poa) VerifyProposer(bc *core.BlockChain, block *core.BlockData) error {
+	parent := bc.BlockByHash(block.ParentHash())
+	if parent == nil {
+		parent = bc.MainTailBlock()
+	}
+	if block.Timestamp() <= parent.Timestamp() {
+		return ErrInvalidBlockForgeTime
+	}
+	st, err := parent.State().Clone()
+	if err != nil {
+		return err
+	}
+	if err := st.TransitionDynasty(block.Timestamp()); err != nil {
+		logging.WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Debug("Failed to find a block proposer.")
+		return err
+	}
+	proposer := st.Proposer()
+
+	signer, err := recoverSigner(block)
+	if err != nil {
+		logging.WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Debug("Failed to recover block's signer.")
+		return err
+	}
+	if !proposer.Equals(signer) {
+		logging.WithFields(logrus.Fields{
+			"signer":   signer,
+			"proposer": proposer,
+			"block":    block,
+		}).Debug("Block proposer and block signer do not match.")
+		return ErrInvalidBlockProposer
+	}
+	return nil
+}
+
+func recoverSigner(block *core.BlockData) (common.Address, error) {
+	sig, err := crypto.NewSignature(block.Alg())
+	if err != nil {
+		return common.Address{}, err
+	}
+	pub, err := sig.RecoverPublic(block.Hash(), block.Signature())
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.PublicKeyToAddress(pub)
+}
+
+func (p *Poa) mintBlock(now time.Time) error {
+	tail := p.bm.TailBlock()
+	slot := p.params.currentSlot(now)
+	if tail.Timestamp() >= slot {
+		return ErrBlockMintedInSlot
+	}
+
+	block, err := core.NewBlock(p.bm.ChainID(), p.coinbase, tail)
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to create new block.")
+		return err
+	}
+	if err := block.SetTimestamp(slot); err != nil {
+		return err
+	}
+	if err := block.State().TransitionDynasty(slot); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"slot": slot,
+			"err":  err,
+		}).Error("Failed to transition dynasty for a new block.")
+		return err
+	}
+	proposer := block.State().Proposer()
+	if !p.miner.Equals(proposer) {
+		logging.WithFields(logrus.Fields{
+			"miner":    p.miner,
+			"proposer": proposer,
+		}).Debug("It's not my turn to mint the block.")
+		return ErrInvalidBlockProposer
+	}
+
+	if err := p.fillBlock(block, time.Unix(slot, 0).Add(p.params.BlockInterval)); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"tail": tail,
+			"err":  err,
+		}).Error("Failed to make a new block.")
+		return err
+	}
+	if err := block.Seal(); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Error("Failed to seal a new block.")
+		return err
+	}
+	if err := p.signer.SignBlock(block); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to sign block.")
+		return err
+	}
+
+	logging.Console().WithFields(logrus.Fields{
+		"proposer": proposer,
+		"block":    block,
+	}).Info("New block is minted.")
+
+	if err := p.bm.PushBlockData(block.GetBlockData()); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Error("Failed to push block to blockchain.")
+		return err
+	}
+	p.bm.BroadCast(block.GetBlockData())
+	return nil
+}
+
+func (p *Poa) fillBlock(block *core.Block, deadline time.Time) error {
+	for p.clock.Now().Before(deadline) {
+		tx := p.tm.Pop()
+		if tx == nil {
+			break
+		}
+		if err := block.BeginBatch(); err != nil {
+			return err
+		}
+		err := block.ExecuteTransaction(tx)
+		if err == core.ErrLargeTransactionNonce {
+			if err := p.tm.Push(tx); err != nil {
+				logging.Console().WithFields(logrus.Fields{
+					"err": err,
+				}).Error("Failed to push back tx.")
+			}
+		}
+		if err == nil {
+			err = block.AcceptTransaction(tx)
+		}
+		if err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"err": err,
+				"tx":  tx,
+			}).Warn("Failed to execute transaction.")
+			if err := block.RollBack(); err != nil {
+				return err
+			}
+			if err == core.ErrLargeTransactionNonce {
+				break
+			}
+			continue
+		}
+		if err := block.Commit(); err != nil {
+			return err
+		}
+	}
+
+	if err := block.BeginBatch(); err != nil {
+		return err
+	}
+	if err := block.ExecuteReservedTasks(); err != nil {
+		block.RollBack()
+		return err
+	}
+	if err := block.PayReward(); err != nil {
+		block.RollBack()
+		return err
+	}
+	return block.Commit()
+}
+
+func (p *Poa) loop() {
+	logging.Console().Info("Started Poa Mining.")
+	ticker := p.clock.NewTicker(p.params.MiningTickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C():
+			p.mintBlock(now)
+		case <-p.quitCh:
+			logging.Console().Info("Stopped Poa Mining.")
+			return
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package poa_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/consensus/poa"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/signer"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/util/clock"
+	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBlockManager(t *testing.T, conf *corepb.Genesis) *core.BlockManager {
+	cfg := medlet.DefaultConfig()
+	cfg.Chain.Consensus = "poa"
+	p := newTestPoa(t, conf)
+	bm, err := core.NewBlockManager(cfg)
+	require.NoError(t, err)
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	require.NoError(t, bm.Setup(conf, stor, nil, p))
+	require.NoError(t, p.Setup(conf, bm, core.NewTransactionManager(cfg)))
+	return bm
+}
+
+func newSignedBlock(t *testing.T, parent *core.Block, ts int64, dynasties testutil.Dynasties) *core.BlockData {
+	block, err := core.NewBlock(testutil.ChainID, dynasties[0].Addr, parent)
+	require.NoError(t, err)
+	require.NoError(t, block.SetTimestamp(ts))
+	require.NoError(t, block.State().TransitionDynasty(ts))
+	require.NoError(t, block.ExecuteAll())
+	require.NoError(t, block.Seal())
+
+	proposer := block.State().Proposer()
+	for _, d := range dynasties {
+		if d.Addr.Equals(proposer) {
+			sig, err := crypto.NewSignature(algorithm.SECP256K1)
+			require.NoError(t, err)
+			sig.InitSign(d.PrivKey)
+			require.NoError(t, block.SignThis(sig))
+		}
+	}
+	require.NotNil(t, block.Signature())
+	return block.GetBlockData()
+}
+
+func pushBlockData(t *testing.T, bm *core.BlockManager, bd *core.BlockData) {
+	pb, err := bd.ToProto()
+	require.NoError(t, err)
+	received := new(core.BlockData)
+	require.NoError(t, received.FromProto(pb))
+	require.NoError(t, bm.PushBlockData(received))
+}
+
+func TestForkChoice(t *testing.T) {
+	conf, dynasties := newTestGenesisConf(t)
+	interval := int64(newTestPoa(t, conf).Params().BlockInterval / time.Second)
+	validators := int64(len(conf.ChainParams.Validators))
+
+	// Both forks have the same height. The first one is produced by two
+	// validators and the second one by a single validator.
+	src := newTestBlockManager(t, conf)
+	genesis := src.TailBlock()
+	var forks [2][]*core.BlockData
+	for i, slots := range [][]int64{{1, 2}, {3, 3 + validators}} {
+		parent := genesis
+		for _, slot := range slots {
+			bd := newSignedBlock(t, parent, genesisTimestamp+slot*interval, dynasties)
+			pushBlockData(t, src, bd)
+			parent = src.BlockByHash(bd.Hash())
+			forks[i] = append(forks[i], bd)
+		}
+	}
+	expected := forks[0][1].Hash()
+	assert.Equal(t, expected, src.TailBlock().Hash())
+
+	// The choice does not depend on the order of arrival.
+	dst := newTestBlockManager(t, conf)
+	for _, bd := range append(forks[1], forks[0]...) {
+		pushBlockData(t, dst, bd)
+	}
+	assert.Equal(t, expected, dst.TailBlock().Hash())
+
+	// Forks with the same height and the same number of validators are
+	// chosen by the lowest hash.
+	a := newSignedBlock(t, genesis, genesisTimestamp+4*interval, dynasties)
+	b := newSignedBlock(t, genesis, genesisTimestamp+5*interval, dynasties)
+	expected = a.Hash()
+	if byteutils.Bytes2Hex(b.Hash()) < byteutils.Bytes2Hex(a.Hash()) {
+		expected = b.Hash()
+	}
+	for _, order := range [][]*core.BlockData{{a, b}, {b, a}} {
+		bm := newTestBlockManager(t, conf)
+		for _, bd := range order {
+			pushBlockData(t, bm, bd)
+		}
+		assert.Equal(t, expected, bm.TailBlock().Hash())
+	}
+}
+
+func TestMintOnClock(t *testing.T) {
+	conf, dynasties := newTestGenesisConf(t)
+	conf.Meta.DynastySize = 1
+	conf.Consensus.Dpos.Dynasty = conf.Consensus.Dpos.Dynasty[:1]
+	conf.ChainParams.Validators = conf.Consensus.Dpos.Dynasty
+	conf.Consensus.Poa = &corepb.GenesisConsensusPoa{
+		BlockInterval:      1,
+		EpochInterval:      100,
+		MiningTickInterval: 100,
+	}
+	clk := clock.NewManual(time.Unix(genesisTimestamp, 0))
+	core.SetClock(clk)
+	defer core.SetClock(clock.System)
+
+	privKey, err := dynasties[0].PrivKey.Encoded()
+	require.NoError(t, err)
+	cfg := medlet.DefaultConfig()
+	cfg.Chain.Consensus = "poa"
+	cfg.Chain.StartMine = true
+	cfg.Chain.Coinbase = dynasties[0].Addr.Hex()
+	cfg.Chain.Miner = dynasties[0].Addr.Hex()
+	cfg.Chain.Privkey = byteutils.Bytes2Hex(privKey)
+	p, err := poa.New(cfg, conf)
+	require.NoError(t, err)
+	bm, err := core.NewBlockManager(cfg)
+	require.NoError(t, err)
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	require.NoError(t, bm.Setup(conf, stor, nil, p))
+	require.NoError(t, p.Setup(conf, bm, core.NewTransactionManager(cfg)))
+
+	p.Start()
+	defer p.Stop()
+	waitFor(t, func() bool { return clk.Tickers() == 1 })
+
+	// A block is minted on the tick at the beginning of each slot, stamped by the clock.
+	for slot := int64(1); slot <= 3; slot++ {
+		for i := 0; i < 10; i++ {
+			clk.Advance(p.Params().MiningTickInterval)
+			waitFor(t, func() bool { return clk.PendingTicks() == 0 })
+		}
+		waitFor(t, func() bool { return bm.TailBlock().Height() == core.GenesisHeight+uint64(slot) })
+		assert.Equal(t, genesisTimestamp+slot, bm.TailBlock().Timestamp())
+	}
+
+	// The miner has recorded its last block before signing it.
+	signed, err := signer.NewSlashingProtection(stor).LastSigned(dynasties[0].Addr)
+	require.NoError(t, err)
+	assert.Equal(t, bm.TailBlock().Hash(), signed.Hash)
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("timed out waiting for condition")
+}
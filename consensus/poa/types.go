@@ -0,0 +1,39 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package poa
+
+import (
+	"errors"
+	"time"
+)
+
+// Default consensus properties used when genesis does not specify them.
+const (
+	DefaultBlockInterval      = 5 * time.Second
+	DefaultEpochInterval      = 120 * DefaultBlockInterval
+	DefaultMiningTickInterval = time.Second
+)
+
+// Error types of poa package.
+var (
+	ErrInvalidBlockProposer         = errors.New("invalid block proposer")
+	ErrInvalidBlockForgeTime        = errors.New("invalid time to forge block")
+	ErrInvalidProtoToConsensusState = errors.New("protobuf message cannot be converted into ConsensusState")
+	ErrBlockMintedInSlot            = errors.New("cannot mint block now, there is a block minted in current slot")
+	ErrNoValidators                 = errors.New("there is no validator")
+	ErrValidatorsMismatch           = errors.New("genesis dynasty does not match validators of chain parameters")
+	ErrInvalidConsensusParams       = errors.New("invalid consensus parameters")
+)
@@ -108,14 +108,15 @@ func (as *AccountStateBatch) getAccount(address []byte) (*account, error) {
 	if err != nil {
 		// create account not exist in
 		return stageAndReturn(&account{
-			address:       address,
-			balance:       util.NewUint128(),
-			vesting:       util.NewUint128(),
-			voted:         []byte{},
-			nonce:         0,
-			records:       [][]byte{},
-			certsReceived: [][]byte{},
-			certsIssued:   [][]byte{},
+			address:        address,
+			balance:        util.NewUint128(),
+			vesting:        util.NewUint128(),
+			voted:          [][]byte{},
+			nonce:          0,
+			records:        [][]byte{},
+			certsReceived:  [][]byte{},
+			certsIssued:    [][]byte{},
+			proxiedVesting: util.NewUint128(),
 		}), nil
 	}
 	acc, err := loadAccount(accBytes)
@@ -257,8 +258,8 @@ func (as *AccountStateBatch) SubVesting(address []byte, amount *util.Uint128) er
 	return nil
 }
 
-// SetVoted vote sets voted of account
-func (as *AccountStateBatch) SetVoted(address []byte, voted []byte) error {
+// SetVoted vote sets voted candidates of account
+func (as *AccountStateBatch) SetVoted(address []byte, voted [][]byte) error {
 	if !as.batching {
 		return ErrNotBatching
 	}
@@ -266,15 +267,24 @@ func (as *AccountStateBatch) SetVoted(address []byte, voted []byte) error {
 	if err != nil {
 		return err
 	}
-	if byteutils.Equal(acc.voted, voted) {
-		return ErrAlreadyVoted
+	if len(acc.voted) == len(voted) {
+		equal := true
+		for i := range voted {
+			if !byteutils.Equal(acc.voted[i], voted[i]) {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return ErrAlreadyVoted
+		}
 	}
 	acc.voted = voted
 	return nil
 }
 
-// GetVoted returned voted address of account
-func (as *AccountStateBatch) GetVoted(address []byte) ([]byte, error) {
+// GetVoted returned voted candidates of account
+func (as *AccountStateBatch) GetVoted(address []byte) ([][]byte, error) {
 	acc, err := as.getAccount(address)
 	if err != nil {
 		return nil, err
@@ -285,6 +295,85 @@ func (as *AccountStateBatch) GetVoted(address []byte) ([]byte, error) {
 	return acc.voted, nil
 }
 
+// SetProxy sets proxy account which votes on behalf of account
+func (as *AccountStateBatch) SetProxy(address []byte, proxy []byte) error {
+	if !as.batching {
+		return ErrNotBatching
+	}
+	acc, err := as.getAccount(address)
+	if err != nil {
+		return err
+	}
+	acc.proxy = proxy
+	return nil
+}
+
+// AddProxiedVesting increases proxied vesting
+func (as *AccountStateBatch) AddProxiedVesting(address []byte, amount *util.Uint128) error {
+	if !as.batching {
+		return ErrNotBatching
+	}
+	acc, err := as.getAccount(address)
+	if err != nil {
+		return err
+	}
+	proxiedVesting, err := acc.proxiedVesting.Add(amount)
+	if err != nil {
+		return err
+	}
+	acc.proxiedVesting = proxiedVesting
+	return nil
+}
+
+// SubProxiedVesting decreases proxied vesting
+func (as *AccountStateBatch) SubProxiedVesting(address []byte, amount *util.Uint128) error {
+	if !as.batching {
+		return ErrNotBatching
+	}
+	acc, err := as.getAccount(address)
+	if err != nil {
+		return err
+	}
+	if amount.Cmp(acc.proxiedVesting) > 0 {
+		return ErrVestingNotEnough
+	}
+	proxiedVesting, err := acc.proxiedVesting.Sub(amount)
+	if err != nil {
+		return err
+	}
+	acc.proxiedVesting = proxiedVesting
+	return nil
+}
+
+// AddDelegator increases number of delegators
+func (as *AccountStateBatch) AddDelegator(address []byte) error {
+	if !as.batching {
+		return ErrNotBatching
+	}
+	acc, err := as.getAccount(address)
+	if err != nil {
+		return err
+	}
+	acc.delegators++
+	return nil
+}
+
+// SubDelegator decreases number of delegators
+func (as *AccountStateBatch) SubDelegator(address []byte) error {
+	if !as.batching {
+		return ErrNotBatching
+	}
+	acc, err := as.getAccount(address)
+	if err != nil {
+		return err
+	}
+	if acc.delegators == 0 {
+		return ErrNotDelegated
+	}
+	acc.delegators--
+	return nil
+}
+
 // AddCertReceived adds a cert hash in certReceived
 func (as *AccountStateBatch) AddCertReceived(address []byte, certHash []byte) error {
 	if !as.batching {
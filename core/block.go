@@ -17,7 +17,6 @@ package core
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/medibloc/go-medibloc/common"
@@ -25,6 +24,7 @@ import (
 	"github.com/medibloc/go-medibloc/crypto/signature"
 	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
 	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util"
 	"github.com/medibloc/go-medibloc/util/byteutils"
 	"github.com/medibloc/go-medibloc/util/logging"
 	"github.com/sirupsen/logrus"
@@ -42,10 +42,19 @@ type BlockHeader struct {
 	recordsRoot       []byte
 	candidacyRoot     []byte
 	certificationRoot []byte
+	issuerRoot        []byte
+	multisigRoot      []byte
+	governanceRoot    []byte
+	evidenceRoot      []byte
+	votesRoot         []byte
 	consensusRoot     []byte
 
+	supply []byte
+
 	reservationQueueHash []byte
+	chainParamsHash      []byte
 
+	version   uint32
 	coinbase  common.Address
 	timestamp int64
 	chainID   uint32
@@ -65,8 +74,16 @@ func (b *BlockHeader) ToProto() (proto.Message, error) {
 		RecordsRoot:          b.recordsRoot,
 		CandidacyRoot:        b.candidacyRoot,
 		CertificationRoot:    b.certificationRoot,
+		IssuerRoot:           b.issuerRoot,
+		MultisigRoot:         b.multisigRoot,
+		GovernanceRoot:       b.governanceRoot,
+		EvidenceRoot:         b.evidenceRoot,
+		VotesRoot:            b.votesRoot,
 		ConsensusRoot:        b.consensusRoot,
 		ReservationQueueHash: b.reservationQueueHash,
+		ChainParamsHash:      b.chainParamsHash,
+		Supply:               b.supply,
+		Version:              b.version,
 		Coinbase:             b.coinbase.Bytes(),
 		Timestamp:            b.timestamp,
 		ChainId:              b.chainID,
@@ -86,8 +103,16 @@ func (b *BlockHeader) FromProto(msg proto.Message) error {
 		b.recordsRoot = msg.RecordsRoot
 		b.candidacyRoot = msg.CandidacyRoot
 		b.certificationRoot = msg.CertificationRoot
+		b.issuerRoot = msg.IssuerRoot
+		b.multisigRoot = msg.MultisigRoot
+		b.governanceRoot = msg.GovernanceRoot
+		b.evidenceRoot = msg.EvidenceRoot
+		b.votesRoot = msg.VotesRoot
 		b.consensusRoot = msg.ConsensusRoot
 		b.reservationQueueHash = msg.ReservationQueueHash
+		b.chainParamsHash = msg.ChainParamsHash
+		b.supply = msg.Supply
+		b.version = msg.Version
 		b.coinbase = common.BytesToAddress(msg.Coinbase)
 		b.timestamp = msg.Timestamp
 		b.chainID = msg.ChainId
@@ -176,7 +201,7 @@ func NewBlock(chainID uint32, coinbase common.Address, parent *Block) (*Block, e
 			header: &BlockHeader{
 				parentHash: parent.Hash(),
 				coinbase:   coinbase,
-				timestamp:  time.Now().Unix(),
+				timestamp:  Clock().Now().Unix(),
 				chainID:    chainID,
 			},
 			transactions: make(Transactions, 0),
@@ -186,6 +211,8 @@ func NewBlock(chainID uint32, coinbase common.Address, parent *Block) (*Block, e
 		state:   state,
 		sealed:  false,
 	}
+	block.state.height = block.height
+	block.state.timestamp = block.header.timestamp
 
 	return block, nil
 }
@@ -216,6 +243,8 @@ func prepareExecution(bd *BlockData, parent *Block) (*Block, error) {
 	if block.state, err = parent.state.Clone(); err != nil {
 		return nil, err
 	}
+	block.state.height = bd.height
+	block.state.timestamp = bd.header.timestamp
 	block.storage = parent.storage
 	return block, nil
 }
@@ -234,6 +263,8 @@ func (bd *BlockData) GetExecutedBlock(consensus Consensus, storage storage.Stora
 		}).Error("Failed to create new block state.")
 		return nil, err
 	}
+	block.state.height = bd.height
+	block.state.timestamp = bd.header.timestamp
 	if err = block.state.LoadAccountsRoot(block.header.accsRoot); err != nil {
 		logging.WithFields(logrus.Fields{
 			"err":   err,
@@ -268,6 +299,21 @@ func (bd *BlockData) GetExecutedBlock(consensus Consensus, storage storage.Stora
 	if err = block.state.LoadCertificationRoot(block.header.certificationRoot); err != nil {
 		return nil, err
 	}
+	if err = block.state.LoadIssuerRoot(block.header.issuerRoot); err != nil {
+		return nil, err
+	}
+	if err = block.state.LoadMultisigRoot(block.header.multisigRoot); err != nil {
+		return nil, err
+	}
+	if err = block.state.LoadGovernanceRoot(block.header.governanceRoot); err != nil {
+		return nil, err
+	}
+	if err = block.state.LoadEvidenceRoot(block.header.evidenceRoot); err != nil {
+		return nil, err
+	}
+	if err = block.state.LoadVotesRoot(block.header.votesRoot); err != nil {
+		return nil, err
+	}
 	if err = block.state.LoadConsensusRoot(block.consensus, block.header.consensusRoot); err != nil {
 		logging.WithFields(logrus.Fields{
 			"err":   err,
@@ -282,11 +328,18 @@ func (bd *BlockData) GetExecutedBlock(consensus Consensus, storage storage.Stora
 		}).Error("Failed to load reservation queue.")
 		return nil, err
 	}
-	if err := block.state.ConstructVotesCache(); err != nil {
+	if err := block.state.LoadChainParams(block.header.chainParamsHash); err != nil {
 		logging.WithFields(logrus.Fields{
 			"err":   err,
 			"block": block,
-		}).Error("Failed to construct votes cache.")
+		}).Error("Failed to load chain parameters.")
+		return nil, err
+	}
+	if err := block.state.LoadSupply(block.header.supply); err != nil {
+		logging.WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Error("Failed to load supply.")
 		return nil, err
 	}
 	block.storage = storage
@@ -324,6 +377,12 @@ func (bd *BlockData) SetTimestamp(timestamp int64) error {
 	return nil
 }
 
+// SetTimestamp sets timestamp of the block and its state.
+func (block *Block) SetTimestamp(timestamp int64) error {
+	block.state.timestamp = timestamp
+	return block.BlockData.SetTimestamp(timestamp)
+}
+
 // Hash returns block hash
 func (bd *BlockData) Hash() []byte {
 	return bd.header.hash
@@ -369,6 +428,31 @@ func (bd *BlockData) CertificationRoot() []byte {
 	return bd.header.certificationRoot
 }
 
+// IssuerRoot returns root hash of issuer trie
+func (bd *BlockData) IssuerRoot() []byte {
+	return bd.header.issuerRoot
+}
+
+// MultisigRoot returns root hash of multisig trie
+func (bd *BlockData) MultisigRoot() []byte {
+	return bd.header.multisigRoot
+}
+
+// GovernanceRoot returns root hash of governance trie
+func (bd *BlockData) GovernanceRoot() []byte {
+	return bd.header.governanceRoot
+}
+
+// EvidenceRoot returns root hash of evidence trie
+func (bd *BlockData) EvidenceRoot() []byte {
+	return bd.header.evidenceRoot
+}
+
+// VotesRoot returns root hash of votes trie
+func (bd *BlockData) VotesRoot() []byte {
+	return bd.header.votesRoot
+}
+
 // ConsensusRoot returns root hash of consensus trie
 func (bd *BlockData) ConsensusRoot() []byte {
 	return bd.header.consensusRoot
@@ -379,6 +463,24 @@ func (bd *BlockData) ReservationQueueHash() []byte {
 	return bd.header.reservationQueueHash
 }
 
+// ChainParamsHash returns hash of chain parameters
+func (bd *BlockData) ChainParamsHash() []byte {
+	return bd.header.chainParamsHash
+}
+
+// Version returns version of block header
+func (bd *BlockData) Version() uint32 {
+	return bd.header.version
+}
+
+// Supply returns total supply after block execution
+func (bd *BlockData) Supply() (*util.Uint128, error) {
+	if len(bd.header.supply) == 0 {
+		return util.Uint128Zero(), nil
+	}
+	return util.NewUint128FromFixedSizeByteSlice(bd.header.supply)
+}
+
 // Height returns height
 func (bd *BlockData) Height() uint64 {
 	return bd.height
@@ -438,6 +540,10 @@ func (block *Block) Seal() error {
 	}
 	block.header.consensusRoot = consensusRoot
 	block.header.reservationQueueHash = block.state.ReservationQueueHash()
+	block.header.version = block.headerVersion()
+	if err := block.setExtendedHeader(); err != nil {
+		return err
+	}
 
 	hash, err := HashBlockData(block.BlockData)
 	if err != nil {
@@ -454,26 +560,85 @@ func HashBlockData(bd *BlockData) ([]byte, error) {
 		return nil, ErrNilArgument
 	}
 
-	hasher := sha3.New256()
-
-	hasher.Write(bd.ParentHash())
-	hasher.Write(bd.Coinbase().Bytes())
-	hasher.Write(bd.AccountsRoot())
-	hasher.Write(bd.TransactionsRoot())
-	hasher.Write(bd.UsageRoot())
-	hasher.Write(bd.RecordsRoot())
-	hasher.Write(bd.CandidacyRoot())
-	hasher.Write(bd.CertificationRoot())
-	hasher.Write(bd.ConsensusRoot())
-	hasher.Write(bd.ReservationQueueHash())
-	hasher.Write(byteutils.FromInt64(bd.Timestamp()))
-	hasher.Write(byteutils.FromUint32(bd.ChainID()))
+	txHashes := make([][]byte, len(bd.transactions))
+	for i, tx := range bd.transactions {
+		txHashes[i] = tx.Hash()
+	}
+	return HashBlockHeader(bd.header, txHashes), nil
+}
 
-	for _, tx := range bd.transactions {
-		hasher.Write(tx.Hash())
+// HashBlockHeader returns hash of block with the header and hashes of its transactions.
+// A header of BlockHeaderV0 is hashed as before the extended header, so hashes of existing blocks are unchanged.
+func HashBlockHeader(header *BlockHeader, txHashes [][]byte) []byte {
+	hasher := sha3.New256()
+	extended := header.version >= BlockHeaderV1
+
+	hasher.Write(header.parentHash)
+	hasher.Write(header.coinbase.Bytes())
+	hasher.Write(header.accsRoot)
+	hasher.Write(header.txsRoot)
+	hasher.Write(header.usageRoot)
+	hasher.Write(header.recordsRoot)
+	hasher.Write(header.candidacyRoot)
+	hasher.Write(header.certificationRoot)
+	if extended {
+		hasher.Write(header.issuerRoot)
+		hasher.Write(header.multisigRoot)
+		hasher.Write(header.governanceRoot)
+		hasher.Write(header.evidenceRoot)
+		hasher.Write(header.votesRoot)
+	}
+	hasher.Write(header.consensusRoot)
+	hasher.Write(header.reservationQueueHash)
+	if extended {
+		hasher.Write(header.chainParamsHash)
+		hasher.Write(header.supply)
+	}
+	hasher.Write(byteutils.FromInt64(header.timestamp))
+	hasher.Write(byteutils.FromUint32(header.chainID))
+	if extended {
+		hasher.Write(byteutils.FromUint32(header.version))
+	}
+
+	for _, hash := range txHashes {
+		hasher.Write(hash)
+	}
+
+	return hasher.Sum(nil)
+}
+
+// headerVersion returns version of header which the block should have at its height
+func (block *Block) headerVersion() uint32 {
+	if block.IsForkActive(ForkExtendedHeader) {
+		return BlockHeaderV1
+	}
+	return BlockHeaderV0
+}
+
+// setExtendedHeader writes roots of states added by BlockHeaderV1 in header.
+// A header of BlockHeaderV0 keeps them only to load the states of the block later, since its hash does not cover them.
+func (block *Block) setExtendedHeader() error {
+	block.header.issuerRoot = block.state.IssuerRoot()
+	block.header.multisigRoot = block.state.MultisigRoot()
+	block.header.governanceRoot = block.state.GovernanceRoot()
+	block.header.evidenceRoot = block.state.EvidenceRoot()
+	block.header.votesRoot = block.state.VotesRoot()
+	chainParamsHash, err := block.state.ChainParamsHash()
+	if err != nil {
+		return err
 	}
+	block.header.chainParamsHash = chainParamsHash
+	supply, err := block.state.SupplyBytes()
+	if err != nil {
+		return err
+	}
+	block.header.supply = supply
+	return nil
+}
 
-	return hasher.Sum(nil), nil
+// IsForkActive returns true if a fork is active at the height of block
+func (block *Block) IsForkActive(name string) bool {
+	return block.state.IsForkActive(name)
 }
 
 // ExecuteTransaction on given block state
@@ -548,20 +713,35 @@ func (block *Block) ExecuteAll() error {
 		return err
 	}
 
+	if err := block.PayReward(); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Warn("Failed to pay block reward.")
+		block.RollBack()
+		return err
+	}
+
 	block.Commit()
 
 	return nil
 }
 
 // ExecuteReservedTasks processes reserved tasks with timestamp before block's timestamp
+// and removes candidates which missed too many slots
 func (block *Block) ExecuteReservedTasks() error {
-	tasks := block.state.PopReservedTasks(block.Timestamp())
+	tasks := block.state.PopReservedTasks(block.Timestamp(), block.Height())
 	for _, t := range tasks {
 		if err := t.ExecuteOnState(block.state); err != nil {
 			return err
 		}
 	}
-	return nil
+	return block.state.RemoveInactiveCandidates(block.Timestamp())
+}
+
+// PayReward mints block reward to coinbase
+func (block *Block) PayReward() error {
+	return block.state.PayBlockReward(block.Coinbase())
 }
 
 // AcceptTransaction adds tx in block state
@@ -575,6 +755,13 @@ func (block *Block) AcceptTransaction(tx *Transaction) error {
 
 // VerifyState verifies block states comparing with root hashes in header
 func (block *Block) VerifyState() error {
+	if block.header.version != block.headerVersion() {
+		logging.WithFields(logrus.Fields{
+			"version": block.header.version,
+			"wanted":  block.headerVersion(),
+		}).Warn("Failed to verify block header version.")
+		return ErrInvalidBlockHeaderVersion
+	}
 	if !byteutils.Equal(block.state.AccountsRoot(), block.AccountsRoot()) {
 		logging.WithFields(logrus.Fields{
 			"state":  byteutils.Bytes2Hex(block.state.AccountsRoot()),
@@ -638,6 +825,71 @@ func (block *Block) VerifyState() error {
 		}).Warn("Failed to verify reservation queue hash.")
 		return ErrInvalidBlockReservationQueueHash
 	}
+	if block.header.version == BlockHeaderV0 {
+		return block.setExtendedHeader()
+	}
+	return block.verifyExtendedState()
+}
+
+// verifyExtendedState verifies block states which are committed to by headers from BlockHeaderV1
+func (block *Block) verifyExtendedState() error {
+	if !byteutils.Equal(block.state.IssuerRoot(), block.IssuerRoot()) {
+		logging.WithFields(logrus.Fields{
+			"state":  byteutils.Bytes2Hex(block.state.IssuerRoot()),
+			"header": byteutils.Bytes2Hex(block.IssuerRoot()),
+		}).Warn("Failed to verify issuer root.")
+		return ErrInvalidBlockIssuerRoot
+	}
+	if !byteutils.Equal(block.state.MultisigRoot(), block.MultisigRoot()) {
+		logging.WithFields(logrus.Fields{
+			"state":  byteutils.Bytes2Hex(block.state.MultisigRoot()),
+			"header": byteutils.Bytes2Hex(block.MultisigRoot()),
+		}).Warn("Failed to verify multisig root.")
+		return ErrInvalidBlockMultisigRoot
+	}
+	if !byteutils.Equal(block.state.GovernanceRoot(), block.GovernanceRoot()) {
+		logging.WithFields(logrus.Fields{
+			"state":  byteutils.Bytes2Hex(block.state.GovernanceRoot()),
+			"header": byteutils.Bytes2Hex(block.GovernanceRoot()),
+		}).Warn("Failed to verify governance root.")
+		return ErrInvalidBlockGovernanceRoot
+	}
+	if !byteutils.Equal(block.state.EvidenceRoot(), block.EvidenceRoot()) {
+		logging.WithFields(logrus.Fields{
+			"state":  byteutils.Bytes2Hex(block.state.EvidenceRoot()),
+			"header": byteutils.Bytes2Hex(block.EvidenceRoot()),
+		}).Warn("Failed to verify evidence root.")
+		return ErrInvalidBlockEvidenceRoot
+	}
+	if !byteutils.Equal(block.state.VotesRoot(), block.VotesRoot()) {
+		logging.WithFields(logrus.Fields{
+			"state":  byteutils.Bytes2Hex(block.state.VotesRoot()),
+			"header": byteutils.Bytes2Hex(block.VotesRoot()),
+		}).Warn("Failed to verify votes root.")
+		return ErrInvalidBlockVotesRoot
+	}
+	chainParamsHash, err := block.state.ChainParamsHash()
+	if err != nil {
+		return err
+	}
+	if !byteutils.Equal(chainParamsHash, block.ChainParamsHash()) {
+		logging.WithFields(logrus.Fields{
+			"state":  byteutils.Bytes2Hex(chainParamsHash),
+			"header": byteutils.Bytes2Hex(block.ChainParamsHash()),
+		}).Warn("Failed to verify chain parameters hash.")
+		return ErrInvalidBlockChainParamsHash
+	}
+	supply, err := block.state.SupplyBytes()
+	if err != nil {
+		return err
+	}
+	if !byteutils.Equal(supply, block.header.supply) {
+		logging.WithFields(logrus.Fields{
+			"state":  block.state.Supply(),
+			"header": byteutils.Bytes2Hex(block.header.supply),
+		}).Warn("Failed to verify supply.")
+		return ErrInvalidBlockSupply
+	}
 	return nil
 }
 
@@ -661,6 +913,16 @@ func (block *Block) SignThis(signer signature.Signature) error {
 	return block.BlockData.SignThis(signer)
 }
 
+// SetSignature sets a signature made outside of the node in block
+func (block *Block) SetSignature(alg algorithm.Algorithm, sign []byte) error {
+	if !block.Sealed() {
+		return ErrBlockNotSealed
+	}
+	block.header.alg = alg
+	block.header.sign = sign
+	return nil
+}
+
 // VerifyIntegrity verifies if block signature is valid
 func (bd *BlockData) VerifyIntegrity() error {
 	if bd.height == GenesisHeight {
@@ -669,6 +931,7 @@ func (bd *BlockData) VerifyIntegrity() error {
 		}
 		return nil
 	}
+	PreVerifyTransactions(bd.transactions)
 	for _, tx := range bd.transactions {
 		if err := tx.VerifyIntegrity(bd.header.chainID); err != nil {
 			return err
@@ -713,8 +976,16 @@ func (block *Block) GetBlockData() *BlockData {
 			recordsRoot:          block.RecordsRoot(),
 			candidacyRoot:        block.CandidacyRoot(),
 			certificationRoot:    block.CertificationRoot(),
+			issuerRoot:           block.IssuerRoot(),
+			multisigRoot:         block.MultisigRoot(),
+			governanceRoot:       block.GovernanceRoot(),
+			evidenceRoot:         block.EvidenceRoot(),
+			votesRoot:            block.VotesRoot(),
 			consensusRoot:        block.ConsensusRoot(),
 			reservationQueueHash: block.ReservationQueueHash(),
+			chainParamsHash:      block.ChainParamsHash(),
+			supply:               block.header.supply,
+			version:              block.Version(),
 			coinbase:             block.Coinbase(),
 			timestamp:            block.Timestamp(),
 			chainID:              block.ChainID(),
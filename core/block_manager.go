@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/hashicorp/golang-lru"
+	"github.com/medibloc/go-medibloc/common"
 	"github.com/medibloc/go-medibloc/core/pb"
 	"github.com/medibloc/go-medibloc/medlet/pb"
 	"github.com/medibloc/go-medibloc/net"
@@ -33,6 +35,9 @@ import (
 var (
 	defaultBlockMessageChanSize = 128
 	newBlockBroadcastTimeLimit  = 3 * time.Second
+	maxPendingEvidences         = 128
+	maxPendingFinalityBlocks    = 128
+	maxFinalityVotesPerLIB      = 32
 )
 
 // BlockManager handles all logic related to BlockChain and BlockPool.
@@ -43,9 +48,17 @@ type BlockManager struct {
 	ns        net.Service
 	consensus Consensus
 
-	receiveBlockMessageCh chan net.Message
-	requestBlockMessageCh chan net.Message
-	quitCh                chan int
+	slotBlocks map[int64]*BlockData
+	evidences  []*DoubleSignEvidence
+
+	// finalityVotes holds votes of blocks not certified yet, keyed by block hash.
+	finalityVotes  *lru.Cache
+	finalitySigner FinalitySigner
+
+	receiveBlockMessageCh    chan net.Message
+	requestBlockMessageCh    chan net.Message
+	receiveFinalityMessageCh chan net.Message
+	quitCh                   chan int
 }
 
 // NewBlockManager returns BlockManager.
@@ -64,15 +77,75 @@ func NewBlockManager(cfg *medletpb.Config) (*BlockManager, error) {
 		}).Error("Failed to create blockchain.")
 		return nil, err
 	}
+	finalityVotes, err := lru.New(maxPendingFinalityBlocks)
+	if err != nil {
+		return nil, err
+	}
 	return &BlockManager{
 		bc: bc,
 		bp: bp,
-		receiveBlockMessageCh: make(chan net.Message, defaultBlockMessageChanSize),
-		requestBlockMessageCh: make(chan net.Message, defaultBlockMessageChanSize),
-		quitCh:                make(chan int, 1),
+		slotBlocks:               make(map[int64]*BlockData),
+		finalityVotes:            finalityVotes,
+		receiveBlockMessageCh:    make(chan net.Message, defaultBlockMessageChanSize),
+		requestBlockMessageCh:    make(chan net.Message, defaultBlockMessageChanSize),
+		receiveFinalityMessageCh: make(chan net.Message, defaultBlockMessageChanSize),
+		quitCh:                   make(chan int, 1),
 	}, nil
 }
 
+// PendingEvidences returns evidences of double signing detected by the block manager.
+func (bm *BlockManager) PendingEvidences() []*DoubleSignEvidence {
+	bm.mu.RLock()
+	defer bm.mu.RUnlock()
+	evidences := make([]*DoubleSignEvidence, len(bm.evidences))
+	copy(evidences, bm.evidences)
+	return evidences
+}
+
+// detectDoubleSign keeps the first block of each slot and makes evidence if another block is signed for the slot.
+func (bm *BlockManager) detectDoubleSign(bd *BlockData) {
+	libTimestamp := bm.bc.LIB().Timestamp()
+	for timestamp := range bm.slotBlocks {
+		if timestamp <= libTimestamp {
+			delete(bm.slotBlocks, timestamp)
+		}
+	}
+
+	seen, ok := bm.slotBlocks[bd.Timestamp()]
+	if !ok {
+		bm.slotBlocks[bd.Timestamp()] = bd
+		return
+	}
+	if byteutils.Equal(seen.Hash(), bd.Hash()) {
+		return
+	}
+
+	evidence := NewDoubleSignEvidence(seen, bd)
+	offender, err := evidence.Verify(bd.ChainID())
+	if err != nil {
+		logging.WithFields(logrus.Fields{
+			"err":       err,
+			"blockData": bd,
+		}).Debug("Blocks of the same slot are not signed by the same proposer.")
+		return
+	}
+	for _, e := range bm.evidences {
+		if byteutils.Equal(e.Hash(), evidence.Hash()) {
+			return
+		}
+	}
+	if len(bm.evidences) >= maxPendingEvidences {
+		bm.evidences = bm.evidences[1:]
+	}
+	bm.evidences = append(bm.evidences, evidence)
+	logging.Console().WithFields(logrus.Fields{
+		"offender":  offender.Hex(),
+		"timestamp": bd.Timestamp(),
+		"first":     byteutils.Bytes2Hex(seen.Hash()),
+		"second":    byteutils.Bytes2Hex(bd.Hash()),
+	}).Warn("Detected double signing.")
+}
+
 // Setup sets up BlockManager.
 func (bm *BlockManager) Setup(genesis *corepb.Genesis, stor storage.Storage, ns net.Service, consensus Consensus) error {
 	bm.consensus = consensus
@@ -108,6 +181,7 @@ func (bm *BlockManager) registerInNetwork() {
 	bm.ns.Register(net.NewSubscriber(bm, bm.receiveBlockMessageCh, true, MessageTypeNewBlock, net.MessageWeightNewBlock))
 	bm.ns.Register(net.NewSubscriber(bm, bm.receiveBlockMessageCh, false, MessageTypeResponseBlock, net.MessageWeightZero))
 	bm.ns.Register(net.NewSubscriber(bm, bm.requestBlockMessageCh, false, MessageTypeRequestBlock, net.MessageWeightZero))
+	bm.ns.Register(net.NewSubscriber(bm, bm.receiveFinalityMessageCh, false, MessageTypeFinalityVote, net.MessageWeightZero))
 }
 
 // ChainID return BlockChain.ChainID
@@ -117,6 +191,13 @@ func (bm *BlockManager) ChainID() uint32 {
 	return bm.bc.ChainID()
 }
 
+// Storage returns storage of the chain.
+func (bm *BlockManager) Storage() storage.Storage {
+	bm.mu.RLock()
+	defer bm.mu.RUnlock()
+	return bm.bc.Storage()
+}
+
 // BlockByHeight returns the block contained in the chain by height.
 func (bm *BlockManager) BlockByHeight(height uint64) (*Block, error) {
 	bm.mu.RLock()
@@ -152,6 +233,9 @@ func (bm *BlockManager) Relay(bd *BlockData) {
 
 // BroadCast broadcasts BlockData to network.
 func (bm *BlockManager) BroadCast(bd *BlockData) {
+	if bm.ns == nil {
+		return
+	}
 	bm.ns.Broadcast(MessageTypeNewBlock, bd, net.MessagePriorityHigh)
 }
 
@@ -181,8 +265,6 @@ func (bm *BlockManager) push(bd *BlockData) error {
 		return ErrCannotRevertLIB
 	}
 
-	// TODO @cl9200 Filter blocks of same height.
-
 	if err := bd.VerifyIntegrity(); err != nil {
 		logging.WithFields(logrus.Fields{
 			"err": err,
@@ -198,6 +280,8 @@ func (bm *BlockManager) push(bd *BlockData) error {
 		return err
 	}
 
+	bm.detectDoubleSign(bd)
+
 	if err := bm.bp.Push(bd); err != nil {
 		logging.Console().WithFields(logrus.Fields{
 			"err":       err,
@@ -247,12 +331,15 @@ func (bm *BlockManager) push(bd *BlockData) error {
 		return err
 	}
 
+	oldLIB := bm.bc.LIB()
 	newLIB := bm.consensus.FindLIB(bm.bc)
 	err = bm.bc.SetLIB(newLIB)
 	if err != nil {
 		logging.WithFields(logrus.Fields{
 			"err": err,
 		}).Error("Failed to set LIB.")
+	} else if !byteutils.Equal(oldLIB.Hash(), newLIB.Hash()) {
+		bm.voteFinality(oldLIB, newLIB)
 	}
 
 	logging.Console().WithFields(logrus.Fields{
@@ -338,6 +425,8 @@ func (bm *BlockManager) loop() {
 			go bm.handleReceiveBlock(msg)
 		case msg := <-bm.requestBlockMessageCh:
 			go bm.handleRequestBlock(msg)
+		case msg := <-bm.receiveFinalityMessageCh:
+			go bm.handleReceiveFinalityVote(msg)
 		}
 	}
 }
@@ -358,7 +447,7 @@ func (bm *BlockManager) handleReceiveBlock(msg net.Message) {
 
 	if msg.MessageType() == MessageTypeNewBlock {
 		ts := time.Unix(bd.Timestamp(), 0)
-		now := time.Now()
+		now := Clock().Now()
 		if ts.After(now) && ts.Sub(now) > newBlockBroadcastTimeLimit || now.After(ts) && now.Sub(ts) > newBlockBroadcastTimeLimit {
 			logging.WithFields(logrus.Fields{
 				"block": bd,
@@ -451,3 +540,201 @@ func (bm *BlockManager) handleRequestBlock(msg net.Message) {
 		"parent": parent,
 	}).Debug("Responded to the download request.")
 }
+
+// SetFinalitySigner sets the signer with which the node confirms finality of blocks as a dynasty member.
+func (bm *BlockManager) SetFinalitySigner(signer FinalitySigner) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	bm.finalitySigner = signer
+}
+
+// FinalityCertificate returns the finality certificate of a block.
+func (bm *BlockManager) FinalityCertificate(hash []byte) (*FinalityCertificate, error) {
+	bm.mu.RLock()
+	defer bm.mu.RUnlock()
+	return bm.bc.FinalityCertificate(hash)
+}
+
+// PushFinalityVote pushes a finality vote.
+func (bm *BlockManager) PushFinalityVote(vote *FinalityVote) error {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	_, err := bm.addFinalityVote(vote)
+	return err
+}
+
+// voteFinality signs finality votes on blocks which became irreversible by the new LIB.
+// Votes are signed apart from the caller since the signer may be remote.
+func (bm *BlockManager) voteFinality(oldLIB *Block, newLIB *Block) {
+	signer := bm.finalitySigner
+	if signer == nil {
+		return
+	}
+	var votes []*FinalityVote
+	for block := newLIB; block != nil && block.Height() > oldLIB.Height(); block = bm.bc.BlockByHash(block.ParentHash()) {
+		if len(votes) >= maxFinalityVotesPerLIB {
+			break
+		}
+		members, err := block.State().Dynasty()
+		if err != nil {
+			logging.WithFields(logrus.Fields{
+				"err":   err,
+				"block": block,
+			}).Error("Failed to get members of dynasty.")
+			return
+		}
+		if containsAddress(members, signer.Address()) {
+			votes = append(votes, NewFinalityVote(bm.bc.ChainID(), block))
+		}
+	}
+	if len(votes) > 0 {
+		go bm.signFinalityVotes(signer, votes)
+	}
+}
+
+func (bm *BlockManager) signFinalityVotes(signer FinalitySigner, votes []*FinalityVote) {
+	for _, vote := range votes {
+		if err := signer.SignFinalityVote(vote); err != nil {
+			logging.WithFields(logrus.Fields{
+				"err":    err,
+				"height": vote.Height(),
+				"hash":   byteutils.Bytes2Hex(vote.BlockHash()),
+			}).Error("Failed to sign a finality vote.")
+			return
+		}
+		bm.mu.Lock()
+		added, err := bm.addFinalityVote(vote)
+		bm.mu.Unlock()
+		if err != nil || !added {
+			continue
+		}
+		if bm.ns != nil {
+			bm.ns.Broadcast(MessageTypeFinalityVote, vote, net.MessagePriorityNormal)
+		}
+	}
+}
+
+// addFinalityVote keeps a vote of a dynasty member on a known block until the block is certified
+// and returns whether the vote is new.
+func (bm *BlockManager) addFinalityVote(vote *FinalityVote) (bool, error) {
+	if vote.ChainID() != bm.bc.ChainID() {
+		return false, ErrInvalidChainID
+	}
+	if _, err := bm.bc.FinalityCertificate(vote.BlockHash()); err == nil {
+		return false, nil
+	}
+	block := bm.bc.BlockByHash(vote.BlockHash())
+	if block == nil {
+		return false, ErrBlockNotExist
+	}
+	if vote.Height() != block.Height() {
+		return false, ErrInvalidFinalityVote
+	}
+	voter, err := vote.RecoverSigner()
+	if err != nil {
+		logging.WithFields(logrus.Fields{
+			"err": err,
+		}).Debug("Failed to recover signer of finality vote.")
+		return false, err
+	}
+	members, err := block.State().Dynasty()
+	if err != nil {
+		return false, err
+	}
+	if !containsAddress(members, voter) {
+		return false, ErrFinalityVoterNotMember
+	}
+
+	key := byteutils.Bytes2Hex(vote.BlockHash())
+	var votes map[common.Address]*FinalityVote
+	if v, ok := bm.finalityVotes.Get(key); ok {
+		votes = v.(map[common.Address]*FinalityVote)
+	} else {
+		votes = make(map[common.Address]*FinalityVote)
+		bm.finalityVotes.Add(key, votes)
+	}
+	if _, ok := votes[voter]; ok {
+		return false, nil
+	}
+	votes[voter] = vote
+
+	bm.tryFinalize(block)
+	return true, nil
+}
+
+// tryFinalize stores a finality certificate of the block if enough dynasty members voted for it.
+func (bm *BlockManager) tryFinalize(block *Block) {
+	key := byteutils.Bytes2Hex(block.Hash())
+	v, ok := bm.finalityVotes.Get(key)
+	if !ok {
+		return
+	}
+	votes := v.(map[common.Address]*FinalityVote)
+
+	members, err := block.State().Dynasty()
+	if err != nil {
+		logging.WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Error("Failed to get members of dynasty.")
+		return
+	}
+	cert := &FinalityCertificate{
+		blockHash: block.Hash(),
+		height:    block.Height(),
+	}
+	for _, member := range members {
+		if vote, ok := votes[*member]; ok {
+			cert.votes = append(cert.votes, vote)
+		}
+	}
+	if len(cert.votes) < FinalityThreshold(len(members)) {
+		return
+	}
+
+	if err := bm.bc.PutFinalityCertificate(cert); err != nil {
+		logging.WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Error("Failed to store finality certificate.")
+		return
+	}
+	bm.finalityVotes.Remove(key)
+
+	logging.Console().WithFields(logrus.Fields{
+		"block": block,
+		"votes": len(cert.votes),
+	}).Info("Block is finalized by certificate.")
+}
+
+func (bm *BlockManager) handleReceiveFinalityVote(msg net.Message) {
+	pbVote := new(corepb.FinalityVote)
+	if err := proto.Unmarshal(msg.Data(), pbVote); err != nil {
+		logging.WithFields(logrus.Fields{
+			"err": err,
+			"msg": msg,
+		}).Debug("Failed to unmarshal finality vote.")
+		return
+	}
+	vote := new(FinalityVote)
+	if err := vote.FromProto(pbVote); err != nil {
+		return
+	}
+
+	bm.mu.Lock()
+	added, err := bm.addFinalityVote(vote)
+	bm.mu.Unlock()
+	if err != nil || !added {
+		return
+	}
+	bm.ns.Relay(MessageTypeFinalityVote, vote, net.MessagePriorityNormal)
+}
+
+func containsAddress(addrs []*common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
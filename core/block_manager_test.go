@@ -19,8 +19,13 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/medibloc/go-medibloc/common"
 	"github.com/medibloc/go-medibloc/core"
 	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/util"
 	"github.com/medibloc/go-medibloc/util/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -255,3 +260,116 @@ func TestBlockManager_InvalidHeight(t *testing.T) {
 		assert.Equal(t, test.err, bm.PushBlockData(bd), "testcase = %v", test)
 	}
 }
+
+func TestBlockManager_DetectDoubleSign(t *testing.T) {
+	m := testutil.NewMockMedlet(t)
+	bm := m.BlockManager()
+	genesis := bm.TailBlock()
+	dynasties := m.Dynasties()
+
+	var blockDatas []*core.BlockData
+	for i := 0; i < 2; i++ {
+		block := testutil.NewTestBlock(t, genesis)
+		testutil.SignBlock(t, block, dynasties)
+		blockDatas = append(blockDatas, restoreBlockData(t, block))
+	}
+	require.Equal(t, blockDatas[0].Timestamp(), blockDatas[1].Timestamp())
+	require.NotEqual(t, blockDatas[0].Hash(), blockDatas[1].Hash())
+
+	for _, bd := range blockDatas {
+		require.NoError(t, bm.PushBlockData(bd))
+	}
+	evidences := bm.PendingEvidences()
+	require.Equal(t, 1, len(evidences))
+
+	offender, err := evidences[0].Verify(testutil.ChainID)
+	require.NoError(t, err)
+	_, err = core.NewDoubleSignEvidence(blockDatas[0], blockDatas[0]).Verify(testutil.ChainID)
+	assert.Equal(t, core.ErrInvalidEvidence, err)
+
+	reporter := dynasties[0]
+	if reporter.Addr == offender {
+		reporter = dynasties[1]
+	}
+	evidenceBytes, err := evidences[0].ToBytes()
+	require.NoError(t, err)
+	payloadBuf, err := core.NewReportDoubleSignPayload(evidenceBytes).ToBytes()
+	require.NoError(t, err)
+	reportTx, err := core.NewTransaction(testutil.ChainID, reporter.Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationReportDoubleSign, payloadBuf)
+	require.NoError(t, err)
+	reportTx.SetTimestamp(blockDatas[0].Timestamp())
+	testutil.SignTx(t, reportTx, reporter.PrivKey)
+
+	st, err := bm.TailBlock().State().Clone()
+	require.NoError(t, err)
+	st.BeginBatch()
+	require.NoError(t, reportTx.ExecuteOnState(st))
+	assert.Equal(t, core.ErrEvidenceAlreadySubmitted, reportTx.ExecuteOnState(st))
+	require.NoError(t, st.Commit())
+
+	_, err = st.GetCandidate(offender)
+	assert.Equal(t, core.ErrNotFound, err)
+	submitted, err := st.HasEvidence(evidences[0].Hash())
+	require.NoError(t, err)
+	assert.True(t, submitted)
+}
+
+func TestBlockManager_FinalityCertificate(t *testing.T) {
+	m := testutil.NewMockMedlet(t)
+	bm := m.BlockManager()
+	dynasties := m.Dynasties()
+
+	bd := nextBlockData(t, bm.TailBlock(), dynasties)
+	require.NoError(t, bm.PushBlockData(bd))
+	block := bm.BlockByHash(bd.Hash())
+	require.NotNil(t, block)
+
+	members, err := block.State().Dynasty()
+	require.NoError(t, err)
+	threshold := core.FinalityThreshold(len(members))
+
+	vote := func(key signature.PrivateKey) *core.FinalityVote {
+		sig, err := crypto.NewSignature(algorithm.SECP256K1)
+		require.NoError(t, err)
+		sig.InitSign(key)
+		v := core.NewFinalityVote(bm.ChainID(), block)
+		require.NoError(t, v.SignThis(sig))
+		return v
+	}
+
+	// Votes of non-members and votes on unknown blocks are dropped, and duplicated votes are not counted.
+	assert.Equal(t, core.ErrFinalityVoterNotMember, bm.PushFinalityVote(vote(testutil.NewPrivateKey(t))))
+	unknown := nextBlockData(t, bm.TailBlock(), dynasties)
+	sig, err := crypto.NewSignature(algorithm.SECP256K1)
+	require.NoError(t, err)
+	sig.InitSign(dynasties[0].PrivKey)
+	unknownVote := new(core.FinalityVote)
+	require.NoError(t, unknownVote.FromProto(&corepb.FinalityVote{
+		ChainId:   bm.ChainID(),
+		BlockHash: unknown.Hash(),
+		Height:    unknown.Height(),
+	}))
+	require.NoError(t, unknownVote.SignThis(sig))
+	assert.Equal(t, core.ErrBlockNotExist, bm.PushFinalityVote(unknownVote))
+
+	for _, d := range dynasties[:threshold-1] {
+		v := vote(d.PrivKey)
+		require.NoError(t, bm.PushFinalityVote(v))
+		require.NoError(t, bm.PushFinalityVote(v))
+	}
+	_, err = bm.FinalityCertificate(block.Hash())
+	assert.Equal(t, core.ErrFinalityCertificateNotFound, err)
+
+	require.NoError(t, bm.PushFinalityVote(vote(dynasties[threshold-1].PrivKey)))
+	cert, err := bm.FinalityCertificate(block.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, block.Hash(), cert.BlockHash())
+	assert.Equal(t, block.Height(), cert.Height())
+	assert.Len(t, cert.Votes(), threshold)
+
+	assert.NoError(t, cert.Verify(bm.ChainID(), members))
+	assert.Equal(t, core.ErrFinalityVotesNotEnough, cert.Verify(bm.ChainID(), append(members, members...)))
+	assert.Equal(t, core.ErrFinalityVoterNotMember, cert.Verify(bm.ChainID(), members[threshold:]))
+	assert.Equal(t, core.ErrInvalidFinalityVote, cert.Verify(bm.ChainID()+1, members))
+}
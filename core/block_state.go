@@ -16,17 +16,24 @@
 package core
 
 import (
+	"bytes"
 	"sort"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/medibloc/go-medibloc/common"
 	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto/hash"
 	"github.com/medibloc/go-medibloc/storage"
 	"github.com/medibloc/go-medibloc/util"
+	"github.com/medibloc/go-medibloc/util/byteutils"
 	"github.com/medibloc/go-medibloc/util/logging"
 	"github.com/sirupsen/logrus"
 )
 
+// totalVestingKey is key of total vesting in governance state. It has the same length as proposal hashes
+// since keys of a trie should not be a prefix of another.
+var totalVestingKey = hash.Sha3256([]byte("total_vesting"))
+
 type states struct {
 	accState           *AccountStateBatch
 	txsState           *TrieBatch
@@ -35,9 +42,15 @@ type states struct {
 	consensusState     ConsensusState
 	candidacyState     *TrieBatch
 	certificationState *TrieBatch
+	issuerState        *TrieBatch
+	multisigState      *TrieBatch
+	governanceState    *TrieBatch
+	evidenceState      *TrieBatch
+	votesState         *TrieBatch
 
 	reservationQueue *ReservationQueue
-	votesCache       *votesCache
+	chainParams      *ChainParams
+	supply           *util.Uint128
 
 	storage storage.Storage
 }
@@ -78,8 +91,32 @@ func newStates(consensus Consensus, stor storage.Storage) (*states, error) {
 		return nil, err
 	}
 
+	issuerState, err := NewTrieBatch(nil, stor)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigState, err := NewTrieBatch(nil, stor)
+	if err != nil {
+		return nil, err
+	}
+
+	governanceState, err := NewTrieBatch(nil, stor)
+	if err != nil {
+		return nil, err
+	}
+
+	evidenceState, err := NewTrieBatch(nil, stor)
+	if err != nil {
+		return nil, err
+	}
+
+	votesState, err := NewTrieBatch(nil, stor)
+	if err != nil {
+		return nil, err
+	}
+
 	reservationQueue := NewEmptyReservationQueue(stor)
-	votesCache := newVotesCache()
 
 	return &states{
 		accState:           accState,
@@ -89,8 +126,14 @@ func newStates(consensus Consensus, stor storage.Storage) (*states, error) {
 		consensusState:     consensusState,
 		candidacyState:     candidacyState,
 		certificationState: certificationState,
+		issuerState:        issuerState,
+		multisigState:      multisigState,
+		governanceState:    governanceState,
+		evidenceState:      evidenceState,
+		votesState:         votesState,
 		reservationQueue:   reservationQueue,
-		votesCache:         votesCache,
+		chainParams:        DefaultChainParams(),
+		supply:             util.Uint128Zero(),
 		storage:            stor,
 	}, nil
 }
@@ -131,12 +174,35 @@ func (st *states) Clone() (*states, error) {
 		return nil, err
 	}
 
-	reservationQueue, err := LoadReservationQueue(st.storage, st.reservationQueue.Hash())
+	issuerState, err := NewTrieBatch(st.issuerState.RootHash(), st.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigState, err := NewTrieBatch(st.multisigState.RootHash(), st.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	governanceState, err := NewTrieBatch(st.governanceState.RootHash(), st.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	evidenceState, err := NewTrieBatch(st.evidenceState.RootHash(), st.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	votesState, err := NewTrieBatch(st.votesState.RootHash(), st.storage)
 	if err != nil {
 		return nil, err
 	}
 
-	votesCache := st.votesCache.Clone()
+	reservationQueue, err := LoadReservationQueue(st.storage, st.reservationQueue.Hash())
+	if err != nil {
+		return nil, err
+	}
 
 	return &states{
 		accState:           accState,
@@ -146,8 +212,14 @@ func (st *states) Clone() (*states, error) {
 		consensusState:     consensusState,
 		candidacyState:     candidacyState,
 		certificationState: certificationState,
+		issuerState:        issuerState,
+		multisigState:      multisigState,
+		governanceState:    governanceState,
+		evidenceState:      evidenceState,
+		votesState:         votesState,
 		reservationQueue:   reservationQueue,
-		votesCache:         votesCache,
+		chainParams:        st.chainParams,
+		supply:             st.supply,
 		storage:            st.storage,
 	}, nil
 }
@@ -171,6 +243,21 @@ func (st *states) BeginBatch() error {
 	if err := st.certificationState.BeginBatch(); err != nil {
 		return err
 	}
+	if err := st.issuerState.BeginBatch(); err != nil {
+		return err
+	}
+	if err := st.multisigState.BeginBatch(); err != nil {
+		return err
+	}
+	if err := st.governanceState.BeginBatch(); err != nil {
+		return err
+	}
+	if err := st.evidenceState.BeginBatch(); err != nil {
+		return err
+	}
+	if err := st.votesState.BeginBatch(); err != nil {
+		return err
+	}
 	return st.reservationQueue.BeginBatch()
 }
 
@@ -193,6 +280,24 @@ func (st *states) Commit() error {
 	if err := st.certificationState.Commit(); err != nil {
 		return err
 	}
+	if err := st.issuerState.Commit(); err != nil {
+		return err
+	}
+	if err := st.multisigState.Commit(); err != nil {
+		return err
+	}
+	if err := st.governanceState.Commit(); err != nil {
+		return err
+	}
+	if err := st.evidenceState.Commit(); err != nil {
+		return err
+	}
+	if err := st.votesState.Commit(); err != nil {
+		return err
+	}
+	if err := st.chainParams.save(st.storage); err != nil {
+		return err
+	}
 	return st.reservationQueue.Commit()
 }
 
@@ -224,10 +329,74 @@ func (st *states) CertificationRoot() []byte {
 	return st.certificationState.RootHash()
 }
 
+func (st *states) IssuerRoot() []byte {
+	return st.issuerState.RootHash()
+}
+
+func (st *states) MultisigRoot() []byte {
+	return st.multisigState.RootHash()
+}
+
+func (st *states) GovernanceRoot() []byte {
+	return st.governanceState.RootHash()
+}
+
+func (st *states) EvidenceRoot() []byte {
+	return st.evidenceState.RootHash()
+}
+
+func (st *states) VotesRoot() []byte {
+	return st.votesState.RootHash()
+}
+
 func (st *states) ReservationQueueHash() []byte {
 	return st.reservationQueue.Hash()
 }
 
+func (st *states) ChainParamsHash() ([]byte, error) {
+	return st.chainParams.Hash()
+}
+
+// ChainParams returns chain parameters of the state
+func (st *states) ChainParams() *ChainParams {
+	return st.chainParams
+}
+
+// SetChainParams replaces chain parameters of the state
+func (st *states) SetChainParams(params *ChainParams) {
+	st.chainParams = params
+}
+
+// Supply returns total amount of tokens issued
+func (st *states) Supply() *util.Uint128 {
+	return st.supply.DeepCopy()
+}
+
+// SupplyBytes returns total supply in fixed size bytes
+func (st *states) SupplyBytes() ([]byte, error) {
+	return st.supply.ToFixedSizeByteSlice()
+}
+
+// AddSupply increases total supply when tokens are minted
+func (st *states) AddSupply(amount *util.Uint128) error {
+	supply, err := st.supply.Add(amount)
+	if err != nil {
+		return err
+	}
+	st.supply = supply
+	return nil
+}
+
+// SubSupply decreases total supply when tokens are burned
+func (st *states) SubSupply(amount *util.Uint128) error {
+	supply, err := st.supply.Sub(amount)
+	if err != nil {
+		return err
+	}
+	st.supply = supply
+	return nil
+}
+
 func (st *states) LoadAccountsRoot(rootHash []byte) error {
 	accState, err := NewAccountStateBatch(rootHash, st.storage)
 	if err != nil {
@@ -291,71 +460,79 @@ func (st *states) LoadCertificationRoot(rootHash []byte) error {
 	return nil
 }
 
-func (st *states) LoadReservationQueue(hash []byte) error {
-	rq, err := LoadReservationQueue(st.storage, hash)
+func (st *states) LoadIssuerRoot(rootHash []byte) error {
+	issuerState, err := NewTrieBatch(rootHash, st.storage)
 	if err != nil {
 		return err
 	}
-	st.reservationQueue = rq
+	st.issuerState = issuerState
 	return nil
 }
 
-func (st *states) ConstructVotesCache() error {
-	votes := make(map[common.Address]*util.Uint128)
-	votesCache := newVotesCache()
+func (st *states) LoadMultisigRoot(rootHash []byte) error {
+	multisigState, err := NewTrieBatch(rootHash, st.storage)
+	if err != nil {
+		return err
+	}
+	st.multisigState = multisigState
+	return nil
+}
 
-	accIter, err := st.accState.as.accounts.Iterator(nil)
+func (st *states) LoadGovernanceRoot(rootHash []byte) error {
+	governanceState, err := NewTrieBatch(rootHash, st.storage)
 	if err != nil {
 		return err
 	}
-	exist, err := accIter.Next()
-	for exist {
-		if err != nil {
-			return err
-		}
-		accBytes := accIter.Value()
-		acc, err := loadAccount(accBytes)
-		if err != nil {
-			return err
-		}
-		votedAddr := common.BytesToAddress(acc.Voted())
-		_, err = st.GetCandidate(votedAddr)
-		if err != nil {
-			exist, err = accIter.Next()
-			continue
-		}
-		_, ok := votes[votedAddr]
-		if ok {
-			votes[votedAddr], err = votes[votedAddr].Add(acc.Vesting())
-			if err != nil {
-				return err
-			}
-		} else {
-			votes[votedAddr] = acc.Vesting()
-		}
-		exist, err = accIter.Next()
+	st.governanceState = governanceState
+	return nil
+}
+
+func (st *states) LoadEvidenceRoot(rootHash []byte) error {
+	evidenceState, err := NewTrieBatch(rootHash, st.storage)
+	if err != nil {
+		return err
 	}
-	candIter, err := st.candidacyState.Iterator(nil)
+	st.evidenceState = evidenceState
+	return nil
+}
+
+func (st *states) LoadVotesRoot(rootHash []byte) error {
+	votesState, err := NewTrieBatch(rootHash, st.storage)
 	if err != nil {
 		return err
 	}
-	exist, err = candIter.Next()
-	for exist {
-		if err != nil {
-			return err
-		}
-		candBytes := candIter.Value()
-		pbCandidate := new(corepb.Candidate)
-		if err := proto.Unmarshal(candBytes, pbCandidate); err != nil {
-			return err
-		}
-		candAddr := common.BytesToAddress(pbCandidate.Address)
-		if _, ok := votes[candAddr]; ok {
-			votesCache.AddCandidate(candAddr, votes[candAddr])
-		}
-		exist, err = candIter.Next()
+	st.votesState = votesState
+	return nil
+}
+
+func (st *states) LoadReservationQueue(hash []byte) error {
+	rq, err := LoadReservationQueue(st.storage, hash)
+	if err != nil {
+		return err
+	}
+	st.reservationQueue = rq
+	return nil
+}
+
+func (st *states) LoadSupply(b []byte) error {
+	if len(b) == 0 {
+		st.supply = util.Uint128Zero()
+		return nil
+	}
+	supply, err := util.NewUint128FromFixedSizeByteSlice(b)
+	if err != nil {
+		return err
+	}
+	st.supply = supply
+	return nil
+}
+
+func (st *states) LoadChainParams(hash []byte) error {
+	params, err := LoadChainParams(st.storage, hash)
+	if err != nil {
+		return err
 	}
-	st.votesCache = votesCache
+	st.chainParams = params
 	return nil
 }
 
@@ -414,9 +591,9 @@ func (st *states) PutTx(txHash []byte, txBytes []byte) error {
 }
 
 func (st *states) updateUsage(tx *Transaction, blockTime int64) error {
-	weekSec := int64(604800)
+	usageWindow := st.chainParams.UsageWindow()
 
-	if tx.Timestamp() < blockTime-weekSec {
+	if tx.Timestamp() < blockTime-usageWindow {
 		return ErrTooOldTransaction
 	}
 
@@ -470,7 +647,7 @@ func (st *states) updateUsage(tx *Transaction, blockTime int64) error {
 
 	var idx int
 	for idx = range pbUsage.Timestamps {
-		if blockTime-weekSec < tx.Timestamp() {
+		if blockTime-usageWindow < tx.Timestamp() {
 			break
 		}
 	}
@@ -528,9 +705,10 @@ func (st *states) Proposer() common.Address {
 	return st.consensusState.Proposer()
 }
 
-// TransitionDynasty transitions dynasty to a new one that is correct for the given time
+// TransitionDynasty transitions dynasty to a new one that is correct for the given time.
+// A new dynasty takes its size from chain parameters, or keeps the current size if chain parameters do not set it.
 func (st *states) TransitionDynasty(now int64) error {
-	if st.consensusState.Timestamp() == GenesisTimestamp {
+	if st.consensusState.Timestamp() == st.chainParams.GenesisTimestamp() {
 		cs, err := st.consensusState.GetNextStateAfterGenesis(now)
 		if err != nil {
 			return err
@@ -546,22 +724,184 @@ func (st *states) TransitionDynasty(now int64) error {
 	if err != nil && err != ErrDynastyExpired {
 		return err
 	}
-	var miners []*common.Address
-	minerNum := st.consensusState.DynastySize()
-	if len(st.votesCache.candidates) < minerNum {
-		minerNum = len(st.votesCache.candidates)
+	dynastySize := st.consensusState.DynastySize()
+	if size := st.chainParams.DynastySize(); size > 0 {
+		dynastySize = size
 	}
-	for _, candidate := range st.votesCache.candidates {
-		if candidate.candidacy {
-			miners = append(miners, &candidate.address)
-			if len(miners) == minerNum {
-				break
-			}
+	miners := st.chainParams.Validators()
+	if len(miners) == 0 {
+		miners, err = st.ElectDynasty(dynastySize)
+		if err != nil {
+			return err
+		}
+	}
+	if len(miners) == 0 {
+		return ErrNoCandidate
+	}
+	if err := st.consensusState.InitDynasty(miners, dynastySize, now); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ElectDynasty returns candidates with the most votes power up to dynastySize.
+// Candidates with the same votes power are ordered by address.
+func (st *states) ElectDynasty(dynastySize int) ([]*common.Address, error) {
+	candidates, err := st.GetCandidates()
+	if err != nil {
+		return nil, err
+	}
+	type elected struct {
+		address    common.Address
+		votesPower *util.Uint128
+	}
+	var electeds []*elected
+	for _, candidate := range candidates {
+		address := common.BytesToAddress(candidate.Address)
+		votesPower, err := st.GetVotesPower(address)
+		if err != nil {
+			return nil, err
+		}
+		electeds = append(electeds, &elected{
+			address:    address,
+			votesPower: votesPower,
+		})
+	}
+	sort.Slice(electeds, func(i, j int) bool {
+		if cmp := electeds[i].votesPower.Cmp(electeds[j].votesPower); cmp != 0 {
+			return cmp > 0
+		}
+		return bytes.Compare(electeds[i].address.Bytes(), electeds[j].address.Bytes()) < 0
+	})
+	if len(electeds) > dynastySize {
+		electeds = electeds[:dynastySize]
+	}
+	miners := make([]*common.Address, len(electeds))
+	for i, e := range electeds {
+		miners[i] = &e.address
+	}
+	return miners, nil
+}
+
+// GetCandidates returns all candidates ordered by address
+func (st *states) GetCandidates() ([]*corepb.Candidate, error) {
+	candidates := []*corepb.Candidate{}
+	iter, err := st.candidacyState.Iterator(nil)
+	if err == ErrNotFound {
+		return candidates, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	exist, err := iter.Next()
+	for exist {
+		pbCandidate := new(corepb.Candidate)
+		if err := proto.Unmarshal(iter.Value(), pbCandidate); err != nil {
+			return nil, err
 		}
+		candidates = append(candidates, pbCandidate)
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return candidates, nil
+}
+
+// GetVotesPower returns the sum of vesting of accounts which voted for the address
+func (st *states) GetVotesPower(address common.Address) (*util.Uint128, error) {
+	b, err := st.votesState.Get(address.Bytes())
+	if err == ErrNotFound {
+		return util.Uint128Zero(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return util.NewUint128FromFixedSizeByteSlice(b)
+}
+
+func (st *states) putVotesPower(address common.Address, votesPower *util.Uint128) error {
+	b, err := votesPower.ToFixedSizeByteSlice()
+	if err != nil {
+		return err
+	}
+	return st.votesState.Put(address.Bytes(), b)
+}
+
+func (st *states) addVotesPower(address common.Address, amount *util.Uint128) error {
+	votesPower, err := st.GetVotesPower(address)
+	if err != nil {
+		return err
+	}
+	votesPower, err = votesPower.Add(amount)
+	if err != nil {
+		return err
+	}
+	return st.putVotesPower(address, votesPower)
+}
+
+func (st *states) subVotesPower(address common.Address, amount *util.Uint128) error {
+	votesPower, err := st.GetVotesPower(address)
+	if err != nil {
+		return err
+	}
+	if votesPower.Cmp(amount) < 0 {
+		return ErrVotesPowerGetsMinus
+	}
+	votesPower, err = votesPower.Sub(amount)
+	if err != nil {
+		return err
+	}
+	return st.putVotesPower(address, votesPower)
+}
+
+// Liveness returns the number of produced and missed blocks of each proposer in current dynasty
+func (st *states) Liveness() ([]*ProposerLiveness, error) {
+	return st.consensusState.Liveness()
+}
+
+// PrevLiveness returns the number of produced and missed blocks of each proposer in previous dynasty
+func (st *states) PrevLiveness() ([]*ProposerLiveness, error) {
+	return st.consensusState.PrevLiveness()
+}
+
+// RemoveInactiveCandidates makes candidates which missed too many slots in current dynasty quit candidacy.
+// Candidates are not removed if the number of candidates would become smaller than dynasty size.
+func (st *states) RemoveInactiveCandidates(now int64) error {
+	maxMissed := st.chainParams.MaxMissedSlots()
+	if maxMissed == 0 {
+		return nil
+	}
+	liveness, err := st.consensusState.Liveness()
+	if err != nil {
+		return err
 	}
-	if err := st.consensusState.InitDynasty(miners, minerNum, now); err != nil {
+	candidates, err := st.GetCandidates()
+	if err != nil {
 		return err
 	}
+	numCandidates := len(candidates)
+	for _, l := range liveness {
+		if l.Missed < maxMissed || numCandidates <= st.consensusState.DynastySize() {
+			continue
+		}
+		_, err := st.GetCandidate(l.Address)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := st.QuitCandidacy(l.Address, now); err != nil {
+			return err
+		}
+		numCandidates--
+		logging.Console().WithFields(logrus.Fields{
+			"candidate": l.Address.Hex(),
+			"produced":  l.Produced,
+			"missed":    l.Missed,
+		}).Warn("Candidate is removed for missing too many slots.")
+	}
 	return nil
 }
 
@@ -586,6 +926,9 @@ func (st *states) AddCandidate(address common.Address, collateral *util.Uint128)
 	if err == nil {
 		return ErrAlreadyInCandidacy
 	}
+	if _, err := st.GetUnbondingTask(address); err == nil {
+		return ErrCollateralUnbonding
+	}
 	if err := st.SubBalance(address, collateral); err != nil {
 		return err
 	}
@@ -601,54 +944,118 @@ func (st *states) AddCandidate(address common.Address, collateral *util.Uint128)
 	if err != nil {
 		return err
 	}
-	if err := st.candidacyState.Put(address.Bytes(), candidateBytes); err != nil {
-		return err
-	}
-	if _, _, err := st.votesCache.GetCandidate(address); err == ErrCandidateNotFound {
-		st.votesCache.AddCandidate(address, util.Uint128Zero())
-		return nil
-	}
-	return st.votesCache.SetCandidacy(address, true)
+	return st.candidacyState.Put(address.Bytes(), candidateBytes)
 }
 
-// QuitCandidacy makes an account quit from candidacy
-func (st *states) QuitCandidacy(address common.Address) error {
+// SetCandidateMeta sets name, url and peer id of a candidate
+func (st *states) SetCandidateMeta(address common.Address, name, url, peerID string) error {
+	if len(name) > MaxCandidateNameLength || len(url) > MaxCandidateURLLength ||
+		len(peerID) > MaxCandidatePeerIDLength {
+		return ErrInvalidCandidateMeta
+	}
 	candidate, err := st.GetCandidate(address)
+	if err == ErrNotFound {
+		return ErrCandidateNotFound
+	}
 	if err != nil {
 		return err
 	}
-	collateral, err := util.NewUint128FromFixedSizeByteSlice(candidate.Collateral)
+	candidate.Name = name
+	candidate.Url = url
+	candidate.PeerId = peerID
+	candidateBytes, err := proto.Marshal(candidate)
 	if err != nil {
 		return err
 	}
-	if err := st.AddBalance(address, collateral); err != nil {
+	return st.candidacyState.Put(address.Bytes(), candidateBytes)
+}
+
+// QuitCandidacy makes an account quit from candidacy.
+// Collateral is locked in a reserved task until the unbonding period passes.
+func (st *states) QuitCandidacy(address common.Address, quitTime int64) error {
+	candidate, err := st.GetCandidate(address)
+	if err != nil {
 		return err
 	}
-	if err := st.candidacyState.Delete(address.Bytes()); err != nil {
+	collateral, err := util.NewUint128FromFixedSizeByteSlice(candidate.Collateral)
+	if err != nil {
 		return err
 	}
-	return st.votesCache.SetCandidacy(address, false)
-}
+	if collateral.Cmp(util.Uint128Zero()) > 0 {
+		payload, err := NewRtUnbondCollateral(collateral)
+		if err != nil {
+			return err
+		}
+		releaseTime := quitTime + st.chainParams.UnbondingPeriod()
+		if err := st.AddReservedTask(NewReservedTask(RtUnbondCollateralType, address, payload, releaseTime)); err != nil {
+			return err
+		}
+	}
+	return st.candidacyState.Delete(address.Bytes())
+}
+
+// GetUnbondingTask returns a reserved task which locks collateral of a quitted candidate
+func (st *states) GetUnbondingTask(address common.Address) (*ReservedTask, error) {
+	for _, t := range st.reservationQueue.Tasks() {
+		if t.TaskType() == RtUnbondCollateralType && t.From() == address {
+			return t, nil
+		}
+	}
+	return nil, ErrReservedTaskNotFound
+}
 
 // GetReservedTasks returns reserved tasks in reservation queue
 func (st *states) GetReservedTasks() []*ReservedTask {
 	return st.reservationQueue.Tasks()
 }
 
+// GetReservedTask returns a reserved task of which hash is equal to taskHash
+func (st *states) GetReservedTask(taskHash []byte) (*ReservedTask, error) {
+	for _, t := range st.reservationQueue.Tasks() {
+		hash, err := t.Hash()
+		if err != nil {
+			return nil, err
+		}
+		if byteutils.Equal(hash, taskHash) {
+			return t, nil
+		}
+	}
+	return nil, ErrReservedTaskNotFound
+}
+
 // AddReservedTask adds a reserved task in reservation queue
 func (st *states) AddReservedTask(task *ReservedTask) error {
 	return st.reservationQueue.AddTask(task)
 }
 
-// PopReservedTask pops reserved tasks which should be processed before 'before'
-func (st *states) PopReservedTasks(before int64) []*ReservedTask {
-	return st.reservationQueue.PopTasksBefore(before)
+// PopReservedTask pops reserved tasks which should be processed before 'before' at given height
+func (st *states) PopReservedTasks(before int64, height uint64) []*ReservedTask {
+	return st.reservationQueue.PopTasksBefore(before, height)
 }
 
 func (st *states) PeekHeadReservedTask() *ReservedTask {
 	return st.reservationQueue.Peek()
 }
 
+// ScheduleTransfer locks amount of sender's balance and reserves a task transferring it at executionTime
+func (st *states) ScheduleTransfer(from common.Address, to common.Address, amount *util.Uint128,
+	blockTime int64, executionTime int64) error {
+	if amount.Cmp(util.Uint128Zero()) == 0 {
+		return ErrVoidTransaction
+	}
+	if executionTime <= blockTime {
+		return ErrInvalidScheduledTime
+	}
+	if err := st.SubBalance(from, amount); err != nil {
+		return err
+	}
+	payload, err := NewRtTransfer(to, amount)
+	if err != nil {
+		return err
+	}
+	return st.AddReservedTask(NewReservedTask(RtTransferType, from, payload, executionTime))
+}
+
 // WithdrawVesting makes multiple reserved tasks for withdraw a certain amount of vesting
 func (st *states) WithdrawVesting(address common.Address, amount *util.Uint128, blockTime int64) error {
 	acc, err := st.GetAccount(address)
@@ -658,13 +1065,15 @@ func (st *states) WithdrawVesting(address common.Address, amount *util.Uint128,
 	if amount.Cmp(acc.Vesting()) > 0 {
 		return ErrVestingNotEnough
 	}
-	splitAmount, err := amount.Div(util.NewUint128FromUint(RtWithdrawNum))
+	withdrawNum := st.chainParams.WithdrawNum()
+	withdrawInterval := st.chainParams.WithdrawInterval()
+	splitAmount, err := amount.Div(util.NewUint128FromUint(uint64(withdrawNum)))
 	if err != nil {
 		return err
 	}
 	amountLeft := amount.DeepCopy()
 	payload := new(RtWithdraw)
-	for i := 0; i < RtWithdrawNum; i++ {
+	for i := 0; i < int(withdrawNum); i++ {
 		if amountLeft.Cmp(splitAmount) <= 0 {
 			payload, err = NewRtWithdraw(amountLeft)
 			if err != nil {
@@ -676,7 +1085,7 @@ func (st *states) WithdrawVesting(address common.Address, amount *util.Uint128,
 				return err
 			}
 		}
-		task := NewReservedTask(RtWithdrawType, address, payload, blockTime+int64(i+1)*RtWithdrawInterval)
+		task := NewReservedTask(RtWithdrawType, address, payload, blockTime+int64(i+1)*withdrawInterval)
 		if err := st.AddReservedTask(task); err != nil {
 			return err
 		}
@@ -686,70 +1095,321 @@ func (st *states) WithdrawVesting(address common.Address, amount *util.Uint128,
 }
 
 func (st *states) Vest(address common.Address, amount *util.Uint128) error {
+	voter, err := st.voterOf(address)
+	if err != nil {
+		return err
+	}
+	if err := st.withdrawVotes(voter); err != nil {
+		return err
+	}
 	if err := st.accState.SubBalance(address.Bytes(), amount); err != nil {
 		return err
 	}
 	if err := st.accState.AddVesting(address.Bytes(), amount); err != nil {
 		return err
 	}
-	voted, err := st.GetVoted(address)
-	if err == ErrNotVotedYet {
-		return nil
+	if err := st.addTotalVesting(amount); err != nil {
+		return err
 	}
+	if voter != address {
+		if err := st.accState.AddProxiedVesting(voter.Bytes(), amount); err != nil {
+			return err
+		}
+	}
+	return st.castVotes(voter)
+}
+
+func (st *states) SubVesting(address common.Address, amount *util.Uint128) error {
+	voter, err := st.voterOf(address)
 	if err != nil {
 		return err
 	}
-	return st.votesCache.AddVotesPower(voted, amount)
+	if err := st.withdrawVotes(voter); err != nil {
+		return err
+	}
+	if err := st.accState.SubVesting(address.Bytes(), amount); err != nil {
+		return err
+	}
+	if err := st.subTotalVesting(amount); err != nil {
+		return err
+	}
+	if voter != address {
+		if err := st.accState.SubProxiedVesting(voter.Bytes(), amount); err != nil {
+			return err
+		}
+	}
+	return st.castVotes(voter)
 }
 
-func (st *states) SubVesting(address common.Address, amount *util.Uint128) error {
+// voterOf returns the proxy of the account if it has delegated its voting power, or the account itself
+func (st *states) voterOf(address common.Address) (common.Address, error) {
 	acc, err := st.GetAccount(address)
+	if err == ErrNotFound {
+		return address, nil
+	}
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(acc.Proxy()) == 0 {
+		return address, nil
+	}
+	return common.BytesToAddress(acc.Proxy()), nil
+}
+
+// Delegate delegates voting power of the account to the proxy.
+// A proxy cannot delegate and an account having delegators cannot delegate, so that delegation is one level deep.
+func (st *states) Delegate(address common.Address, proxy common.Address) error {
+	if address == proxy {
+		return ErrInvalidProxy
+	}
+	voter, err := st.voterOf(address)
 	if err != nil {
 		return err
 	}
-	voted := common.BytesToAddress(acc.Voted())
-	if voted != (common.Address{}) {
-		if err := st.votesCache.SubtractVotesPower(voted, amount); err != nil {
+	if voter == proxy {
+		return ErrAlreadyDelegated
+	}
+	if delegators, err := st.delegatorsOf(address); err != nil {
+		return err
+	} else if delegators > 0 {
+		return ErrProxyChainTooDeep
+	}
+	proxyVoter, err := st.voterOf(proxy)
+	if err != nil {
+		return err
+	}
+	if proxyVoter != proxy {
+		return ErrProxyChainTooDeep
+	}
+	if voter != address {
+		if err := st.Undelegate(address); err != nil {
 			return err
 		}
 	}
-	return st.accState.SubVesting(address.Bytes(), amount)
+
+	if err := st.withdrawVotes(address); err != nil {
+		return err
+	}
+	if err := st.withdrawVotes(proxy); err != nil {
+		return err
+	}
+	acc, err := st.GetAccount(address)
+	if err != nil {
+		return err
+	}
+	if err := st.accState.SetProxy(address.Bytes(), proxy.Bytes()); err != nil {
+		return err
+	}
+	if err := st.accState.AddProxiedVesting(proxy.Bytes(), acc.Vesting()); err != nil {
+		return err
+	}
+	if err := st.accState.AddDelegator(proxy.Bytes()); err != nil {
+		return err
+	}
+	return st.castVotes(proxy)
 }
 
-func (st *states) Vote(address common.Address, voted common.Address) error {
-	if _, err := st.GetCandidate(voted); err != nil {
+// Undelegate takes back voting power delegated to the proxy.
+func (st *states) Undelegate(address common.Address) error {
+	proxy, err := st.voterOf(address)
+	if err != nil {
+		return err
+	}
+	if proxy == address {
+		return ErrNotDelegated
+	}
+	if err := st.withdrawVotes(proxy); err != nil {
+		return err
+	}
+	acc, err := st.GetAccount(address)
+	if err != nil {
+		return err
+	}
+	if err := st.accState.SubProxiedVesting(proxy.Bytes(), acc.Vesting()); err != nil {
 		return err
 	}
+	if err := st.accState.SubDelegator(proxy.Bytes()); err != nil {
+		return err
+	}
+	if err := st.accState.SetProxy(address.Bytes(), nil); err != nil {
+		return err
+	}
+	if err := st.castVotes(proxy); err != nil {
+		return err
+	}
+	return st.castVotes(address)
+}
+
+func (st *states) delegatorsOf(address common.Address) (uint32, error) {
 	acc, err := st.GetAccount(address)
+	if err == ErrNotFound {
+		return 0, nil
+	}
 	if err != nil {
+		return 0, err
+	}
+	return acc.Delegators(), nil
+}
+
+// Vote replaces candidates voted by the account.
+// Voting power of the account is split equally into the candidates.
+func (st *states) Vote(address common.Address, candidates []common.Address) error {
+	if len(candidates) == 0 {
+		return ErrNotVotedYet
+	}
+	if voter, err := st.voterOf(address); err != nil {
 		return err
+	} else if voter != address {
+		return ErrVotingPowerDelegated
 	}
-	oldVoted := common.BytesToAddress(acc.Voted())
-	if oldVoted == voted {
-		return ErrVoteDuplicate
+	if uint32(len(candidates)) > st.chainParams.MaxVotes() {
+		return ErrTooManyVotes
 	}
-	if oldVoted != (common.Address{}) {
-		if err := st.votesCache.SubtractVotesPower(oldVoted, acc.Vesting()); err != nil {
+	voted := make([][]byte, 0, len(candidates))
+	seen := make(map[common.Address]bool)
+	for _, candidate := range candidates {
+		if seen[candidate] {
+			return ErrVoteDuplicate
+		}
+		seen[candidate] = true
+		if _, err := st.GetCandidate(candidate); err != nil {
 			return err
 		}
+		voted = append(voted, candidate.Bytes())
+	}
+	oldVoted, err := st.GetVoted(address)
+	if err != nil && err != ErrNotVotedYet {
+		return err
+	}
+	if len(oldVoted) == len(candidates) {
+		same := true
+		for i := range candidates {
+			if oldVoted[i] != candidates[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return ErrVoteDuplicate
+		}
+	}
+	if err := st.withdrawVotes(address); err != nil {
+		return err
 	}
-	if err := st.accState.SetVoted(address.Bytes(), voted.Bytes()); err != nil {
+	if err := st.accState.SetVoted(address.Bytes(), voted); err != nil {
 		return err
 	}
-	return st.votesCache.AddVotesPower(voted, acc.Vesting())
+	return st.castVotes(address)
 }
 
-func (st *states) GetVoted(address common.Address) (common.Address, error) {
+// Unvote cancels all votes of the account.
+// Votes of an account delegating its voting power are already withdrawn and are cast again on undelegation,
+// so they cannot be cancelled until then.
+func (st *states) Unvote(address common.Address) error {
+	if voter, err := st.voterOf(address); err != nil {
+		return err
+	} else if voter != address {
+		return ErrVotingPowerDelegated
+	}
+	if _, err := st.GetVoted(address); err != nil {
+		return err
+	}
+	if err := st.withdrawVotes(address); err != nil {
+		return err
+	}
+	return st.accState.SetVoted(address.Bytes(), nil)
+}
+
+// GetVoted returns candidates voted by the account
+func (st *states) GetVoted(address common.Address) ([]common.Address, error) {
 	votedBytes, err := st.accState.GetVoted(address.Bytes())
 	if err != nil {
-		return common.Address{}, err
+		return nil, err
+	}
+	voted := make([]common.Address, len(votedBytes))
+	for i, v := range votedBytes {
+		voted[i] = common.BytesToAddress(v)
+	}
+	return voted, nil
+}
+
+// castVotes adds the account's current voting power to voted candidates.
+func (st *states) castVotes(address common.Address) error {
+	return st.applyVotes(address, st.addVotesPower)
+}
+
+// withdrawVotes subtracts the account's current voting power from voted candidates.
+func (st *states) withdrawVotes(address common.Address) error {
+	return st.applyVotes(address, st.subVotesPower)
+}
+
+func (st *states) applyVotes(address common.Address, apply func(common.Address, *util.Uint128) error) error {
+	voted, err := st.GetVoted(address)
+	if err == ErrNotVotedYet {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	acc, err := st.GetAccount(address)
+	if err != nil {
+		return err
+	}
+	votingPower, err := acc.VotingPower()
+	if err != nil {
+		return err
+	}
+	shares, err := splitVotesPower(votingPower, len(voted))
+	if err != nil {
+		return err
+	}
+	for i, candidate := range voted {
+		if err := apply(candidate, shares[i]); err != nil {
+			return err
+		}
 	}
-	return common.BytesToAddress(votedBytes), nil
+	return nil
+}
+
+// splitVotesPower splits voting power into n shares. The remainder is given to the leading shares one by one.
+func splitVotesPower(votingPower *util.Uint128, n int) ([]*util.Uint128, error) {
+	num := util.NewUint128FromUint(uint64(n))
+	share, err := votingPower.Div(num)
+	if err != nil {
+		return nil, err
+	}
+	total, err := share.Mul(num)
+	if err != nil {
+		return nil, err
+	}
+	remainder, err := votingPower.Sub(total)
+	if err != nil {
+		return nil, err
+	}
+	shares := make([]*util.Uint128, n)
+	one := util.NewUint128FromUint(1)
+	for i := range shares {
+		shares[i] = share.DeepCopy()
+		if remainder.Cmp(util.Uint128Zero()) > 0 {
+			if shares[i], err = shares[i].Add(one); err != nil {
+				return nil, err
+			}
+			if remainder, err = remainder.Sub(one); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return shares, nil
 }
 
 func (st *states) AddCertification(hash []byte,
 	issuerAddr common.Address, certifiedAddr common.Address,
-	issueTime int64, expirationTime int64) error {
+	issueTime int64, expirationTime int64, certType string) error {
+	if certType != "" {
+		if err := st.checkIssuerCertType(issuerAddr, certType); err != nil {
+			return err
+		}
+	}
 	if err := st.accState.AddCertReceived(certifiedAddr.Bytes(), hash); err != nil {
 		return err
 	}
@@ -757,21 +1417,19 @@ func (st *states) AddCertification(hash []byte,
 		return err
 	}
 	pbCertification := &corepb.Certification{
-		CertificateHash: hash,
-		Issuer:          issuerAddr.Bytes(),
-		Certified:       certifiedAddr.Bytes(),
-		IssueTime:       issueTime,
-		ExpirationTime:  expirationTime,
-		RevocationTime:  int64(0),
+		CertificateHash:   hash,
+		Issuer:            issuerAddr.Bytes(),
+		Certified:         certifiedAddr.Bytes(),
+		IssueTime:         issueTime,
+		ExpirationTime:    expirationTime,
+		RevocationTime:    int64(0),
+		CertificationType: certType,
 	}
 	certificationBytes, err := proto.Marshal(pbCertification)
 	if err != nil {
 		return err
 	}
-	if err := st.certificationState.Put(hash, certificationBytes); err != nil {
-		return err
-	}
-	return nil
+	return st.certificationState.Put(hash, certificationBytes)
 }
 
 func (st *states) RevokeCertification(hash []byte, revoker common.Address, revokeTime int64) error {
@@ -812,10 +1470,459 @@ func (st *states) GetCertification(hash []byte) (*corepb.Certification, error) {
 	return pbCertification, nil
 }
 
+func (st *states) GetIssuer(address common.Address) (*corepb.Issuer, error) {
+	issuerBytes, err := st.issuerState.Get(address.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	pbIssuer := new(corepb.Issuer)
+	if err := proto.Unmarshal(issuerBytes, pbIssuer); err != nil {
+		return nil, err
+	}
+	return pbIssuer, nil
+}
+
+func (st *states) putIssuer(pbIssuer *corepb.Issuer) error {
+	_, err := st.issuerState.Get(pbIssuer.Address)
+	if err != nil && err != ErrNotFound {
+		return err
+	}
+	if err == nil {
+		return ErrIssuerAlreadyRegistered
+	}
+	issuerBytes, err := proto.Marshal(pbIssuer)
+	if err != nil {
+		return err
+	}
+	return st.issuerState.Put(pbIssuer.Address, issuerBytes)
+}
+
+// AddRootIssuer registers a root issuer which can register or deregister other issuers
+func (st *states) AddRootIssuer(address common.Address, name string, certTypes []string) error {
+	return st.putIssuer(&corepb.Issuer{
+		Address:          address.Bytes(),
+		Name:             name,
+		CertTypes:        certTypes,
+		Root:             true,
+		Registrar:        nil,
+		RegistrationTime: st.chainParams.GenesisTimestamp(),
+	})
+}
+
+// RegisterIssuer registers an issuer of certifications. Registrar should be a root issuer.
+func (st *states) RegisterIssuer(registrar common.Address, address common.Address,
+	name string, certTypes []string, registrationTime int64) error {
+	if err := st.checkRootIssuer(registrar); err != nil {
+		return err
+	}
+	return st.putIssuer(&corepb.Issuer{
+		Address:          address.Bytes(),
+		Name:             name,
+		CertTypes:        certTypes,
+		Root:             false,
+		Registrar:        registrar.Bytes(),
+		RegistrationTime: registrationTime,
+	})
+}
+
+// DeregisterIssuer removes an issuer from the registry. Root issuers cannot be deregistered.
+func (st *states) DeregisterIssuer(deregistrar common.Address, address common.Address) error {
+	if err := st.checkRootIssuer(deregistrar); err != nil {
+		return err
+	}
+	issuer, err := st.GetIssuer(address)
+	if err == ErrNotFound {
+		return ErrIssuerNotRegistered
+	}
+	if err != nil {
+		return err
+	}
+	if issuer.Root {
+		return ErrCannotDeregisterRootIssuer
+	}
+	return st.issuerState.Delete(address.Bytes())
+}
+
+func (st *states) checkRootIssuer(address common.Address) error {
+	issuer, err := st.GetIssuer(address)
+	if err == ErrNotFound {
+		return ErrNotRootIssuer
+	}
+	if err != nil {
+		return err
+	}
+	if !issuer.Root {
+		return ErrNotRootIssuer
+	}
+	return nil
+}
+
+func (st *states) checkIssuerCertType(address common.Address, certType string) error {
+	issuer, err := st.GetIssuer(address)
+	if err == ErrNotFound {
+		return ErrIssuerNotRegistered
+	}
+	if err != nil {
+		return err
+	}
+	if len(issuer.CertTypes) == 0 {
+		return nil
+	}
+	for _, t := range issuer.CertTypes {
+		if t == certType {
+			return nil
+		}
+	}
+	return ErrCertTypeNotAllowed
+}
+
+// TotalVesting returns sum of vesting of all accounts
+func (st *states) TotalVesting() (*util.Uint128, error) {
+	b, err := st.governanceState.Get(totalVestingKey)
+	if err == ErrNotFound {
+		return util.NewUint128(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return util.NewUint128FromFixedSizeByteSlice(b)
+}
+
+func (st *states) putTotalVesting(total *util.Uint128) error {
+	b, err := total.ToFixedSizeByteSlice()
+	if err != nil {
+		return err
+	}
+	return st.governanceState.Put(totalVestingKey, b)
+}
+
+func (st *states) addTotalVesting(amount *util.Uint128) error {
+	total, err := st.TotalVesting()
+	if err != nil {
+		return err
+	}
+	total, err = total.Add(amount)
+	if err != nil {
+		return err
+	}
+	return st.putTotalVesting(total)
+}
+
+func (st *states) subTotalVesting(amount *util.Uint128) error {
+	total, err := st.TotalVesting()
+	if err != nil {
+		return err
+	}
+	total, err = total.Sub(amount)
+	if err != nil {
+		return err
+	}
+	return st.putTotalVesting(total)
+}
+
+func (st *states) GetProposal(hash []byte) (*corepb.Proposal, error) {
+	proposalBytes, err := st.governanceState.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	pbProposal := new(corepb.Proposal)
+	if err := proto.Unmarshal(proposalBytes, pbProposal); err != nil {
+		return nil, err
+	}
+	return pbProposal, nil
+}
+
+func (st *states) putProposal(pbProposal *corepb.Proposal) error {
+	proposalBytes, err := proto.Marshal(pbProposal)
+	if err != nil {
+		return err
+	}
+	return st.governanceState.Put(pbProposal.Hash, proposalBytes)
+}
+
+// SubmitProposal registers a proposal changing chain parameters and reserves its tally at the end of voting period.
+// submitTime should be the time of the block including the proposal.
+// If chain parameters define validators, a validators change must be proposed alone as validators decide it.
+func (bs *BlockState) SubmitProposal(hash []byte, proposer common.Address, changes []*corepb.ParamChange, submitTime int64) error {
+	if len(changes) == 0 {
+		return ErrEmptyProposal
+	}
+	if len(changes) > 1 && changesValidators(changes) && len(bs.chainParams.Validators()) > 0 {
+		return ErrValidatorsProposalNotAlone
+	}
+	if _, err := bs.chainParams.Apply(changes, bs.height); err != nil {
+		return err
+	}
+	_, err := bs.governanceState.Get(hash)
+	if err != nil && err != ErrNotFound {
+		return err
+	}
+	if err == nil {
+		return ErrProposalAlreadyExist
+	}
+	votingEndTime := submitTime + bs.chainParams.ProposalPeriod()
+	pbProposal := &corepb.Proposal{
+		Hash:          hash,
+		Proposer:      proposer.Bytes(),
+		Changes:       changes,
+		SubmitTime:    submitTime,
+		VotingEndTime: votingEndTime,
+		Status:        ProposalStatusVoting,
+	}
+	if err := bs.putProposal(pbProposal); err != nil {
+		return err
+	}
+	payload, err := NewRtTallyProposal(hash)
+	if err != nil {
+		return err
+	}
+	return bs.AddReservedTask(NewReservedTask(RtTallyProposalType, proposer, payload, votingEndTime))
+}
+
+// VoteProposal records a vote on a proposal. Voting again replaces the previous vote.
+// voteTime should be the time of the block including the vote.
+func (st *states) VoteProposal(hash []byte, voter common.Address, approve bool, voteTime int64) error {
+	pbProposal, err := st.GetProposal(hash)
+	if err == ErrNotFound {
+		return ErrProposalNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if pbProposal.Status != ProposalStatusVoting || voteTime >= pbProposal.VotingEndTime {
+		return ErrProposalVotingClosed
+	}
+	acc, err := st.GetAccount(voter)
+	if err != nil {
+		return err
+	}
+	if acc.Vesting().Cmp(util.Uint128Zero()) == 0 {
+		validator, err := st.isCurrentValidator(voter)
+		if err != nil {
+			return err
+		}
+		if !validator {
+			return ErrNoVotingPower
+		}
+	}
+	for _, v := range pbProposal.Votes {
+		if byteutils.Equal(v.Voter, voter.Bytes()) {
+			v.Approve = approve
+			return st.putProposal(pbProposal)
+		}
+	}
+	pbProposal.Votes = append(pbProposal.Votes, &corepb.ProposalVote{
+		Voter:   voter.Bytes(),
+		Approve: approve,
+	})
+	return st.putProposal(pbProposal)
+}
+
+// ApplyProposal applies changes of a passed proposal on chain parameters.
+// A proposal whose changes became invalid by then is marked as failed.
+func (bs *BlockState) ApplyProposal(hash []byte) error {
+	pbProposal, err := bs.GetProposal(hash)
+	if err != nil {
+		return err
+	}
+	if pbProposal.Status != ProposalStatusPassed {
+		return ErrProposalNotPassed
+	}
+	pbProposal.Status = ProposalStatusFailed
+	params, err := bs.chainParams.Apply(pbProposal.Changes, bs.height)
+	if err == nil {
+		bs.chainParams = params
+		pbProposal.Status = ProposalStatusApplied
+	}
+	return bs.putProposal(pbProposal)
+}
+
+// TallyProposal closes voting on a proposal weighting votes by current vesting of voters.
+// A proposal passes if votes reach quorum of total vesting and approvals outweigh rejections,
+// and its changes are reserved to be applied after activation delay.
+// A proposal changing validators also needs approvals of more than 2/3 of current validators.
+// If chain parameters define validators, such a proposal is decided by the validators alone.
+func (bs *BlockState) TallyProposal(hash []byte) error {
+	pbProposal, err := bs.GetProposal(hash)
+	if err != nil {
+		return err
+	}
+	pbProposal.Status = ProposalStatusRejected
+	if changesValidators(pbProposal.Changes) && len(bs.chainParams.Validators()) > 0 {
+		approved, err := bs.validatorsApproved(pbProposal)
+		if err != nil {
+			return err
+		}
+		if !approved {
+			return bs.putProposal(pbProposal)
+		}
+		return bs.passProposal(pbProposal)
+	}
+
+	approval, rejection := util.NewUint128(), util.NewUint128()
+	for _, v := range pbProposal.Votes {
+		acc, err := bs.GetAccount(common.BytesToAddress(v.Voter))
+		if err != nil {
+			return err
+		}
+		if v.Approve {
+			approval, err = approval.Add(acc.Vesting())
+		} else {
+			rejection, err = rejection.Add(acc.Vesting())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	quorumReached, err := bs.quorumReached(approval, rejection)
+	if err != nil {
+		return err
+	}
+	if !quorumReached || approval.Cmp(rejection) <= 0 {
+		return bs.putProposal(pbProposal)
+	}
+	if changesValidators(pbProposal.Changes) {
+		approved, err := bs.validatorsApproved(pbProposal)
+		if err != nil {
+			return err
+		}
+		if !approved {
+			return bs.putProposal(pbProposal)
+		}
+	}
+	return bs.passProposal(pbProposal)
+}
+
+// passProposal marks a proposal as passed and reserves its application after activation delay
+func (bs *BlockState) passProposal(pbProposal *corepb.Proposal) error {
+	pbProposal.Status = ProposalStatusPassed
+	pbProposal.ActivationHeight = bs.height + bs.chainParams.ProposalActivationDelay()
+	if err := bs.putProposal(pbProposal); err != nil {
+		return err
+	}
+	payload, err := NewRtApplyProposal(pbProposal.Hash)
+	if err != nil {
+		return err
+	}
+	return bs.AddReservedTask(NewReservedTaskAtHeight(RtApplyProposalType,
+		common.BytesToAddress(pbProposal.Proposer), payload, bs.timestamp, pbProposal.ActivationHeight))
+}
+
+// currentValidators returns validators of chain parameters, or the elected dynasty if validators are not set
+func (st *states) currentValidators() ([]*common.Address, error) {
+	if validators := st.chainParams.Validators(); len(validators) > 0 {
+		return validators, nil
+	}
+	return st.consensusState.Dynasty()
+}
+
+func (st *states) isCurrentValidator(address common.Address) (bool, error) {
+	validators, err := st.currentValidators()
+	if err != nil {
+		return false, err
+	}
+	for _, v := range validators {
+		if *v == address {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// validatorsApproved returns true if more than 2/3 of current validators approved the proposal
+func (st *states) validatorsApproved(pbProposal *corepb.Proposal) (bool, error) {
+	validators, err := st.currentValidators()
+	if err != nil {
+		return false, err
+	}
+	approvers := 0
+	for _, v := range validators {
+		for _, vote := range pbProposal.Votes {
+			if vote.Approve && byteutils.Equal(vote.Voter, v.Bytes()) {
+				approvers++
+				break
+			}
+		}
+	}
+	return approvers*3 > len(validators)*2, nil
+}
+
+func changesValidators(changes []*corepb.ParamChange) bool {
+	for _, change := range changes {
+		if change.Name == ChainParamValidators {
+			return true
+		}
+	}
+	return false
+}
+
+// quorumReached returns true if votes are at least quorum percentage of total vesting
+func (bs *BlockState) quorumReached(approval, rejection *util.Uint128) (bool, error) {
+	turnout, err := approval.Add(rejection)
+	if err != nil {
+		return false, err
+	}
+	total, err := bs.TotalVesting()
+	if err != nil {
+		return false, err
+	}
+	if total.Cmp(util.Uint128Zero()) == 0 {
+		return false, nil
+	}
+	turnout, err = turnout.Mul(util.NewUint128FromUint(100))
+	if err != nil {
+		return false, err
+	}
+	required, err := total.Mul(util.NewUint128FromUint(uint64(bs.chainParams.ProposalQuorum())))
+	if err != nil {
+		return false, err
+	}
+	return turnout.Cmp(required) >= 0, nil
+}
+
+func (st *states) GetMultisig(address common.Address) (*corepb.Multisig, error) {
+	multisigBytes, err := st.multisigState.Get(address.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	pbMultisig := new(corepb.Multisig)
+	if err := proto.Unmarshal(multisigBytes, pbMultisig); err != nil {
+		return nil, err
+	}
+	return pbMultisig, nil
+}
+
+// CreateMultisig creates a multisig account owned by weighted owners
+func (st *states) CreateMultisig(address common.Address, threshold uint32, owners []*corepb.MultisigOwner) error {
+	_, err := st.multisigState.Get(address.Bytes())
+	if err != nil && err != ErrNotFound {
+		return err
+	}
+	if err == nil {
+		return ErrMultisigAlreadyExist
+	}
+	if err := validateMultisigOwners(threshold, owners); err != nil {
+		return err
+	}
+	pbMultisig := &corepb.Multisig{
+		Address:   address.Bytes(),
+		Threshold: threshold,
+		Owners:    owners,
+	}
+	multisigBytes, err := proto.Marshal(pbMultisig)
+	if err != nil {
+		return err
+	}
+	return st.multisigState.Put(address.Bytes(), multisigBytes)
+}
+
 // BlockState possesses every states a block should have
 type BlockState struct {
 	*states
 	snapshot *states
+
+	height    uint64
+	timestamp int64
 }
 
 // NewBlockState creates a new block state
@@ -837,11 +1944,48 @@ func (bs *BlockState) Clone() (*BlockState, error) {
 		return nil, err
 	}
 	return &BlockState{
-		states:   states,
-		snapshot: nil,
+		states:    states,
+		snapshot:  nil,
+		height:    bs.height,
+		timestamp: bs.timestamp,
 	}, nil
 }
 
+// Height returns height of the block which the state belongs to
+func (bs *BlockState) Height() uint64 {
+	return bs.height
+}
+
+// Timestamp returns timestamp of the block which the state belongs to
+func (bs *BlockState) Timestamp() int64 {
+	return bs.timestamp
+}
+
+// GetCertification returns a certification. It is expired once the block time reaches its expiration time.
+func (bs *BlockState) GetCertification(hash []byte) (*corepb.Certification, error) {
+	pbCertification, err := bs.states.GetCertification(hash)
+	if err != nil {
+		return nil, err
+	}
+	if pbCertification.ExpirationTime > 0 && pbCertification.ExpirationTime <= bs.timestamp {
+		pbCertification.Expired = true
+	}
+	return pbCertification, nil
+}
+
+// RevokeCertification revokes a certification which has not expired yet.
+func (bs *BlockState) RevokeCertification(hash []byte, revoker common.Address, revokeTime int64) error {
+	if pbCertification, err := bs.GetCertification(hash); err == nil && pbCertification.Expired {
+		return ErrCertAlreadyExpired
+	}
+	return bs.states.RevokeCertification(hash, revoker, revokeTime)
+}
+
+// IsForkActive returns true if a fork is active at the height of the block
+func (bs *BlockState) IsForkActive(name string) bool {
+	return bs.chainParams.IsForkActive(name, bs.height)
+}
+
 // BeginBatch begins batch
 func (bs *BlockState) BeginBatch() error {
 	snapshot, err := bs.states.Clone()
@@ -916,6 +2060,130 @@ func (bs *BlockState) AcceptTransaction(tx *Transaction, blockTime int64) error
 	return bs.incrementNonce(tx.from)
 }
 
+// CancelReservedTask removes a reserved task owned by owner and reverts what was done by reserving it
+func (bs *BlockState) CancelReservedTask(owner common.Address, taskHash []byte) error {
+	task, err := bs.GetReservedTask(taskHash)
+	if err != nil {
+		return err
+	}
+	if task.From() != owner {
+		return ErrNotReservedTaskOwner
+	}
+	if !task.Cancellable() {
+		return ErrReservedTaskNotCancellable
+	}
+	if _, err := bs.reservationQueue.RemoveTask(taskHash); err != nil {
+		return err
+	}
+	return task.CancelOnState(bs)
+}
+
+// SlashDoubleSign burns all collateral of a double signing proposer and removes its candidacy.
+// Collateral which is still in unbonding period is also slashed.
+func (bs *BlockState) SlashDoubleSign(evidence *DoubleSignEvidence, chainID uint32, reportTime int64) error {
+	offender, err := evidence.Verify(chainID)
+	if err != nil {
+		return err
+	}
+	if evidence.Timestamp()+bs.chainParams.UnbondingPeriod() < reportTime {
+		return ErrEvidenceTooOld
+	}
+	hash := evidence.Hash()
+	_, err = bs.evidenceState.Get(hash)
+	if err != nil && err != ErrNotFound {
+		return err
+	}
+	if err == nil {
+		return ErrEvidenceAlreadySubmitted
+	}
+
+	var slashed *util.Uint128
+	candidate, err := bs.GetCandidate(offender)
+	switch err {
+	case nil:
+		slashed, err = util.NewUint128FromFixedSizeByteSlice(candidate.Collateral)
+		if err != nil {
+			return err
+		}
+		if err := bs.candidacyState.Delete(offender.Bytes()); err != nil {
+			return err
+		}
+	case ErrNotFound:
+		task, err := bs.GetUnbondingTask(offender)
+		if err == ErrReservedTaskNotFound {
+			return ErrCandidateNotFound
+		}
+		if err != nil {
+			return err
+		}
+		taskHash, err := task.Hash()
+		if err != nil {
+			return err
+		}
+		if _, err := bs.reservationQueue.RemoveTask(taskHash); err != nil {
+			return err
+		}
+		slashed = task.payload.(*RtUnbondCollateral).Amount
+	default:
+		return err
+	}
+
+	if err := bs.evidenceState.Put(hash, offender.Bytes()); err != nil {
+		return err
+	}
+	if err := bs.SubSupply(slashed); err != nil {
+		return err
+	}
+	logging.Console().WithFields(logrus.Fields{
+		"offender":   offender.Hex(),
+		"timestamp":  evidence.Timestamp(),
+		"collateral": slashed,
+	}).Warn("Proposer is slashed for double signing.")
+	return nil
+}
+
+// PayBlockReward mints block reward of the height to coinbase
+func (bs *BlockState) PayBlockReward(coinbase common.Address) error {
+	reward := bs.chainParams.BlockReward(bs.height)
+	if reward.Cmp(util.Uint128Zero()) == 0 {
+		return nil
+	}
+	if err := bs.AddBalance(coinbase, reward); err != nil {
+		return err
+	}
+	return bs.AddSupply(reward)
+}
+
+// HasEvidence returns true if evidence of the hash is already submitted
+func (st *states) HasEvidence(hash []byte) (bool, error) {
+	_, err := st.evidenceState.Get(hash)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (bs *BlockState) checkSigners(tx *Transaction) error {
+	multisig, err := bs.GetMultisig(tx.from)
+	if err == ErrNotFound {
+		if len(tx.multiSign) > 0 {
+			return ErrNotMultisigAccount
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	signers, err := tx.recoverMultiSigners()
+	if err != nil {
+		return err
+	}
+	return verifyMultisigSigners(multisig, signers)
+}
+
 func (bs *BlockState) checkNonce(tx *Transaction) error {
 	fromAcc, err := bs.GetAccount(tx.from)
 	if err != nil {
@@ -16,6 +16,7 @@
 package core_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -176,6 +177,46 @@ func TestDynastyState(t *testing.T) {
 	assert.True(t, equalSlice(expected, actual))
 }
 
+func TestElectDynasty(t *testing.T) {
+	genesis, dynasties, distributed := testutil.NewTestGenesisBlock(t)
+	first := distributed[len(distributed)-1].Addr
+	second := distributed[len(distributed)-2].Addr
+
+	elect := func(candidates []common.Address) (*core.BlockState, []*common.Address) {
+		st, err := genesis.State().Clone()
+		assert.NoError(t, err)
+		st.BeginBatch()
+		for _, candidate := range candidates {
+			assert.NoError(t, st.AddCandidate(candidate, util.Uint128Zero()))
+		}
+		assert.NoError(t, st.Vest(first, util.NewUint128FromUint(200)))
+		assert.NoError(t, st.Vote(first, []common.Address{first}))
+		assert.NoError(t, st.Vest(second, util.NewUint128FromUint(100)))
+		assert.NoError(t, st.Vote(second, []common.Address{second}))
+		assert.NoError(t, st.Commit())
+
+		miners, err := st.ElectDynasty(len(dynasties))
+		assert.NoError(t, err)
+		return st, miners
+	}
+
+	st1, miners1 := elect([]common.Address{first, second})
+	st2, miners2 := elect([]common.Address{second, first})
+	assert.Equal(t, st1.VotesRoot(), st2.VotesRoot())
+	assert.Equal(t, miners1, miners2)
+
+	assert.Len(t, miners1, len(dynasties))
+	assert.Equal(t, first, *miners1[0])
+	assert.Equal(t, second, *miners1[1])
+	for i := 3; i < len(miners1); i++ {
+		assert.True(t, bytes.Compare(miners1[i-1].Bytes(), miners1[i].Bytes()) < 0)
+	}
+
+	votesPower, err := st1.GetVotesPower(first)
+	assert.NoError(t, err)
+	assert.Equal(t, util.NewUint128FromUint(200), votesPower)
+}
+
 func TestAddCandidate(t *testing.T) {
 	genesis, dynasty, distributed := testutil.NewTestGenesisBlock(t)
 
@@ -221,7 +262,7 @@ func TestCloneState(t *testing.T) {
 	assert.NoError(t, st.AddRecord(addRecordTx, []byte("recordHash"), users[0].Addr))
 	assert.NoError(t, st.Vest(users[1].Addr, util.NewUint128FromUint(100)))
 	assert.NoError(t, st.SubVesting(users[1].Addr, util.NewUint128FromUint(10)))
-	assert.NoError(t, st.Vote(users[2].Addr, users[3].Addr))
+	assert.NoError(t, st.Vote(users[2].Addr, []common.Address{users[3].Addr}))
 
 	st.Commit()
 
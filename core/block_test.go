@@ -22,13 +22,18 @@ import (
 	"github.com/medibloc/go-medibloc/common"
 	"github.com/medibloc/go-medibloc/consensus/dpos"
 	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
 	"github.com/medibloc/go-medibloc/crypto"
 	"github.com/medibloc/go-medibloc/crypto/signature"
 	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
 	"github.com/medibloc/go-medibloc/medlet"
+	"github.com/medibloc/go-medibloc/storage"
 	"github.com/medibloc/go-medibloc/util"
+	"github.com/medibloc/go-medibloc/util/byteutils"
 	"github.com/medibloc/go-medibloc/util/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 )
 
 func TestNewBlock(t *testing.T) {
@@ -206,8 +211,8 @@ func TestExecuteOnParentBlock(t *testing.T) {
 	secondBlock, err := core.NewBlock(testutil.ChainID, coinbase, firstBlock)
 	assert.NoError(t, err)
 
-	nextBlockTime := (firstBlock.Timestamp()/int64(dpos.DynastyInterval/time.Second) + 1) * int64(dpos.DynastyInterval/time.Second)
-	deadline, err := dpos.CheckDeadline(firstBlock, time.Unix(nextBlockTime, 0))
+	nextBlockTime := (firstBlock.Timestamp()/int64(dpos.DefaultDynastyInterval/time.Second) + 1) * int64(dpos.DefaultDynastyInterval/time.Second)
+	deadline, err := dpos.DefaultParams().CheckDeadline(firstBlock, time.Unix(nextBlockTime, 0))
 	assert.NoError(t, err)
 	secondBlock.BeginBatch()
 	secondBlock.SetTimestamp(deadline.Unix())
@@ -282,7 +287,7 @@ func TestGetExecutedBlock(t *testing.T) {
 	cfg.Chain.Coinbase = "02fc22ea22d02fc2469f5ec8fab44bc3de42dda2bf9ebc0c0055a9eb7df579056c"
 	cfg.Chain.Miner = "02fc22ea22d02fc2469f5ec8fab44bc3de42dda2bf9ebc0c0055a9eb7df579056c"
 
-	consensus, err := dpos.New(cfg)
+	consensus, err := dpos.New(cfg, nil)
 	assert.NoError(t, err)
 	executedBlock, err := bd.GetExecutedBlock(consensus, newBlock.Storage())
 	assert.NoError(t, err)
@@ -340,10 +345,10 @@ func TestExecuteReservedTasks(t *testing.T) {
 	for i := 0; i < len(tasks); i++ {
 		assert.Equal(t, core.RtWithdrawType, tasks[i].TaskType())
 		assert.Equal(t, from, tasks[i].From())
-		assert.Equal(t, withdrawTx.Timestamp()+int64(i+1)*core.RtWithdrawInterval, tasks[i].Timestamp())
+		assert.Equal(t, withdrawTx.Timestamp()+int64(i+1)*genesis.State().ChainParams().WithdrawInterval(), tasks[i].Timestamp())
 	}
 
-	newBlock.SetTimestamp(newBlock.Timestamp() + int64(2)*core.RtWithdrawInterval)
+	newBlock.SetTimestamp(newBlock.Timestamp() + int64(2)*genesis.State().ChainParams().WithdrawInterval())
 	newBlock.BeginBatch()
 	assert.NoError(t, newBlock.ExecuteReservedTasks())
 	newBlock.Commit()
@@ -356,7 +361,7 @@ func TestExecuteReservedTasks(t *testing.T) {
 	assert.Equal(t, 1, len(tasks))
 	assert.Equal(t, core.RtWithdrawType, tasks[0].TaskType())
 	assert.Equal(t, from, tasks[0].From())
-	assert.Equal(t, withdrawTx.Timestamp()+int64(3)*core.RtWithdrawInterval, tasks[0].Timestamp())
+	assert.Equal(t, withdrawTx.Timestamp()+int64(3)*genesis.State().ChainParams().WithdrawInterval(), tasks[0].Timestamp())
 }
 
 func TestBlock_VerifyState(t *testing.T) {
@@ -388,3 +393,538 @@ func TestBlock_VerifyState(t *testing.T) {
 	_, err = bd.ExecuteOnParentBlock(wrongGenesis)
 	assert.Error(t, err)
 }
+
+func TestScheduledTransfer(t *testing.T) {
+	genesis, dynasties, users := testutil.NewTestGenesisBlock(t)
+	from := dynasties[0].Addr
+	recipient := users[len(users)-1]
+	to := recipient.Addr
+
+	payload := core.NewScheduleTransferPayload(int64(5000))
+	payloadBuf, err := payload.ToBytes()
+	assert.NoError(t, err)
+	scheduleTx, err := core.NewTransaction(testutil.ChainID, from, to,
+		util.NewUint128FromUint(100), 1, core.TxOperationScheduleTransfer, payloadBuf)
+	assert.NoError(t, err)
+	scheduleTx.SetTimestamp(int64(1000))
+	testutil.SignTx(t, scheduleTx, dynasties[0].PrivKey)
+
+	// Execution time must be after the block time, regardless of the tx timestamp.
+	backdatedPayloadBuf, err := core.NewScheduleTransferPayload(int64(1000)).ToBytes()
+	assert.NoError(t, err)
+	backdatedTx, err := core.NewTransaction(testutil.ChainID, from, to,
+		util.NewUint128FromUint(100), 1, core.TxOperationScheduleTransfer, backdatedPayloadBuf)
+	assert.NoError(t, err)
+	backdatedTx.SetTimestamp(int64(500))
+	testutil.SignTx(t, backdatedTx, dynasties[0].PrivKey)
+
+	newBlock, err := core.NewBlock(testutil.ChainID, from, genesis)
+	assert.NoError(t, err)
+	newBlock.SetTimestamp(int64(1000))
+
+	newBlock.BeginBatch()
+	assert.Equal(t, core.ErrInvalidScheduledTime, newBlock.ExecuteTransaction(backdatedTx))
+	assert.NoError(t, newBlock.ExecuteTransaction(scheduleTx))
+	assert.NoError(t, newBlock.AcceptTransaction(scheduleTx))
+	assert.NoError(t, newBlock.ExecuteReservedTasks())
+	newBlock.Commit()
+
+	state := newBlock.State()
+	acc, err := state.GetAccount(from)
+	assert.NoError(t, err)
+	assert.Equal(t, util.NewUint128FromUint(uint64(1000000000-100)), acc.Balance())
+	acc, err = state.GetAccount(to)
+	assert.NoError(t, err)
+	assert.Equal(t, util.NewUint128FromUint(uint64(1000000000)), acc.Balance())
+	tasks := state.GetReservedTasks()
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, core.RtTransferType, tasks[0].TaskType())
+	assert.Equal(t, int64(5000), tasks[0].Timestamp())
+
+	newBlock.SetTimestamp(int64(5000))
+	newBlock.BeginBatch()
+	assert.NoError(t, newBlock.ExecuteReservedTasks())
+	newBlock.Commit()
+
+	acc, err = state.GetAccount(to)
+	assert.NoError(t, err)
+	assert.Equal(t, util.NewUint128FromUint(uint64(1000000000+100)), acc.Balance())
+	assert.Equal(t, 0, len(state.GetReservedTasks()))
+}
+
+func TestCollateralUnbonding(t *testing.T) {
+	genesis, _, users := testutil.NewTestGenesisBlock(t)
+	user := users[len(users)-1]
+	period := genesis.State().ChainParams().UnbondingPeriod()
+
+	becomeTx, err := core.NewTransaction(testutil.ChainID, user.Addr, common.Address{},
+		util.NewUint128FromUint(10), 1, core.TxOperationBecomeCandidate, []byte{})
+	require.NoError(t, err)
+	becomeTx.SetTimestamp(int64(1000))
+	testutil.SignTx(t, becomeTx, user.PrivKey)
+	quitTx, err := core.NewTransaction(testutil.ChainID, user.Addr, common.Address{},
+		util.Uint128Zero(), 2, core.TxOperationQuitCandidacy, []byte{})
+	require.NoError(t, err)
+	// a backdated transaction cannot shorten unbonding period which starts at block time
+	quitTx.SetTimestamp(int64(1))
+	testutil.SignTx(t, quitTx, user.PrivKey)
+	againTx, err := core.NewTransaction(testutil.ChainID, user.Addr, common.Address{},
+		util.NewUint128FromUint(10), 3, core.TxOperationBecomeCandidate, []byte{})
+	require.NoError(t, err)
+	againTx.SetTimestamp(int64(1000))
+	testutil.SignTx(t, againTx, user.PrivKey)
+
+	newBlock, err := core.NewBlock(testutil.ChainID, user.Addr, genesis)
+	require.NoError(t, err)
+	newBlock.SetTimestamp(int64(1000))
+
+	newBlock.BeginBatch()
+	require.NoError(t, newBlock.ExecuteTransaction(becomeTx))
+	require.NoError(t, newBlock.AcceptTransaction(becomeTx))
+	require.NoError(t, newBlock.ExecuteTransaction(quitTx))
+	require.NoError(t, newBlock.AcceptTransaction(quitTx))
+	assert.Equal(t, core.ErrCollateralUnbonding, newBlock.ExecuteTransaction(againTx))
+	require.NoError(t, newBlock.ExecuteReservedTasks())
+	newBlock.Commit()
+
+	state := newBlock.State()
+	acc, err := state.GetAccount(user.Addr)
+	require.NoError(t, err)
+	assert.Equal(t, util.NewUint128FromUint(uint64(1000000000-10)), acc.Balance())
+
+	newBlock.SetTimestamp(int64(1) + period)
+	newBlock.BeginBatch()
+	require.NoError(t, newBlock.ExecuteReservedTasks())
+	newBlock.Commit()
+	_, err = state.GetUnbondingTask(user.Addr)
+	assert.NoError(t, err)
+
+	newBlock.SetTimestamp(int64(1000) + period)
+	newBlock.BeginBatch()
+	require.NoError(t, newBlock.ExecuteReservedTasks())
+	newBlock.Commit()
+
+	acc, err = state.GetAccount(user.Addr)
+	require.NoError(t, err)
+	assert.Equal(t, util.NewUint128FromUint(uint64(1000000000)), acc.Balance())
+	_, err = state.GetUnbondingTask(user.Addr)
+	assert.Equal(t, core.ErrReservedTaskNotFound, err)
+
+	newBlock.BeginBatch()
+	assert.NoError(t, newBlock.ExecuteTransaction(againTx))
+	newBlock.RollBack()
+}
+
+func TestSlashUnbondingCollateral(t *testing.T) {
+	genesis, dynasties, users := testutil.NewTestGenesisBlock(t)
+	offender := users[len(users)-1]
+
+	becomeTx, err := core.NewTransaction(testutil.ChainID, offender.Addr, common.Address{},
+		util.NewUint128FromUint(10), 1, core.TxOperationBecomeCandidate, []byte{})
+	require.NoError(t, err)
+	testutil.SignTx(t, becomeTx, offender.PrivKey)
+	quitTx, err := core.NewTransaction(testutil.ChainID, offender.Addr, common.Address{},
+		util.Uint128Zero(), 2, core.TxOperationQuitCandidacy, []byte{})
+	require.NoError(t, err)
+	testutil.SignTx(t, quitTx, offender.PrivKey)
+
+	sig, err := crypto.NewSignature(algorithm.SECP256K1)
+	require.NoError(t, err)
+	sig.InitSign(offender.PrivKey)
+	first := testutil.NewTestBlock(t, genesis)
+	require.NoError(t, first.SignThis(sig))
+	second := testutil.NewTestBlock(t, genesis)
+	require.NoError(t, second.SignThis(sig))
+	evidence := core.NewDoubleSignEvidence(first.BlockData, second.BlockData)
+	evidenceBytes, err := evidence.ToBytes()
+	require.NoError(t, err)
+	payloadBuf, err := core.NewReportDoubleSignPayload(evidenceBytes).ToBytes()
+	require.NoError(t, err)
+	reportTx, err := core.NewTransaction(testutil.ChainID, dynasties[0].Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationReportDoubleSign, payloadBuf)
+	require.NoError(t, err)
+	reportTx.SetTimestamp(first.Timestamp())
+	testutil.SignTx(t, reportTx, dynasties[0].PrivKey)
+
+	// A report in a block after the unbonding period is too old, even with a backdated tx.
+	late, err := core.NewBlock(testutil.ChainID, dynasties[0].Addr, genesis)
+	require.NoError(t, err)
+	require.NoError(t, late.SetTimestamp(first.Timestamp()+genesis.State().ChainParams().UnbondingPeriod()+1))
+	late.BeginBatch()
+	assert.Equal(t, core.ErrEvidenceTooOld, late.ExecuteTransaction(reportTx))
+	late.RollBack()
+
+	st, err := genesis.State().Clone()
+	require.NoError(t, err)
+	st.BeginBatch()
+	require.NoError(t, becomeTx.ExecuteOnState(st))
+	require.NoError(t, st.AcceptTransaction(becomeTx, genesis.Timestamp()))
+	require.NoError(t, quitTx.ExecuteOnState(st))
+	require.NoError(t, st.AcceptTransaction(quitTx, genesis.Timestamp()))
+	require.NoError(t, reportTx.ExecuteOnState(st))
+	require.NoError(t, st.Commit())
+
+	_, err = st.GetUnbondingTask(offender.Addr)
+	assert.Equal(t, core.ErrReservedTaskNotFound, err)
+	acc, err := st.GetAccount(offender.Addr)
+	require.NoError(t, err)
+	assert.Equal(t, util.NewUint128FromUint(uint64(1000000000-10)), acc.Balance())
+}
+
+func TestRemoveInactiveCandidates(t *testing.T) {
+	conf, _, users := testutil.NewTestGenesisConf(t)
+	conf.ChainParams.MaxMissedSlots = 1
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	genesis, err := core.NewGenesisBlock(conf, testutil.NewTestConsensus(t), stor)
+	require.NoError(t, err)
+	block := testutil.NewTestBlock(t, genesis)
+
+	user := users[len(users)-1]
+	becomeTx, err := core.NewTransaction(testutil.ChainID, user.Addr, common.Address{},
+		util.NewUint128FromUint(10), 1, core.TxOperationBecomeCandidate, []byte{})
+	require.NoError(t, err)
+	testutil.SignTx(t, becomeTx, user.PrivKey)
+
+	st, err := block.State().Clone()
+	require.NoError(t, err)
+	members, err := st.Dynasty()
+	require.NoError(t, err)
+	interval := int64(dpos.DefaultBlockInterval / time.Second)
+	missed, err := dpos.DefaultParams().FindProposer(block.Timestamp()+interval, members)
+	require.NoError(t, err)
+
+	st.BeginBatch()
+	require.NoError(t, becomeTx.ExecuteOnState(st))
+	require.NoError(t, st.TransitionDynasty(block.Timestamp()+2*interval))
+	require.NoError(t, st.RemoveInactiveCandidates(block.Timestamp()+2*interval))
+	require.NoError(t, st.Commit())
+
+	_, err = st.GetCandidate(missed)
+	assert.Equal(t, core.ErrNotFound, err)
+	_, err = st.GetCandidate(user.Addr)
+	assert.NoError(t, err)
+	for _, member := range members {
+		if *member == missed {
+			continue
+		}
+		_, err = st.GetCandidate(*member)
+		assert.NoError(t, err)
+	}
+}
+
+func TestDynastySizeByProposal(t *testing.T) {
+	genesis, dynasties, users := testutil.NewTestGenesisBlock(t)
+	block := testutil.NewTestBlock(t, genesis)
+	holder := users[len(users)-1]
+	assert.Equal(t, len(dynasties), block.State().ChainParams().DynastySize())
+
+	_, err := block.State().ChainParams().Apply([]*corepb.ParamChange{
+		{Name: core.ChainParamDynastySize, Value: "0"},
+	}, block.Height())
+	assert.Equal(t, core.ErrInvalidChainParams, err)
+
+	st, err := block.State().Clone()
+	require.NoError(t, err)
+	st.BeginBatch()
+	require.NoError(t, st.Vest(holder.Addr, util.NewUint128FromUint(1000)))
+	hash := make([]byte, 32)
+	require.NoError(t, st.SubmitProposal(hash, holder.Addr, []*corepb.ParamChange{
+		{Name: core.ChainParamDynastySize, Value: "3"},
+	}, st.Timestamp()))
+	require.NoError(t, st.VoteProposal(hash, holder.Addr, true, st.Timestamp()))
+	require.NoError(t, st.TallyProposal(hash))
+	require.NoError(t, st.ApplyProposal(hash))
+	assert.Equal(t, 3, st.ChainParams().DynastySize())
+	assert.Equal(t, len(dynasties), st.DynastySize())
+
+	// The new size is applied from the next dynasty.
+	require.NoError(t, st.TransitionDynasty(block.Timestamp()+2*int64(dpos.DefaultDynastyInterval/time.Second)))
+	require.NoError(t, st.Commit())
+	assert.Equal(t, 3, st.DynastySize())
+	members, err := st.Dynasty()
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(members))
+}
+
+func TestBlockReward(t *testing.T) {
+	conf, _, users := testutil.NewTestGenesisConf(t)
+	conf.ChainParams.BlockReward = "100"
+	conf.ChainParams.RewardSchedule = []*corepb.RewardStage{{Height: 3, Reward: "50"}}
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	genesis, err := core.NewGenesisBlock(conf, testutil.NewTestConsensus(t), stor)
+	require.NoError(t, err)
+
+	expected := util.Uint128Zero()
+	for _, dist := range conf.TokenDistribution {
+		balance, err := util.NewUint128FromString(dist.Value)
+		require.NoError(t, err)
+		expected, err = expected.Add(balance)
+		require.NoError(t, err)
+	}
+	supply, err := genesis.Supply()
+	require.NoError(t, err)
+	assert.Equal(t, expected, supply)
+
+	coinbase := users[len(users)-1].Addr
+	acc, err := genesis.State().GetAccount(coinbase)
+	require.NoError(t, err)
+	balance := acc.Balance()
+
+	parent := genesis
+	for _, reward := range []uint64{100, 50, 50} {
+		block, err := core.NewBlock(testutil.ChainID, coinbase, parent)
+		require.NoError(t, err)
+		require.NoError(t, block.SetTimestamp(parent.Timestamp()+int64(dpos.DefaultBlockInterval/time.Second)))
+		require.NoError(t, block.BeginBatch())
+		require.NoError(t, block.State().TransitionDynasty(block.Timestamp()))
+		require.NoError(t, block.ExecuteReservedTasks())
+		require.NoError(t, block.PayReward())
+		require.NoError(t, block.Commit())
+		require.NoError(t, block.Seal())
+
+		executed, err := block.GetBlockData().ExecuteOnParentBlock(parent)
+		require.NoError(t, err)
+
+		expected, err = expected.Add(util.NewUint128FromUint(reward))
+		require.NoError(t, err)
+		supply, err := executed.Supply()
+		require.NoError(t, err)
+		assert.Equal(t, expected, supply)
+		assert.Equal(t, expected, executed.State().Supply())
+
+		balance, err = balance.Add(util.NewUint128FromUint(reward))
+		require.NoError(t, err)
+		acc, err := executed.State().GetAccount(coinbase)
+		require.NoError(t, err)
+		assert.Equal(t, balance, acc.Balance())
+		parent = executed
+	}
+}
+
+func TestCancelScheduledTransfer(t *testing.T) {
+	genesis, dynasties, users := testutil.NewTestGenesisBlock(t)
+	from := dynasties[0].Addr
+	recipient := users[len(users)-1]
+	to := recipient.Addr
+
+	payload := core.NewScheduleTransferPayload(int64(5000))
+	payloadBuf, err := payload.ToBytes()
+	assert.NoError(t, err)
+	scheduleTx, err := core.NewTransaction(testutil.ChainID, from, to,
+		util.NewUint128FromUint(100), 1, core.TxOperationScheduleTransfer, payloadBuf)
+	assert.NoError(t, err)
+	scheduleTx.SetTimestamp(int64(1000))
+	testutil.SignTx(t, scheduleTx, dynasties[0].PrivKey)
+
+	st, err := genesis.State().Clone()
+	assert.NoError(t, err)
+	st.BeginBatch()
+	assert.NoError(t, scheduleTx.ExecuteOnState(st))
+	assert.NoError(t, st.AcceptTransaction(scheduleTx, genesis.Timestamp()))
+	st.Commit()
+
+	taskHash, err := st.GetReservedTasks()[0].Hash()
+	assert.NoError(t, err)
+	cancelPayload := core.NewCancelReservedTaskPayload(taskHash)
+	cancelPayloadBuf, err := cancelPayload.ToBytes()
+	assert.NoError(t, err)
+
+	notOwnerTx, err := core.NewTransaction(testutil.ChainID, to, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationCancelReservedTask, cancelPayloadBuf)
+	assert.NoError(t, err)
+	testutil.SignTx(t, notOwnerTx, recipient.PrivKey)
+	cancelTx, err := core.NewTransaction(testutil.ChainID, from, common.Address{},
+		util.Uint128Zero(), 2, core.TxOperationCancelReservedTask, cancelPayloadBuf)
+	assert.NoError(t, err)
+	testutil.SignTx(t, cancelTx, dynasties[0].PrivKey)
+
+	st.BeginBatch()
+	assert.Equal(t, core.ErrNotReservedTaskOwner, notOwnerTx.ExecuteOnState(st))
+	assert.NoError(t, cancelTx.ExecuteOnState(st))
+	assert.NoError(t, st.AcceptTransaction(cancelTx, genesis.Timestamp()))
+	st.Commit()
+
+	acc, err := st.GetAccount(from)
+	assert.NoError(t, err)
+	assert.Equal(t, util.NewUint128FromUint(uint64(1000000000)), acc.Balance())
+	assert.Equal(t, 0, len(st.GetReservedTasks()))
+}
+
+func TestCertificationAutoExpiry(t *testing.T) {
+	genesis, dynasties, users := testutil.NewTestGenesisBlock(t)
+	issuer := dynasties[0]
+	hash := []byte("certificate hash")
+
+	payload := core.NewAddCertificationPayload(int64(1000), int64(5000), hash)
+	payloadBuf, err := payload.ToBytes()
+	assert.NoError(t, err)
+	addCertTx, err := core.NewTransaction(testutil.ChainID, issuer.Addr, users[len(users)-1].Addr,
+		util.Uint128Zero(), 1, core.TxOperationAddCertification, payloadBuf)
+	assert.NoError(t, err)
+	testutil.SignTx(t, addCertTx, issuer.PrivKey)
+
+	newBlock, err := core.NewBlock(testutil.ChainID, issuer.Addr, genesis)
+	assert.NoError(t, err)
+	newBlock.SetTimestamp(int64(1000))
+
+	newBlock.BeginBatch()
+	assert.NoError(t, newBlock.ExecuteTransaction(addCertTx))
+	assert.NoError(t, newBlock.AcceptTransaction(addCertTx))
+	assert.NoError(t, newBlock.ExecuteReservedTasks())
+	newBlock.Commit()
+
+	cert, err := newBlock.State().GetCertification(hash)
+	assert.NoError(t, err)
+	assert.False(t, cert.Expired)
+	// Expiration does not add a reserved task for each certification.
+	assert.Empty(t, newBlock.State().GetReservedTasks())
+
+	newBlock.SetTimestamp(int64(5000))
+	cert, err = newBlock.State().GetCertification(hash)
+	assert.NoError(t, err)
+	assert.True(t, cert.Expired)
+	assert.Equal(t, core.ErrCertAlreadyExpired,
+		newBlock.State().RevokeCertification(hash, issuer.Addr, int64(6000)))
+}
+
+func TestForkSchedule(t *testing.T) {
+	conf, dynasties, _ := testutil.NewTestGenesisConf(t)
+	conf.ChainParams = &corepb.ChainParams{
+		Forks: []*corepb.Fork{{Name: core.ForkDelegation, Height: 3}},
+	}
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	genesis, err := core.NewGenesisBlock(conf, testutil.NewTestConsensus(t), stor)
+	require.NoError(t, err)
+
+	height, ok := genesis.State().ChainParams().ForkHeight(core.ForkDelegation)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(3), height)
+	assert.False(t, genesis.IsForkActive(core.ForkDelegation))
+	assert.False(t, genesis.IsForkActive("unknown_fork"))
+
+	from := dynasties[0]
+	newForkedTx := func(nonce uint64) *core.Transaction {
+		tx, err := core.NewTransaction(testutil.ChainID, from.Addr, dynasties[1].Addr,
+			util.Uint128Zero(), nonce, core.TxOperationDelegate, []byte{})
+		require.NoError(t, err)
+		testutil.SignTx(t, tx, from.PrivKey)
+		return tx
+	}
+
+	block2, err := core.NewBlock(testutil.ChainID, from.Addr, genesis)
+	require.NoError(t, err)
+	assert.False(t, block2.IsForkActive(core.ForkDelegation))
+	assert.False(t, block2.IsForkActive(core.ForkMultisig))
+	block2.BeginBatch()
+	assert.Equal(t, core.ErrTxTypeNotActivated, block2.ExecuteTransaction(newForkedTx(1)))
+	block2.RollBack()
+
+	block2.SetTimestamp(genesis.Timestamp() + 1)
+	require.NoError(t, block2.Seal())
+	block3, err := core.NewBlock(testutil.ChainID, from.Addr, block2)
+	require.NoError(t, err)
+	assert.True(t, block3.IsForkActive(core.ForkDelegation))
+	block3.BeginBatch()
+	assert.NoError(t, block3.ExecuteTransaction(newForkedTx(1)))
+	block3.Commit()
+
+	conf.ChainParams.Forks = append(conf.ChainParams.Forks, &corepb.Fork{Name: core.ForkDelegation, Height: 5})
+	_, err = core.NewGenesisBlock(conf, testutil.NewTestConsensus(t), stor)
+	assert.Equal(t, core.ErrDuplicatedFork, err)
+}
+
+func TestReplayInitialHeaderBlock(t *testing.T) {
+	conf, _, _ := testutil.NewTestGenesisConf(t)
+	var forks []*corepb.Fork
+	for _, fork := range conf.ChainParams.Forks {
+		if fork.Name != core.ForkExtendedHeader {
+			forks = append(forks, fork)
+		}
+	}
+	conf.ChainParams.Forks = forks
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	genesis, err := core.NewGenesisBlock(conf, testutil.NewTestConsensus(t), stor)
+	require.NoError(t, err)
+
+	block := testutil.NewTestBlock(t, genesis)
+	assert.Equal(t, core.BlockHeaderV0, block.Version())
+
+	// The hash covers the same fields as blocks made before the extended header.
+	hasher := sha3.New256()
+	hasher.Write(block.ParentHash())
+	hasher.Write(block.Coinbase().Bytes())
+	hasher.Write(block.AccountsRoot())
+	hasher.Write(block.TransactionsRoot())
+	hasher.Write(block.UsageRoot())
+	hasher.Write(block.RecordsRoot())
+	hasher.Write(block.CandidacyRoot())
+	hasher.Write(block.CertificationRoot())
+	hasher.Write(block.ConsensusRoot())
+	hasher.Write(block.ReservationQueueHash())
+	hasher.Write(byteutils.FromInt64(block.Timestamp()))
+	hasher.Write(byteutils.FromUint32(block.ChainID()))
+	assert.Equal(t, hasher.Sum(nil), block.Hash())
+
+	// A block in the old format has none of the extended header fields.
+	msg, err := block.GetBlockData().ToProto()
+	require.NoError(t, err)
+	pbBlock := msg.(*corepb.Block)
+	pbBlock.Header.IssuerRoot = nil
+	pbBlock.Header.MultisigRoot = nil
+	pbBlock.Header.GovernanceRoot = nil
+	pbBlock.Header.EvidenceRoot = nil
+	pbBlock.Header.VotesRoot = nil
+	pbBlock.Header.ChainParamsHash = nil
+	pbBlock.Header.Supply = nil
+	old := new(core.BlockData)
+	require.NoError(t, old.FromProto(pbBlock))
+	require.NoError(t, old.VerifyIntegrity())
+
+	replayed, err := old.ExecuteOnParentBlock(genesis)
+	require.NoError(t, err)
+	assert.Equal(t, block.Hash(), replayed.Hash())
+	assert.Equal(t, block.ChainParamsHash(), replayed.ChainParamsHash())
+
+	// A header of the extended version is not valid before the fork.
+	pbBlock.Header.Version = core.BlockHeaderV1
+	extended := new(core.BlockData)
+	require.NoError(t, extended.FromProto(pbBlock))
+	_, err = extended.ExecuteOnParentBlock(genesis)
+	assert.Equal(t, core.ErrInvalidBlockHeaderVersion, err)
+}
+
+func TestScheduleForkByProposal(t *testing.T) {
+	params := core.DefaultChainParams()
+	updated, err := params.Apply([]*corepb.ParamChange{
+		{Name: core.ChainParamForkPrefix + "test_fork", Value: "100"},
+	}, 10)
+	require.NoError(t, err)
+	assert.False(t, params.IsForkActive("test_fork", 100))
+	assert.False(t, updated.IsForkActive("test_fork", 99))
+	assert.True(t, updated.IsForkActive("test_fork", 100))
+
+	_, err = params.Apply([]*corepb.ParamChange{
+		{Name: core.ChainParamForkPrefix, Value: "100"},
+	}, 10)
+	assert.Equal(t, core.ErrInvalidChainParams, err)
+
+	_, err = params.Apply([]*corepb.ParamChange{
+		{Name: core.ChainParamForkPrefix + "test_fork", Value: "10"},
+	}, 10)
+	assert.Equal(t, core.ErrForkHeightPassed, err)
+
+	rescheduled, err := updated.Apply([]*corepb.ParamChange{
+		{Name: core.ChainParamForkPrefix + "test_fork", Value: "200"},
+	}, 99)
+	require.NoError(t, err)
+	assert.False(t, rescheduled.IsForkActive("test_fork", 100))
+
+	_, err = updated.Apply([]*corepb.ParamChange{
+		{Name: core.ChainParamForkPrefix + "test_fork", Value: "200"},
+	}, 100)
+	assert.Equal(t, core.ErrForkAlreadyActive, err)
+}
@@ -27,8 +27,9 @@ import (
 )
 
 const (
-	tailBlockKey = "blockchain_tail"
-	libKey       = "blockchain_lib"
+	tailBlockKey          = "blockchain_tail"
+	libKey                = "blockchain_lib"
+	finalityCertKeyPrefix = "finality_"
 )
 
 // BlockChain manages blockchain structure.
@@ -144,6 +145,11 @@ func (bc *BlockChain) ChainID() uint32 {
 	return bc.chainID
 }
 
+// Storage returns storage of the chain.
+func (bc *BlockChain) Storage() storage.Storage {
+	return bc.storage
+}
+
 // BlockByHash returns a block of given hash.
 func (bc *BlockChain) BlockByHash(hash []byte) *Block {
 	block, err := bc.loadBlockByHash(hash)
@@ -245,6 +251,43 @@ func (bc *BlockChain) SetLIB(newLIB *Block) error {
 	return nil
 }
 
+// PutFinalityCertificate stores a finality certificate of a block.
+func (bc *BlockChain) PutFinalityCertificate(cert *FinalityCertificate) error {
+	msg, err := cert.ToProto()
+	if err != nil {
+		return err
+	}
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return bc.storage.Put(finalityCertKey(cert.BlockHash()), value)
+}
+
+// FinalityCertificate returns the finality certificate of a block.
+func (bc *BlockChain) FinalityCertificate(hash []byte) (*FinalityCertificate, error) {
+	value, err := bc.storage.Get(finalityCertKey(hash))
+	if err == storage.ErrKeyNotFound {
+		return nil, ErrFinalityCertificateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	pbCert := new(corepb.FinalityCertificate)
+	if err := proto.Unmarshal(value, pbCert); err != nil {
+		return nil, err
+	}
+	cert := new(FinalityCertificate)
+	if err := cert.FromProto(pbCert); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+func finalityCertKey(hash []byte) []byte {
+	return append([]byte(finalityCertKeyPrefix), hash...)
+}
+
 // SetTailBlock sets tail block.
 func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 	ancestor, err := bc.FindAncestorOnCanonical(newTail, true)
@@ -0,0 +1,567 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"golang.org/x/crypto/sha3"
+)
+
+// ChainParams is a versioned set of economics and time parameters stored in state
+type ChainParams struct {
+	version          uint64
+	genesisTimestamp int64
+	withdrawNum      uint32
+	withdrawInterval int64
+	usageWindow      int64
+	proposalPeriod   int64
+	forks            map[string]uint64
+	minCollateral    *util.Uint128
+	unbondingPeriod  int64
+	maxMissedSlots   uint64
+	maxVotes         uint32
+	blockReward      *util.Uint128
+	rewardSchedule   []*rewardStage
+	validators       []common.Address
+
+	proposalQuorum          uint32
+	proposalActivationDelay uint64
+	dynastySize             uint32
+}
+
+type rewardStage struct {
+	height uint64
+	reward *util.Uint128
+}
+
+// DefaultChainParams returns chain parameters used when genesis does not specify them
+func DefaultChainParams() *ChainParams {
+	minCollateral, _ := util.NewUint128FromString(DefaultMinCollateral)
+	blockReward, _ := util.NewUint128FromString(DefaultBlockReward)
+	return &ChainParams{
+		version:          1,
+		genesisTimestamp: DefaultGenesisTimestamp,
+		withdrawNum:      DefaultRtWithdrawNum,
+		withdrawInterval: DefaultRtWithdrawInterval,
+		usageWindow:      DefaultUsageWindow,
+		proposalPeriod:   DefaultProposalPeriod,
+		forks:            make(map[string]uint64),
+		minCollateral:    minCollateral,
+		unbondingPeriod:  DefaultUnbondingPeriod,
+		maxVotes:         DefaultMaxVotes,
+		blockReward:      blockReward,
+
+		proposalQuorum:          DefaultProposalQuorum,
+		proposalActivationDelay: DefaultProposalActivationDelay,
+	}
+}
+
+// NewChainParamsFromGenesis returns chain parameters of genesis. Omitted fields are set to default values.
+func NewChainParamsFromGenesis(conf *corepb.Genesis) (*ChainParams, error) {
+	params := DefaultChainParams()
+	params.dynastySize = conf.GetMeta().GetDynastySize()
+	pbParams := conf.GetChainParams()
+	if pbParams == nil {
+		return params, nil
+	}
+	if pbParams.DynastySize != 0 && pbParams.DynastySize != params.dynastySize {
+		return nil, ErrInvalidChainParams
+	}
+	params.genesisTimestamp = pbParams.GenesisTimestamp
+	if pbParams.WithdrawNum != 0 {
+		params.withdrawNum = pbParams.WithdrawNum
+	}
+	if pbParams.WithdrawInterval != 0 {
+		params.withdrawInterval = pbParams.WithdrawInterval
+	}
+	if pbParams.UsageWindow != 0 {
+		params.usageWindow = pbParams.UsageWindow
+	}
+	if pbParams.ProposalPeriod != 0 {
+		params.proposalPeriod = pbParams.ProposalPeriod
+	}
+	for _, fork := range pbParams.Forks {
+		if _, ok := params.forks[fork.Name]; ok {
+			return nil, ErrDuplicatedFork
+		}
+		params.forks[fork.Name] = fork.Height
+	}
+	if pbParams.MinCollateral != "" {
+		minCollateral, err := util.NewUint128FromString(pbParams.MinCollateral)
+		if err != nil {
+			return nil, ErrInvalidChainParams
+		}
+		params.minCollateral = minCollateral
+	}
+	if pbParams.UnbondingPeriod != 0 {
+		params.unbondingPeriod = pbParams.UnbondingPeriod
+	}
+	params.maxMissedSlots = pbParams.MaxMissedSlots
+	if pbParams.MaxVotes != 0 {
+		params.maxVotes = pbParams.MaxVotes
+	}
+	if pbParams.BlockReward != "" {
+		blockReward, err := util.NewUint128FromString(pbParams.BlockReward)
+		if err != nil {
+			return nil, ErrInvalidChainParams
+		}
+		params.blockReward = blockReward
+	}
+	rewardSchedule, err := rewardScheduleFromProto(pbParams.RewardSchedule)
+	if err != nil {
+		return nil, ErrInvalidChainParams
+	}
+	params.rewardSchedule = rewardSchedule
+	validators, err := validatorsFromHex(pbParams.Validators)
+	if err != nil {
+		return nil, err
+	}
+	params.validators = validators
+	if pbParams.ProposalQuorum != 0 {
+		params.proposalQuorum = pbParams.ProposalQuorum
+	}
+	if pbParams.ProposalActivationDelay != 0 {
+		params.proposalActivationDelay = pbParams.ProposalActivationDelay
+	}
+	if err := params.verify(); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// ToProto converts ChainParams to corepb.ChainParams
+func (p *ChainParams) ToProto() (proto.Message, error) {
+	names := make([]string, 0, len(p.forks))
+	for name := range p.forks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var forks []*corepb.Fork
+	for _, name := range names {
+		forks = append(forks, &corepb.Fork{
+			Name:   name,
+			Height: p.forks[name],
+		})
+	}
+	var rewardSchedule []*corepb.RewardStage
+	for _, stage := range p.rewardSchedule {
+		rewardSchedule = append(rewardSchedule, &corepb.RewardStage{
+			Height: stage.height,
+			Reward: stage.reward.String(),
+		})
+	}
+	var validators []string
+	for _, v := range p.validators {
+		validators = append(validators, v.Hex())
+	}
+	return &corepb.ChainParams{
+		Version:          p.version,
+		GenesisTimestamp: p.genesisTimestamp,
+		WithdrawNum:      p.withdrawNum,
+		WithdrawInterval: p.withdrawInterval,
+		UsageWindow:      p.usageWindow,
+		ProposalPeriod:   p.proposalPeriod,
+		Forks:            forks,
+		MinCollateral:    p.minCollateral.String(),
+		UnbondingPeriod:  p.unbondingPeriod,
+		MaxMissedSlots:   p.maxMissedSlots,
+		MaxVotes:         p.maxVotes,
+		BlockReward:      p.blockReward.String(),
+		RewardSchedule:   rewardSchedule,
+		Validators:       validators,
+
+		ProposalQuorum:          p.proposalQuorum,
+		ProposalActivationDelay: p.proposalActivationDelay,
+		DynastySize:             p.dynastySize,
+	}, nil
+}
+
+// FromProto converts corepb.ChainParams to ChainParams
+func (p *ChainParams) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.ChainParams); ok {
+		p.version = msg.Version
+		p.genesisTimestamp = msg.GenesisTimestamp
+		p.withdrawNum = msg.WithdrawNum
+		p.withdrawInterval = msg.WithdrawInterval
+		p.usageWindow = msg.UsageWindow
+		p.proposalPeriod = msg.ProposalPeriod
+		p.forks = make(map[string]uint64)
+		for _, fork := range msg.Forks {
+			p.forks[fork.Name] = fork.Height
+		}
+		minCollateral, err := util.NewUint128FromString(msg.MinCollateral)
+		if err != nil {
+			return err
+		}
+		p.minCollateral = minCollateral
+		p.unbondingPeriod = msg.UnbondingPeriod
+		p.maxMissedSlots = msg.MaxMissedSlots
+		p.maxVotes = msg.MaxVotes
+		blockReward, err := util.NewUint128FromString(msg.BlockReward)
+		if err != nil {
+			return err
+		}
+		p.blockReward = blockReward
+		rewardSchedule, err := rewardScheduleFromProto(msg.RewardSchedule)
+		if err != nil {
+			return err
+		}
+		p.rewardSchedule = rewardSchedule
+		validators, err := validatorsFromHex(msg.Validators)
+		if err != nil {
+			return err
+		}
+		p.validators = validators
+		p.proposalQuorum = msg.ProposalQuorum
+		p.proposalActivationDelay = msg.ProposalActivationDelay
+		p.dynastySize = msg.DynastySize
+		return nil
+	}
+	return ErrCannotConvertChainParams
+}
+
+// LoadChainParams loads chain parameters by hash from storage
+func LoadChainParams(storage storage.Storage, hash []byte) (*ChainParams, error) {
+	if hash == nil {
+		return DefaultChainParams(), nil
+	}
+	b, err := storage.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	pbParams := new(corepb.ChainParams)
+	if err := proto.Unmarshal(b, pbParams); err != nil {
+		return nil, err
+	}
+	params := new(ChainParams)
+	if err := params.FromProto(pbParams); err != nil {
+		return nil, err
+	}
+	hashCalc, err := params.Hash()
+	if err != nil {
+		return nil, err
+	}
+	if !byteutils.Equal(hash, hashCalc) {
+		return nil, ErrInvalidChainParamsHash
+	}
+	return params, nil
+}
+
+// Version returns p.version
+func (p *ChainParams) Version() uint64 {
+	return p.version
+}
+
+// GenesisTimestamp returns p.genesisTimestamp
+func (p *ChainParams) GenesisTimestamp() int64 {
+	return p.genesisTimestamp
+}
+
+// WithdrawNum returns p.withdrawNum
+func (p *ChainParams) WithdrawNum() uint32 {
+	return p.withdrawNum
+}
+
+// WithdrawInterval returns p.withdrawInterval
+func (p *ChainParams) WithdrawInterval() int64 {
+	return p.withdrawInterval
+}
+
+// UsageWindow returns p.usageWindow
+func (p *ChainParams) UsageWindow() int64 {
+	return p.usageWindow
+}
+
+// ProposalPeriod returns p.proposalPeriod
+func (p *ChainParams) ProposalPeriod() int64 {
+	return p.proposalPeriod
+}
+
+// MinCollateral returns p.minCollateral
+func (p *ChainParams) MinCollateral() *util.Uint128 {
+	return p.minCollateral.DeepCopy()
+}
+
+// UnbondingPeriod returns p.unbondingPeriod
+func (p *ChainParams) UnbondingPeriod() int64 {
+	return p.unbondingPeriod
+}
+
+// MaxMissedSlots returns p.maxMissedSlots
+func (p *ChainParams) MaxMissedSlots() uint64 {
+	return p.maxMissedSlots
+}
+
+// MaxVotes returns p.maxVotes
+func (p *ChainParams) MaxVotes() uint32 {
+	return p.maxVotes
+}
+
+// BlockReward returns amount minted to coinbase of a block at the given height.
+// The latest stage of reward schedule at the height overrides p.blockReward.
+func (p *ChainParams) BlockReward(height uint64) *util.Uint128 {
+	reward := p.blockReward
+	for _, stage := range p.rewardSchedule {
+		if stage.height > height {
+			break
+		}
+		reward = stage.reward
+	}
+	return reward.DeepCopy()
+}
+
+// Validators returns validators proposing blocks in turn. Empty if dynasty is elected by votes.
+func (p *ChainParams) Validators() []*common.Address {
+	validators := make([]*common.Address, len(p.validators))
+	for i := range p.validators {
+		v := p.validators[i]
+		validators[i] = &v
+	}
+	return validators
+}
+
+// ProposalQuorum returns percentage of total vesting which must vote on a proposal for it to pass
+func (p *ChainParams) ProposalQuorum() uint32 {
+	return p.proposalQuorum
+}
+
+// ProposalActivationDelay returns number of blocks after a proposal passes until its changes are applied
+func (p *ChainParams) ProposalActivationDelay() uint64 {
+	return p.proposalActivationDelay
+}
+
+// DynastySize returns number of block producers elected for a dynasty. 0 if not set in chain parameters.
+func (p *ChainParams) DynastySize() int {
+	return int(p.dynastySize)
+}
+
+// ForkHeight returns activation height of a fork and whether the fork is scheduled
+func (p *ChainParams) ForkHeight(name string) (uint64, bool) {
+	height, ok := p.forks[name]
+	return height, ok
+}
+
+// IsForkActive returns true if a fork is scheduled at or before the given height
+func (p *ChainParams) IsForkActive(name string, height uint64) bool {
+	forkHeight, ok := p.forks[name]
+	return ok && forkHeight <= height
+}
+
+// Apply returns a new version of chain parameters with changes applied at the given height.
+// A fork is scheduled by a change named ChainParamForkPrefix followed by the fork name.
+// A fork must be scheduled after the height, and a fork already active cannot be rescheduled.
+func (p *ChainParams) Apply(changes []*corepb.ParamChange, height uint64) (*ChainParams, error) {
+	params := *p
+	params.version++
+	params.forks = make(map[string]uint64)
+	for name, height := range p.forks {
+		params.forks[name] = height
+	}
+	for _, change := range changes {
+		if strings.HasPrefix(change.Name, ChainParamForkPrefix) {
+			name := strings.TrimPrefix(change.Name, ChainParamForkPrefix)
+			v, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil || name == "" {
+				return nil, ErrInvalidChainParams
+			}
+			if p.IsForkActive(name, height) {
+				return nil, ErrForkAlreadyActive
+			}
+			if v <= height {
+				return nil, ErrForkHeightPassed
+			}
+			params.forks[name] = v
+			continue
+		}
+		switch change.Name {
+		case ChainParamWithdrawNum:
+			v, err := strconv.ParseUint(change.Value, 10, 32)
+			if err != nil {
+				return nil, ErrInvalidChainParams
+			}
+			params.withdrawNum = uint32(v)
+		case ChainParamWithdrawInterval:
+			v, err := strconv.ParseInt(change.Value, 10, 64)
+			if err != nil {
+				return nil, ErrInvalidChainParams
+			}
+			params.withdrawInterval = v
+		case ChainParamUsageWindow:
+			v, err := strconv.ParseInt(change.Value, 10, 64)
+			if err != nil {
+				return nil, ErrInvalidChainParams
+			}
+			params.usageWindow = v
+		case ChainParamProposalPeriod:
+			v, err := strconv.ParseInt(change.Value, 10, 64)
+			if err != nil {
+				return nil, ErrInvalidChainParams
+			}
+			params.proposalPeriod = v
+		case ChainParamMinCollateral:
+			v, err := util.NewUint128FromString(change.Value)
+			if err != nil {
+				return nil, ErrInvalidChainParams
+			}
+			params.minCollateral = v
+		case ChainParamUnbondingPeriod:
+			v, err := strconv.ParseInt(change.Value, 10, 64)
+			if err != nil {
+				return nil, ErrInvalidChainParams
+			}
+			params.unbondingPeriod = v
+		case ChainParamMaxMissedSlots:
+			v, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return nil, ErrInvalidChainParams
+			}
+			params.maxMissedSlots = v
+		case ChainParamMaxVotes:
+			v, err := strconv.ParseUint(change.Value, 10, 32)
+			if err != nil {
+				return nil, ErrInvalidChainParams
+			}
+			params.maxVotes = uint32(v)
+		case ChainParamBlockReward:
+			v, err := util.NewUint128FromString(change.Value)
+			if err != nil {
+				return nil, ErrInvalidChainParams
+			}
+			params.blockReward = v
+		case ChainParamValidators:
+			var hexes []string
+			if change.Value != "" {
+				hexes = strings.Split(change.Value, ",")
+			}
+			v, err := validatorsFromHex(hexes)
+			if err != nil {
+				return nil, err
+			}
+			params.validators = v
+		case ChainParamProposalQuorum:
+			v, err := strconv.ParseUint(change.Value, 10, 32)
+			if err != nil {
+				return nil, ErrInvalidChainParams
+			}
+			params.proposalQuorum = uint32(v)
+		case ChainParamProposalActivationDelay:
+			v, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return nil, ErrInvalidChainParams
+			}
+			params.proposalActivationDelay = v
+		case ChainParamDynastySize:
+			v, err := strconv.ParseUint(change.Value, 10, 32)
+			if err != nil || v == 0 {
+				return nil, ErrInvalidChainParams
+			}
+			params.dynastySize = uint32(v)
+		default:
+			return nil, ErrUnknownChainParam
+		}
+	}
+	if err := params.verify(); err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
+// Hash returns hash of marshalled chain parameters
+func (p *ChainParams) Hash() ([]byte, error) {
+	b, err := p.marshal()
+	if err != nil {
+		return nil, err
+	}
+	hasher := sha3.New256()
+	hasher.Write(b)
+	return hasher.Sum(nil), nil
+}
+
+func (p *ChainParams) marshal() ([]byte, error) {
+	msg, err := p.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(msg)
+}
+
+func (p *ChainParams) save(storage storage.Storage) error {
+	b, err := p.marshal()
+	if err != nil {
+		return err
+	}
+	hash, err := p.Hash()
+	if err != nil {
+		return err
+	}
+	return storage.Put(hash, b)
+}
+
+func (p *ChainParams) verify() error {
+	if p.withdrawNum == 0 || p.withdrawInterval <= 0 || p.usageWindow <= 0 || p.proposalPeriod <= 0 ||
+		p.unbondingPeriod <= 0 || p.maxVotes == 0 || p.proposalQuorum == 0 || p.proposalQuorum > 100 ||
+		p.proposalActivationDelay == 0 {
+		return ErrInvalidChainParams
+	}
+	return nil
+}
+
+// rewardScheduleFromProto converts reward stages which must be in ascending order of height
+func rewardScheduleFromProto(pbStages []*corepb.RewardStage) ([]*rewardStage, error) {
+	var schedule []*rewardStage
+	for i, pbStage := range pbStages {
+		if i > 0 && pbStage.Height <= pbStages[i-1].Height {
+			return nil, ErrInvalidChainParams
+		}
+		reward, err := util.NewUint128FromString(pbStage.Reward)
+		if err != nil {
+			return nil, err
+		}
+		schedule = append(schedule, &rewardStage{
+			height: pbStage.Height,
+			reward: reward,
+		})
+	}
+	return schedule, nil
+}
+
+// validatorsFromHex converts hex addresses of validators which must not be duplicated
+func validatorsFromHex(hexes []string) ([]common.Address, error) {
+	var validators []common.Address
+	seen := make(map[common.Address]bool)
+	for _, h := range hexes {
+		h = strings.TrimSpace(h)
+		if !common.IsHexAddress(h) {
+			return nil, ErrInvalidChainParams
+		}
+		addr := common.HexToAddress(h)
+		if seen[addr] {
+			return nil, ErrInvalidChainParams
+		}
+		seen[addr] = true
+		validators = append(validators, addr)
+	}
+	return validators, nil
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core
+
+import (
+	"sync"
+
+	"github.com/medibloc/go-medibloc/util/clock"
+)
+
+var (
+	clockMu   sync.RWMutex
+	coreClock clock.Clock = clock.System
+)
+
+// SetClock replaces the clock which stamps new transactions and blocks and checks arrival time of blocks.
+// It is used to run simulations on a manual clock. Consensus engines created afterwards use it too.
+func SetClock(c clock.Clock) {
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	coreClock = c
+}
+
+// Clock returns the clock of core.
+func Clock() clock.Clock {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return coreClock
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core
+
+import (
+	"bytes"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"golang.org/x/crypto/sha3"
+)
+
+// signedHeader is a signed block header with hashes of transactions needed to recompute the block hash
+type signedHeader struct {
+	header   *BlockHeader
+	txHashes [][]byte
+}
+
+func newSignedHeader(bd *BlockData) *signedHeader {
+	txHashes := make([][]byte, len(bd.transactions))
+	for i, tx := range bd.transactions {
+		txHashes[i] = tx.Hash()
+	}
+	return &signedHeader{
+		header:   bd.header,
+		txHashes: txHashes,
+	}
+}
+
+func (sh *signedHeader) toProto() (*corepb.SignedBlockHeader, error) {
+	header, err := sh.header.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.SignedBlockHeader{
+		Header:   header.(*corepb.BlockHeader),
+		TxHashes: sh.txHashes,
+	}, nil
+}
+
+func (sh *signedHeader) fromProto(msg *corepb.SignedBlockHeader) error {
+	if msg == nil || msg.Header == nil {
+		return ErrCannotConvertEvidence
+	}
+	sh.header = new(BlockHeader)
+	if err := sh.header.FromProto(msg.Header); err != nil {
+		return err
+	}
+	sh.txHashes = msg.TxHashes
+	return nil
+}
+
+func (sh *signedHeader) recoverSigner() (common.Address, error) {
+	signature, err := crypto.NewSignature(sh.header.alg)
+	if err != nil {
+		return common.Address{}, err
+	}
+	pubKey, err := signature.RecoverPublic(sh.header.hash, sh.header.sign)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.PublicKeyToAddress(pubKey)
+}
+
+// DoubleSignEvidence proves that a proposer signed two different blocks for the same slot
+type DoubleSignEvidence struct {
+	first  *signedHeader
+	second *signedHeader
+}
+
+// NewDoubleSignEvidence returns evidence made of two blocks
+func NewDoubleSignEvidence(first *BlockData, second *BlockData) *DoubleSignEvidence {
+	return &DoubleSignEvidence{
+		first:  newSignedHeader(first),
+		second: newSignedHeader(second),
+	}
+}
+
+// ToProto converts DoubleSignEvidence to corepb.DoubleSignEvidence
+func (e *DoubleSignEvidence) ToProto() (proto.Message, error) {
+	first, err := e.first.toProto()
+	if err != nil {
+		return nil, err
+	}
+	second, err := e.second.toProto()
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.DoubleSignEvidence{
+		First:  first,
+		Second: second,
+	}, nil
+}
+
+// FromProto converts corepb.DoubleSignEvidence to DoubleSignEvidence
+func (e *DoubleSignEvidence) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.DoubleSignEvidence); ok {
+		e.first = new(signedHeader)
+		if err := e.first.fromProto(msg.First); err != nil {
+			return err
+		}
+		e.second = new(signedHeader)
+		return e.second.fromProto(msg.Second)
+	}
+	return ErrCannotConvertEvidence
+}
+
+// ToBytes returns marshalled DoubleSignEvidence
+func (e *DoubleSignEvidence) ToBytes() ([]byte, error) {
+	msg, err := e.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(msg)
+}
+
+// BytesToDoubleSignEvidence converts bytes to DoubleSignEvidence
+func BytesToDoubleSignEvidence(b []byte) (*DoubleSignEvidence, error) {
+	pbEvidence := new(corepb.DoubleSignEvidence)
+	if err := proto.Unmarshal(b, pbEvidence); err != nil {
+		return nil, ErrCannotConvertEvidence
+	}
+	evidence := new(DoubleSignEvidence)
+	if err := evidence.FromProto(pbEvidence); err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+// Timestamp returns the slot in which blocks are double signed
+func (e *DoubleSignEvidence) Timestamp() int64 {
+	return e.first.header.timestamp
+}
+
+// Hash returns hash of evidence which does not depend on the order of blocks
+func (e *DoubleSignEvidence) Hash() []byte {
+	first, second := e.first.header.hash, e.second.header.hash
+	if bytes.Compare(first, second) > 0 {
+		first, second = second, first
+	}
+	hasher := sha3.New256()
+	hasher.Write(first)
+	hasher.Write(second)
+	return hasher.Sum(nil)
+}
+
+// Verify checks that two different blocks of the same slot are signed by the same signer and returns the signer
+func (e *DoubleSignEvidence) Verify(chainID uint32) (common.Address, error) {
+	first, second := e.first.header, e.second.header
+	if first.chainID != chainID || second.chainID != chainID {
+		return common.Address{}, ErrInvalidEvidence
+	}
+	if first.timestamp != second.timestamp {
+		return common.Address{}, ErrInvalidEvidence
+	}
+	if byteutils.Equal(first.hash, second.hash) {
+		return common.Address{}, ErrInvalidEvidence
+	}
+	for _, sh := range []*signedHeader{e.first, e.second} {
+		if !byteutils.Equal(HashBlockHeader(sh.header, sh.txHashes), sh.header.hash) {
+			return common.Address{}, ErrInvalidEvidence
+		}
+	}
+	firstSigner, err := e.first.recoverSigner()
+	if err != nil {
+		return common.Address{}, err
+	}
+	secondSigner, err := e.second.recoverSigner()
+	if err != nil {
+		return common.Address{}, err
+	}
+	if !firstSigner.Equals(secondSigner) {
+		return common.Address{}, ErrInvalidEvidence
+	}
+	return firstSigner, nil
+}
@@ -0,0 +1,227 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"golang.org/x/crypto/sha3"
+)
+
+// FinalitySigner signs finality votes of a dynasty member
+type FinalitySigner interface {
+	Address() common.Address
+	SignFinalityVote(vote *FinalityVote) error
+}
+
+// FinalityVote is a confirmation signed by a dynasty member that it considers a block final
+type FinalityVote struct {
+	chainID   uint32
+	blockHash []byte
+	height    uint64
+	alg       algorithm.Algorithm
+	sign      []byte
+}
+
+// NewFinalityVote returns an unsigned finality vote on the block
+func NewFinalityVote(chainID uint32, block *Block) *FinalityVote {
+	return &FinalityVote{
+		chainID:   chainID,
+		blockHash: block.Hash(),
+		height:    block.Height(),
+	}
+}
+
+// ChainID returns chain id of the vote
+func (v *FinalityVote) ChainID() uint32 {
+	return v.chainID
+}
+
+// BlockHash returns hash of the voted block
+func (v *FinalityVote) BlockHash() []byte {
+	return v.blockHash
+}
+
+// Height returns height of the voted block
+func (v *FinalityVote) Height() uint64 {
+	return v.height
+}
+
+// Alg returns signature algorithm of the vote
+func (v *FinalityVote) Alg() algorithm.Algorithm {
+	return v.alg
+}
+
+// Signature returns signature of the vote
+func (v *FinalityVote) Signature() []byte {
+	return v.sign
+}
+
+// Hash returns the hash signed by the voter
+func (v *FinalityVote) Hash() []byte {
+	hasher := sha3.New256()
+	hasher.Write(byteutils.FromUint32(v.chainID))
+	hasher.Write(v.blockHash)
+	hasher.Write(byteutils.FromUint64(v.height))
+	return hasher.Sum(nil)
+}
+
+// SignThis signs the vote
+func (v *FinalityVote) SignThis(signer signature.Signature) error {
+	sign, err := signer.Sign(v.Hash())
+	if err != nil {
+		return err
+	}
+	v.alg = signer.Algorithm()
+	v.sign = sign
+	return nil
+}
+
+// SetSignature sets a signature of the vote signed elsewhere
+func (v *FinalityVote) SetSignature(alg algorithm.Algorithm, sign []byte) {
+	v.alg = alg
+	v.sign = sign
+}
+
+// RecoverSigner returns the address of the voter
+func (v *FinalityVote) RecoverSigner() (common.Address, error) {
+	sig, err := crypto.NewSignature(v.alg)
+	if err != nil {
+		return common.Address{}, err
+	}
+	pubKey, err := sig.RecoverPublic(v.Hash(), v.sign)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.PublicKeyToAddress(pubKey)
+}
+
+// ToProto converts FinalityVote to corepb.FinalityVote
+func (v *FinalityVote) ToProto() (proto.Message, error) {
+	return &corepb.FinalityVote{
+		ChainId:   v.chainID,
+		BlockHash: v.blockHash,
+		Height:    v.height,
+		Alg:       uint32(v.alg),
+		Sign:      v.sign,
+	}, nil
+}
+
+// FromProto converts corepb.FinalityVote to FinalityVote
+func (v *FinalityVote) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.FinalityVote); ok {
+		v.chainID = msg.ChainId
+		v.blockHash = msg.BlockHash
+		v.height = msg.Height
+		v.alg = algorithm.Algorithm(msg.Alg)
+		v.sign = msg.Sign
+		return nil
+	}
+	return ErrCannotConvertFinalityVote
+}
+
+// FinalityCertificate aggregates finality votes of more than two thirds of the dynasty on a block
+type FinalityCertificate struct {
+	blockHash []byte
+	height    uint64
+	votes     []*FinalityVote
+}
+
+// BlockHash returns hash of the finalized block
+func (c *FinalityCertificate) BlockHash() []byte {
+	return c.blockHash
+}
+
+// Height returns height of the finalized block
+func (c *FinalityCertificate) Height() uint64 {
+	return c.height
+}
+
+// Votes returns votes of the certificate
+func (c *FinalityCertificate) Votes() []*FinalityVote {
+	return c.votes
+}
+
+// Verify checks that the certificate has valid votes of more than two thirds of the members
+func (c *FinalityCertificate) Verify(chainID uint32, members []*common.Address) error {
+	isMember := make(map[common.Address]bool)
+	for _, m := range members {
+		isMember[*m] = true
+	}
+	voted := make(map[common.Address]bool)
+	for _, v := range c.votes {
+		if v.chainID != chainID || v.height != c.height || !byteutils.Equal(v.blockHash, c.blockHash) {
+			return ErrInvalidFinalityVote
+		}
+		signer, err := v.RecoverSigner()
+		if err != nil {
+			return err
+		}
+		if !isMember[signer] {
+			return ErrFinalityVoterNotMember
+		}
+		voted[signer] = true
+	}
+	if len(voted) < FinalityThreshold(len(members)) {
+		return ErrFinalityVotesNotEnough
+	}
+	return nil
+}
+
+// FinalityThreshold returns the number of votes needed to finalize a block among the given number of members
+func FinalityThreshold(members int) int {
+	return members*2/3 + 1
+}
+
+// ToProto converts FinalityCertificate to corepb.FinalityCertificate
+func (c *FinalityCertificate) ToProto() (proto.Message, error) {
+	votes := make([]*corepb.FinalityVote, 0, len(c.votes))
+	for _, v := range c.votes {
+		msg, err := v.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		votes = append(votes, msg.(*corepb.FinalityVote))
+	}
+	return &corepb.FinalityCertificate{
+		BlockHash: c.blockHash,
+		Height:    c.height,
+		Votes:     votes,
+	}, nil
+}
+
+// FromProto converts corepb.FinalityCertificate to FinalityCertificate
+func (c *FinalityCertificate) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.FinalityCertificate); ok {
+		c.blockHash = msg.BlockHash
+		c.height = msg.Height
+		c.votes = make([]*FinalityVote, 0, len(msg.Votes))
+		for _, pbVote := range msg.Votes {
+			v := new(FinalityVote)
+			if err := v.FromProto(pbVote); err != nil {
+				return err
+			}
+			c.votes = append(c.votes, v)
+		}
+		return nil
+	}
+	return ErrCannotConvertFinalityCertificate
+}
@@ -32,8 +32,8 @@ import (
 var (
 	// GenesisHash is hash of genesis block
 	GenesisHash = []byte("genesisHash")
-	// GenesisTimestamp is timestamp of genesis block
-	GenesisTimestamp = int64(0)
+	// DefaultGenesisTimestamp is timestamp of genesis block used when genesis does not specify it
+	DefaultGenesisTimestamp = int64(0)
 	// GenesisCoinbase coinbase address of genesis block
 	GenesisCoinbase = common.HexToAddress("02fc22ea22d02fc2469f5ec8fab44bc3de42dda2bf9ebc0c0055a9eb7df579056c")
 	// GenesisHeight is height of genesis block
@@ -60,10 +60,17 @@ func NewGenesisBlock(conf *corepb.Genesis, consensus Consensus, sto storage.Stor
 	if conf == nil {
 		return nil, ErrNilArgument
 	}
+	chainParams, err := NewChainParamsFromGenesis(conf)
+	if err != nil {
+		return nil, err
+	}
 	blockState, err := NewBlockState(consensus, sto)
 	if err != nil {
 		return nil, err
 	}
+	blockState.SetChainParams(chainParams)
+	blockState.height = GenesisHeight
+	blockState.timestamp = chainParams.GenesisTimestamp()
 	genesisBlock := &Block{
 		BlockData: &BlockData{
 			header: &BlockHeader{
@@ -71,7 +78,7 @@ func NewGenesisBlock(conf *corepb.Genesis, consensus Consensus, sto storage.Stor
 				parentHash: GenesisHash,
 				chainID:    conf.Meta.ChainId,
 				coinbase:   GenesisCoinbase,
-				timestamp:  GenesisTimestamp,
+				timestamp:  chainParams.GenesisTimestamp(),
 				alg:        algorithm.SECP256K1,
 			},
 			transactions: make(Transactions, 0),
@@ -93,7 +100,17 @@ func NewGenesisBlock(conf *corepb.Genesis, consensus Consensus, sto storage.Stor
 		}
 		members = append(members, &member)
 	}
-	genesisBlock.State().SetDynasty(members, dynastySize, int64(0))
+	genesisBlock.State().SetDynasty(members, dynastySize, chainParams.GenesisTimestamp())
+
+	for _, issuer := range conf.GetIssuers() {
+		addr := common.HexToAddress(issuer.Address)
+		if err := genesisBlock.state.AddRootIssuer(addr, issuer.Name, issuer.CertTypes); err != nil {
+			if err := genesisBlock.RollBack(); err != nil {
+				return nil, err
+			}
+			return nil, err
+		}
+	}
 
 	for _, dist := range conf.TokenDistribution {
 		addr := common.HexToAddress(dist.Address)
@@ -112,6 +129,12 @@ func NewGenesisBlock(conf *corepb.Genesis, consensus Consensus, sto storage.Stor
 			}
 			return nil, err
 		}
+		if err := genesisBlock.state.AddSupply(balance); err != nil {
+			if err := genesisBlock.RollBack(); err != nil {
+				return nil, err
+			}
+			return nil, err
+		}
 	}
 
 	initialMessage := "Genesis block of MediBloc"
@@ -126,7 +149,7 @@ func NewGenesisBlock(conf *corepb.Genesis, consensus Consensus, sto storage.Stor
 	if err != nil {
 		return nil, err
 	}
-	initialTx.SetTimestamp(GenesisTimestamp)
+	initialTx.SetTimestamp(chainParams.GenesisTimestamp())
 
 	hash, err := initialTx.calcHash()
 	if err != nil {
@@ -158,10 +181,23 @@ func NewGenesisBlock(conf *corepb.Genesis, consensus Consensus, sto storage.Stor
 	genesisBlock.header.txsRoot = genesisBlock.state.TransactionsRoot()
 	genesisBlock.header.candidacyRoot = genesisBlock.state.CandidacyRoot()
 	genesisBlock.header.certificationRoot = genesisBlock.state.CertificationRoot()
+	genesisBlock.header.issuerRoot = genesisBlock.state.IssuerRoot()
+	genesisBlock.header.multisigRoot = genesisBlock.state.MultisigRoot()
+	genesisBlock.header.governanceRoot = genesisBlock.state.GovernanceRoot()
+	genesisBlock.header.evidenceRoot = genesisBlock.state.EvidenceRoot()
+	genesisBlock.header.votesRoot = genesisBlock.state.VotesRoot()
 	genesisBlock.header.consensusRoot, err = genesisBlock.state.ConsensusRoot()
 	if err != nil {
 		return nil, err
 	}
+	genesisBlock.header.chainParamsHash, err = genesisBlock.state.ChainParamsHash()
+	if err != nil {
+		return nil, err
+	}
+	genesisBlock.header.supply, err = genesisBlock.state.SupplyBytes()
+	if err != nil {
+		return nil, err
+	}
 
 	genesisBlock.sealed = true
 
@@ -197,6 +233,29 @@ func CheckGenesisConf(block *Block, genesis *corepb.Genesis) bool {
 		return false
 	}
 
+	chainParams, err := NewChainParamsFromGenesis(genesis)
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"genesis": genesis,
+			"err":     err,
+		}).Error("Failed to get chain parameters from genesis.")
+		return false
+	}
+	paramsHash, err := chainParams.Hash()
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to calculate hash of chain parameters.")
+		return false
+	}
+	if !byteutils.Equal(paramsHash, block.ChainParamsHash()) {
+		logging.Console().WithFields(logrus.Fields{
+			"hashInBlock":  byteutils.Bytes2Hex(block.ChainParamsHash()),
+			"hashInConfig": byteutils.Bytes2Hex(paramsHash),
+		}).Error("Genesis's chain parameters do not match.")
+		return false
+	}
+
 	members, err := block.State().Dynasty()
 	if err != nil {
 		logging.Console().WithFields(logrus.Fields{
@@ -94,4 +94,40 @@ func TestCheckGenesisBlock(t *testing.T) {
 	modified = copystructure.Must(copystructure.Copy(conf)).(*corepb.Genesis)
 	modified.TokenDistribution[4].Value = "Wrong Value"
 	require.False(t, core.CheckGenesisConf(genesis, modified))
+
+	modified = copystructure.Must(copystructure.Copy(conf)).(*corepb.Genesis)
+	modified.ChainParams = &corepb.ChainParams{WithdrawInterval: 60}
+	require.False(t, core.CheckGenesisConf(genesis, modified))
+}
+
+func TestGenesisChainParams(t *testing.T) {
+	conf, _, _ := testutil.NewTestGenesisConf(t)
+	conf.ChainParams = &corepb.ChainParams{
+		GenesisTimestamp: 1000,
+		WithdrawInterval: 60,
+		UsageWindow:      600,
+		MinCollateral:    "100",
+	}
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	genesis, err := core.NewGenesisBlock(conf, testutil.NewTestConsensus(t), stor)
+	require.NoError(t, err)
+
+	params := genesis.State().ChainParams()
+	assert.Equal(t, uint64(1), params.Version())
+	assert.Equal(t, int64(1000), params.GenesisTimestamp())
+	assert.Equal(t, core.DefaultRtWithdrawNum, params.WithdrawNum())
+	assert.Equal(t, int64(60), params.WithdrawInterval())
+	assert.Equal(t, int64(600), params.UsageWindow())
+	assert.Equal(t, util.NewUint128FromUint(100), params.MinCollateral())
+	assert.Equal(t, int64(1000), genesis.Timestamp())
+	assert.True(t, core.CheckGenesisConf(genesis, conf))
+
+	loaded, err := core.LoadChainParams(stor, genesis.ChainParamsHash())
+	require.NoError(t, err)
+	assert.Equal(t, params, loaded)
+
+	conf.ChainParams.WithdrawInterval = -1
+	_, err = core.NewGenesisBlock(conf, testutil.NewTestConsensus(t), stor)
+	assert.Equal(t, core.ErrInvalidChainParams, err)
 }
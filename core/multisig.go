@@ -0,0 +1,80 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core
+
+import (
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"golang.org/x/crypto/sha3"
+)
+
+// MultisigAddressPrefix is the first byte of multisig account addresses.
+// Compressed public keys always start with 0x02 or 0x03, so a multisig address never collides with a key address.
+const MultisigAddressPrefix = byte(0x00)
+
+// NewMultisigAddress derives address of a multisig account created by creator's tx with the given nonce
+func NewMultisigAddress(creator common.Address, nonce uint64) common.Address {
+	hasher := sha3.New256()
+
+	hasher.Write(creator.Bytes())
+	hasher.Write(byteutils.FromUint64(nonce))
+
+	return common.BytesToAddress(append([]byte{MultisigAddressPrefix}, hasher.Sum(nil)...))
+}
+
+func validateMultisigOwners(threshold uint32, owners []*corepb.MultisigOwner) error {
+	if len(owners) == 0 {
+		return ErrInvalidMultisigOwner
+	}
+	totalWeight := uint64(0)
+	for i, owner := range owners {
+		if len(owner.Address) != common.AddressLength || owner.Weight == 0 {
+			return ErrInvalidMultisigOwner
+		}
+		for _, other := range owners[:i] {
+			if byteutils.Equal(owner.Address, other.Address) {
+				return ErrInvalidMultisigOwner
+			}
+		}
+		totalWeight += uint64(owner.Weight)
+	}
+	if threshold == 0 || uint64(threshold) > totalWeight {
+		return ErrInvalidMultisigThreshold
+	}
+	return nil
+}
+
+func verifyMultisigSigners(multisig *corepb.Multisig, signers []common.Address) error {
+	weight := uint64(0)
+	for _, signer := range signers {
+		found := false
+		for _, owner := range multisig.Owners {
+			if byteutils.Equal(signer.Bytes(), owner.Address) {
+				weight += uint64(owner.Weight)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return ErrMultisigSignerNotOwner
+		}
+	}
+	if weight < uint64(multisig.Threshold) {
+		return ErrMultisigThresholdNotMet
+	}
+	return nil
+}
@@ -33,11 +33,14 @@ type Account struct {
 	Nonce            uint64   `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	ObservationsHash []byte   `protobuf:"bytes,4,opt,name=observations_hash,json=observationsHash,proto3" json:"observations_hash,omitempty"`
 	Vesting          []byte   `protobuf:"bytes,5,opt,name=vesting,proto3" json:"vesting,omitempty"`
-	Voted            []byte   `protobuf:"bytes,6,opt,name=voted,proto3" json:"voted,omitempty"`
+	Voted            [][]byte `protobuf:"bytes,6,rep,name=voted" json:"voted,omitempty"`
 	Writers          [][]byte `protobuf:"bytes,7,rep,name=writers" json:"writers,omitempty"`
 	Records          [][]byte `protobuf:"bytes,8,rep,name=records" json:"records,omitempty"`
 	CertsReceived    [][]byte `protobuf:"bytes,9,rep,name=certs_received,json=certsReceived" json:"certs_received,omitempty"`
 	CertsIssued      [][]byte `protobuf:"bytes,10,rep,name=certs_issued,json=certsIssued" json:"certs_issued,omitempty"`
+	Proxy            []byte   `protobuf:"bytes,11,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	ProxiedVesting   []byte   `protobuf:"bytes,12,opt,name=proxied_vesting,json=proxiedVesting,proto3" json:"proxied_vesting,omitempty"`
+	Delegators       uint32   `protobuf:"varint,13,opt,name=delegators,proto3" json:"delegators,omitempty"`
 }
 
 func (m *Account) Reset()                    { *m = Account{} }
@@ -80,7 +83,7 @@ func (m *Account) GetVesting() []byte {
 	return nil
 }
 
-func (m *Account) GetVoted() []byte {
+func (m *Account) GetVoted() [][]byte {
 	if m != nil {
 		return m.Voted
 	}
@@ -115,6 +118,27 @@ func (m *Account) GetCertsIssued() [][]byte {
 	return nil
 }
 
+func (m *Account) GetProxy() []byte {
+	if m != nil {
+		return m.Proxy
+	}
+	return nil
+}
+
+func (m *Account) GetProxiedVesting() []byte {
+	if m != nil {
+		return m.ProxiedVesting
+	}
+	return nil
+}
+
+func (m *Account) GetDelegators() uint32 {
+	if m != nil {
+		return m.Delegators
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "corepb.Account")
 }
@@ -122,20 +146,23 @@ func init() {
 func init() { proto.RegisterFile("account.proto", fileDescriptorAccount) }
 
 var fileDescriptorAccount = []byte{
-	// 229 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x4d, 0x4a, 0x04, 0x31,
-	0x10, 0x46, 0xe9, 0xf9, 0xe9, 0xd6, 0x38, 0x23, 0x1a, 0x5c, 0xd4, 0xb2, 0x15, 0x84, 0x01, 0xc1,
-	0x8d, 0x27, 0x70, 0xa7, 0xdb, 0xbe, 0xc0, 0x90, 0x4e, 0x0a, 0x3b, 0x20, 0xa9, 0xa1, 0x2a, 0x13,
-	0x6f, 0xe5, 0x19, 0x25, 0x3f, 0xc2, 0x2c, 0xdf, 0x7b, 0x5f, 0x6a, 0x11, 0xb5, 0x37, 0xd6, 0xd2,
-	0x39, 0xc4, 0xd7, 0x13, 0x53, 0x24, 0xdd, 0x5b, 0x62, 0x3c, 0xcd, 0x4f, 0xbf, 0x2b, 0x35, 0xbc,
-	0xd7, 0xa2, 0x41, 0x0d, 0xc6, 0x39, 0x46, 0x11, 0xe8, 0xc6, 0xee, 0xb0, 0x9b, 0xfe, 0x31, 0x97,
-	0xd9, 0x7c, 0x9b, 0x60, 0x11, 0x56, 0xb5, 0x34, 0xd4, 0x0f, 0x6a, 0x1b, 0x28, 0xfb, 0xf5, 0xd8,
-	0x1d, 0x36, 0x53, 0x05, 0xfd, 0xa2, 0xee, 0x69, 0x16, 0xe4, 0x64, 0xa2, 0xa7, 0x20, 0xc7, 0xc5,
-	0xc8, 0x02, 0x9b, 0xf2, 0xf2, 0xee, 0x32, 0x7c, 0x18, 0x59, 0xf2, 0xf1, 0x84, 0x12, 0x7d, 0xf8,
-	0x82, 0x6d, 0x3d, 0xde, 0x30, 0x1f, 0x4f, 0x14, 0xd1, 0x41, 0x5f, 0x7c, 0x85, 0xbc, 0xff, 0x61,
-	0x1f, 0x91, 0x05, 0x86, 0x71, 0x9d, 0xf7, 0x0d, 0x73, 0x61, 0xb4, 0xc4, 0x4e, 0xe0, 0xaa, 0x96,
-	0x86, 0xfa, 0x59, 0xdd, 0x5a, 0xe4, 0x28, 0x47, 0x46, 0x8b, 0x3e, 0xa1, 0x83, 0xeb, 0x32, 0xd8,
-	0x17, 0x3b, 0x35, 0xa9, 0x1f, 0xd5, 0xae, 0xce, 0xbc, 0xc8, 0x19, 0x1d, 0xa8, 0x32, 0xba, 0x29,
-	0xee, 0xb3, 0xa8, 0xb9, 0x2f, 0xff, 0xf7, 0xf6, 0x17, 0x00, 0x00, 0xff, 0xff, 0x90, 0x3e, 0xef,
-	0xd9, 0x50, 0x01, 0x00, 0x00,
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x4d, 0x4b, 0xc4, 0x30,
+	0x10, 0x86, 0xa9, 0xfb, 0xa5, 0xd9, 0x76, 0xd5, 0xe0, 0x61, 0x4e, 0x52, 0x05, 0xb1, 0x20, 0x78,
+	0xf1, 0x17, 0x78, 0xd3, 0x6b, 0x0f, 0x5e, 0x97, 0x34, 0x19, 0xb6, 0x81, 0xa5, 0x29, 0x33, 0xd9,
+	0xaa, 0x3f, 0xc2, 0xff, 0x2c, 0xf9, 0x28, 0xec, 0xad, 0xcf, 0xf3, 0xbe, 0x1d, 0x66, 0x88, 0xa8,
+	0x94, 0xd6, 0xee, 0x34, 0xf8, 0xd7, 0x91, 0x9c, 0x77, 0x72, 0xad, 0x1d, 0xe1, 0xd8, 0x3d, 0xfe,
+	0x2d, 0xc4, 0xe6, 0x3d, 0x25, 0x12, 0xc4, 0x46, 0x19, 0x43, 0xc8, 0x0c, 0x45, 0x5d, 0x34, 0x65,
+	0x3b, 0x63, 0x48, 0x3a, 0x75, 0x54, 0x83, 0x46, 0xb8, 0x48, 0x49, 0x46, 0x79, 0x27, 0x56, 0x83,
+	0x0b, 0x7e, 0x51, 0x17, 0xcd, 0xb2, 0x4d, 0x20, 0x5f, 0xc4, 0xad, 0xeb, 0x18, 0x69, 0x52, 0xde,
+	0xba, 0x81, 0xf7, 0xbd, 0xe2, 0x1e, 0x96, 0xf1, 0xcf, 0x9b, 0xf3, 0xe0, 0x43, 0x71, 0x1f, 0x86,
+	0x4f, 0xc8, 0xde, 0x0e, 0x07, 0x58, 0xa5, 0xe1, 0x19, 0xc3, 0xf0, 0xc9, 0x79, 0x34, 0xb0, 0xae,
+	0x17, 0x4d, 0xd9, 0x26, 0x08, 0xfd, 0x6f, 0xb2, 0x1e, 0x89, 0x61, 0x13, 0xfd, 0x8c, 0x21, 0x21,
+	0xd4, 0x8e, 0x0c, 0xc3, 0x65, 0x4a, 0x32, 0xca, 0x27, 0xb1, 0xd3, 0x48, 0x9e, 0xf7, 0x84, 0x1a,
+	0xed, 0x84, 0x06, 0xae, 0x62, 0xa1, 0x8a, 0xb6, 0xcd, 0x52, 0x3e, 0x88, 0x32, 0xd5, 0x2c, 0xf3,
+	0x09, 0x0d, 0x88, 0x58, 0xda, 0x46, 0xf7, 0x19, 0x55, 0xd8, 0x69, 0x24, 0xf7, 0xf3, 0x0b, 0xdb,
+	0xb8, 0x6b, 0x02, 0xf9, 0x2c, 0xae, 0xc3, 0x87, 0x45, 0xb3, 0x9f, 0x6f, 0x29, 0x63, 0xbe, 0xcb,
+	0xfa, 0x2b, 0x9f, 0x74, 0x2f, 0x84, 0xc1, 0x23, 0x1e, 0x94, 0x77, 0xc4, 0x50, 0xd5, 0x45, 0x53,
+	0xb5, 0x67, 0xa6, 0x5b, 0xc7, 0xe7, 0x79, 0xfb, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x03, 0xef,
+	0x0b, 0xaf, 0x01, 0x00, 0x00,
 }
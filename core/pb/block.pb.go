@@ -47,6 +47,14 @@ type BlockHeader struct {
 	CertificationRoot    []byte `protobuf:"bytes,13,opt,name=certification_root,json=certificationRoot,proto3" json:"certification_root,omitempty"`
 	ConsensusRoot        []byte `protobuf:"bytes,14,opt,name=consensus_root,json=consensusRoot,proto3" json:"consensus_root,omitempty"`
 	ReservationQueueHash []byte `protobuf:"bytes,15,opt,name=reservation_queue_hash,json=reservationQueueHash,proto3" json:"reservation_queue_hash,omitempty"`
+	IssuerRoot           []byte `protobuf:"bytes,16,opt,name=issuer_root,json=issuerRoot,proto3" json:"issuer_root,omitempty"`
+	MultisigRoot         []byte `protobuf:"bytes,17,opt,name=multisig_root,json=multisigRoot,proto3" json:"multisig_root,omitempty"`
+	ChainParamsHash      []byte `protobuf:"bytes,18,opt,name=chain_params_hash,json=chainParamsHash,proto3" json:"chain_params_hash,omitempty"`
+	GovernanceRoot       []byte `protobuf:"bytes,19,opt,name=governance_root,json=governanceRoot,proto3" json:"governance_root,omitempty"`
+	EvidenceRoot         []byte `protobuf:"bytes,20,opt,name=evidence_root,json=evidenceRoot,proto3" json:"evidence_root,omitempty"`
+	VotesRoot            []byte `protobuf:"bytes,21,opt,name=votes_root,json=votesRoot,proto3" json:"votes_root,omitempty"`
+	Supply               []byte `protobuf:"bytes,22,opt,name=supply,proto3" json:"supply,omitempty"`
+	Version              uint32 `protobuf:"varint,23,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *BlockHeader) Reset()                    { *m = BlockHeader{} }
@@ -159,6 +167,62 @@ func (m *BlockHeader) GetReservationQueueHash() []byte {
 	return nil
 }
 
+func (m *BlockHeader) GetIssuerRoot() []byte {
+	if m != nil {
+		return m.IssuerRoot
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetMultisigRoot() []byte {
+	if m != nil {
+		return m.MultisigRoot
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetChainParamsHash() []byte {
+	if m != nil {
+		return m.ChainParamsHash
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetGovernanceRoot() []byte {
+	if m != nil {
+		return m.GovernanceRoot
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetEvidenceRoot() []byte {
+	if m != nil {
+		return m.EvidenceRoot
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetVotesRoot() []byte {
+	if m != nil {
+		return m.VotesRoot
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetSupply() []byte {
+	if m != nil {
+		return m.Supply
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type Block struct {
 	Header       *BlockHeader   `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	Transactions []*Transaction `protobuf:"bytes,2,rep,name=transactions" json:"transactions,omitempty"`
@@ -240,17 +304,18 @@ func (m *Data) GetPayload() []byte {
 }
 
 type Transaction struct {
-	Hash      []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	From      []byte `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To        []byte `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Value     []byte `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Data      *Data  `protobuf:"bytes,6,opt,name=data" json:"data,omitempty"`
-	Nonce     uint64 `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	ChainId   uint32 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Alg       uint32 `protobuf:"varint,9,opt,name=alg,proto3" json:"alg,omitempty"`
-	Sign      []byte `protobuf:"bytes,10,opt,name=sign,proto3" json:"sign,omitempty"`
-	PayerSign []byte `protobuf:"bytes,11,opt,name=payerSign,proto3" json:"payerSign,omitempty"`
+	Hash      []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	From      []byte   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To        []byte   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value     []byte   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp int64    `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Data      *Data    `protobuf:"bytes,6,opt,name=data" json:"data,omitempty"`
+	Nonce     uint64   `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ChainId   uint32   `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Alg       uint32   `protobuf:"varint,9,opt,name=alg,proto3" json:"alg,omitempty"`
+	Sign      []byte   `protobuf:"bytes,10,opt,name=sign,proto3" json:"sign,omitempty"`
+	PayerSign []byte   `protobuf:"bytes,11,opt,name=payerSign,proto3" json:"payerSign,omitempty"`
+	MultiSign [][]byte `protobuf:"bytes,12,rep,name=multiSign" json:"multiSign,omitempty"`
 }
 
 func (m *Transaction) Reset()                    { *m = Transaction{} }
@@ -335,6 +400,13 @@ func (m *Transaction) GetPayerSign() []byte {
 	return nil
 }
 
+func (m *Transaction) GetMultiSign() [][]byte {
+	if m != nil {
+		return m.MultiSign
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BlockHeader)(nil), "corepb.BlockHeader")
 	proto.RegisterType((*Block)(nil), "corepb.Block")
@@ -346,39 +418,46 @@ func init() {
 func init() { proto.RegisterFile("block.proto", fileDescriptorBlock) }
 
 var fileDescriptorBlock = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x55, 0x1c, 0xe7, 0xc3, 0x63, 0x27, 0xc0, 0xb6, 0xaa, 0x0c, 0x14, 0x11, 0x22, 0x21, 0x45,
-	0x42, 0xe4, 0x50, 0x2a, 0x71, 0xe2, 0x82, 0x7a, 0x28, 0x37, 0x58, 0xb8, 0x47, 0x93, 0xf5, 0x36,
-	0xb1, 0x48, 0x76, 0xcd, 0xee, 0xa6, 0x34, 0x3f, 0x80, 0x3b, 0xbf, 0x93, 0x5f, 0x81, 0x76, 0xd6,
-	0x49, 0x1c, 0x68, 0x6f, 0x33, 0xef, 0xbd, 0x9d, 0x99, 0x64, 0xde, 0x18, 0xd2, 0xf9, 0x4a, 0x8b,
-	0xef, 0xd3, 0xca, 0x68, 0xa7, 0x59, 0x57, 0x68, 0x23, 0xab, 0xf9, 0xf8, 0x4f, 0x1b, 0xd2, 0x8f,
-	0x1e, 0xbf, 0x96, 0x58, 0x48, 0xc3, 0x18, 0xc4, 0x4b, 0xb4, 0xcb, 0xbc, 0x35, 0x6a, 0x4d, 0x32,
-	0x4e, 0x31, 0x7b, 0x09, 0x69, 0x85, 0x46, 0x2a, 0x37, 0x23, 0x2a, 0x22, 0x0a, 0x02, 0x74, 0xed,
-	0x05, 0xcf, 0xa0, 0x2f, 0x74, 0xa9, 0xe6, 0x68, 0x65, 0xde, 0x26, 0x76, 0x9f, 0xb3, 0x73, 0x48,
-	0x5c, 0xb9, 0x96, 0xd6, 0xe1, 0xba, 0xca, 0xe3, 0x51, 0x6b, 0xd2, 0xe6, 0x07, 0x80, 0x3d, 0x85,
-	0xbe, 0x58, 0x62, 0xa9, 0x66, 0x65, 0x91, 0x77, 0x46, 0xad, 0xc9, 0x80, 0xf7, 0x28, 0xff, 0x54,
-	0xb0, 0xc7, 0xd0, 0xc6, 0xd5, 0x22, 0xef, 0x12, 0xea, 0x43, 0x3f, 0x9b, 0x2d, 0x17, 0x2a, 0xef,
-	0x85, 0xd9, 0x7c, 0xcc, 0x9e, 0x43, 0x82, 0x42, 0xd8, 0x99, 0xd1, 0xda, 0xe5, 0xfd, 0xd0, 0xdb,
-	0x03, 0x5c, 0x6b, 0xe7, 0xab, 0xbb, 0xbb, 0x9a, 0x4b, 0x88, 0xeb, 0xb9, 0xbb, 0x40, 0xbd, 0x00,
-	0xd8, 0x58, 0x5c, 0xc8, 0x40, 0x02, 0x91, 0x09, 0x21, 0x44, 0xbf, 0x82, 0xcc, 0x48, 0xa1, 0x4d,
-	0x51, 0xbf, 0x4e, 0x49, 0x90, 0xd6, 0x18, 0x49, 0x5e, 0xc3, 0x50, 0xa0, 0x2a, 0xca, 0x02, 0xc5,
-	0x36, 0x88, 0x32, 0x12, 0x0d, 0xf6, 0x28, 0xc9, 0xde, 0x02, 0x13, 0xd2, 0xb8, 0xf2, 0xa6, 0x14,
-	0xe8, 0x4a, 0xad, 0x82, 0x74, 0x40, 0xd2, 0x27, 0x47, 0xcc, 0xbe, 0xaa, 0x56, 0x56, 0x2a, 0xbb,
-	0xa9, 0x5b, 0x0f, 0xeb, 0xaa, 0x3b, 0x94, 0x64, 0x97, 0x70, 0x66, 0xa4, 0x95, 0xe6, 0x36, 0xd4,
-	0xfc, 0xb1, 0x91, 0x1b, 0x19, 0xb6, 0xf3, 0x88, 0xe4, 0xa7, 0x0d, 0xf6, 0x8b, 0x27, 0xfd, 0x9e,
-	0xc6, 0xbf, 0x5a, 0xd0, 0xa1, 0x65, 0xb3, 0x37, 0xd0, 0x5d, 0xd2, 0xc2, 0x69, 0xd1, 0xe9, 0xc5,
-	0xc9, 0x34, 0xf8, 0x61, 0xda, 0xf0, 0x02, 0xaf, 0x25, 0xec, 0x3d, 0x64, 0xce, 0xa0, 0xb2, 0x28,
-	0x7c, 0x39, 0x9b, 0x47, 0xa3, 0x76, 0xf3, 0xc9, 0xb7, 0x03, 0xc7, 0x8f, 0x84, 0xec, 0xcc, 0x77,
-	0x29, 0x17, 0x4b, 0x47, 0xae, 0x88, 0x79, 0x9d, 0x8d, 0x3f, 0xc0, 0xc9, 0x95, 0xfe, 0xa9, 0x56,
-	0x1a, 0x8b, 0xcf, 0xe4, 0xa2, 0x30, 0xd4, 0x7d, 0xde, 0xdb, 0xed, 0x3c, 0x3a, 0xec, 0x7c, 0x7c,
-	0x09, 0xf1, 0x15, 0x3a, 0xf4, 0x9c, 0xdb, 0x56, 0x92, 0xf4, 0x09, 0xa7, 0x98, 0xe5, 0xd0, 0xab,
-	0x70, 0xeb, 0x2b, 0xd7, 0x4f, 0x76, 0xe9, 0xf8, 0x77, 0x04, 0x69, 0x63, 0xd4, 0x87, 0xba, 0xdd,
-	0x18, 0xbd, 0xde, 0x75, 0xf3, 0x31, 0x1b, 0x42, 0xe4, 0x74, 0x6d, 0xeb, 0xc8, 0x69, 0x76, 0x0a,
-	0x9d, 0x5b, 0x5c, 0x6d, 0x24, 0x99, 0x39, 0xe3, 0x21, 0x39, 0xb6, 0x79, 0xe7, 0x5f, 0x9b, 0x8f,
-	0x20, 0x2e, 0xd0, 0x21, 0x99, 0x39, 0xbd, 0xc8, 0x76, 0xff, 0x9c, 0xff, 0x15, 0x9c, 0x18, 0x5f,
-	0x55, 0x69, 0x25, 0x24, 0x99, 0x3b, 0xe6, 0x21, 0x39, 0x3a, 0x8f, 0xfe, 0xbd, 0xe7, 0x91, 0xfc,
-	0x7f, 0x1e, 0xd0, 0x38, 0x8f, 0x73, 0x48, 0x2a, 0xdc, 0x4a, 0xf3, 0xd5, 0x13, 0xc1, 0xc4, 0x07,
-	0x60, 0xde, 0xa5, 0x6f, 0xc1, 0xbb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xd5, 0xb4, 0x3a,
-	0x1a, 0x04, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x73, 0xd3, 0x30,
+	0x10, 0x9d, 0x7c, 0xc7, 0x6b, 0x27, 0x6d, 0xd5, 0x52, 0x0c, 0x94, 0x21, 0x84, 0x61, 0xe8, 0xc0,
+	0xd0, 0x43, 0xe9, 0x0c, 0x27, 0x2e, 0x4c, 0x0f, 0xe5, 0x56, 0x0c, 0xf7, 0x8e, 0x22, 0xab, 0x89,
+	0x06, 0x47, 0x32, 0x92, 0x6c, 0x9a, 0x1f, 0xc0, 0xdf, 0xe1, 0x0f, 0x72, 0x61, 0xb4, 0x92, 0xf3,
+	0x01, 0xe5, 0xa6, 0x7d, 0xef, 0x79, 0x77, 0xad, 0xd5, 0x5b, 0x88, 0x67, 0x85, 0x62, 0xdf, 0xce,
+	0x4a, 0xad, 0xac, 0x22, 0x7d, 0xa6, 0x34, 0x2f, 0x67, 0xd3, 0xdf, 0x3d, 0x88, 0x3f, 0x3a, 0xfc,
+	0x8a, 0xd3, 0x9c, 0x6b, 0x42, 0xa0, 0xbb, 0xa0, 0x66, 0x91, 0xb6, 0x26, 0xad, 0xd3, 0x24, 0xc3,
+	0x33, 0x79, 0x06, 0x71, 0x49, 0x35, 0x97, 0xf6, 0x06, 0xa9, 0x36, 0x52, 0xe0, 0xa1, 0x2b, 0x27,
+	0x78, 0x0c, 0x43, 0xa6, 0x84, 0x9c, 0x51, 0xc3, 0xd3, 0x0e, 0xb2, 0xeb, 0x98, 0x9c, 0x40, 0x64,
+	0xc5, 0x92, 0x1b, 0x4b, 0x97, 0x65, 0xda, 0x9d, 0xb4, 0x4e, 0x3b, 0xd9, 0x06, 0x20, 0x8f, 0x60,
+	0xc8, 0x16, 0x54, 0xc8, 0x1b, 0x91, 0xa7, 0xbd, 0x49, 0xeb, 0x74, 0x94, 0x0d, 0x30, 0xfe, 0x94,
+	0x93, 0x7d, 0xe8, 0xd0, 0x62, 0x9e, 0xf6, 0x11, 0x75, 0x47, 0xd7, 0x9b, 0x11, 0x73, 0x99, 0x0e,
+	0x7c, 0x6f, 0xee, 0x4c, 0x9e, 0x40, 0x44, 0x19, 0x33, 0x37, 0x5a, 0x29, 0x9b, 0x0e, 0x7d, 0x6d,
+	0x07, 0x64, 0x4a, 0x59, 0x97, 0xdd, 0xde, 0x05, 0x2e, 0x42, 0x6e, 0x60, 0xef, 0x3c, 0xf5, 0x14,
+	0xa0, 0x32, 0x74, 0xce, 0x3d, 0x09, 0x48, 0x46, 0x88, 0x20, 0xfd, 0x1c, 0x12, 0xcd, 0x99, 0xd2,
+	0x79, 0xf8, 0x3a, 0x46, 0x41, 0x1c, 0x30, 0x94, 0xbc, 0x84, 0x31, 0xa3, 0x32, 0x17, 0x39, 0x65,
+	0x2b, 0x2f, 0x4a, 0x50, 0x34, 0x5a, 0xa3, 0x28, 0x7b, 0x0b, 0x84, 0x71, 0x6d, 0xc5, 0xad, 0x60,
+	0xd4, 0x0a, 0x25, 0xbd, 0x74, 0x84, 0xd2, 0x83, 0x1d, 0x66, 0x9d, 0x55, 0x49, 0xc3, 0xa5, 0xa9,
+	0x42, 0xe9, 0x71, 0xc8, 0xda, 0xa0, 0x28, 0xbb, 0x80, 0x63, 0xcd, 0x0d, 0xd7, 0xb5, 0xcf, 0xf9,
+	0xbd, 0xe2, 0x15, 0xf7, 0xd3, 0xd9, 0x43, 0xf9, 0xd1, 0x16, 0xfb, 0xd9, 0x91, 0x57, 0x61, 0x90,
+	0xc2, 0x98, 0x8a, 0x6b, 0x9f, 0x79, 0xdf, 0x0f, 0xd2, 0x43, 0x98, 0xf6, 0x05, 0x8c, 0x96, 0x55,
+	0x61, 0x85, 0x11, 0x73, 0x2f, 0x39, 0x40, 0x49, 0xd2, 0x80, 0x28, 0x7a, 0x0d, 0x07, 0x7e, 0x66,
+	0x25, 0xd5, 0x74, 0x69, 0x7c, 0x59, 0x82, 0xc2, 0x3d, 0x24, 0xae, 0x11, 0xc7, 0x8a, 0xaf, 0x60,
+	0x6f, 0xae, 0x6a, 0xae, 0x25, 0x95, 0x2c, 0xdc, 0xf5, 0x21, 0x2a, 0xc7, 0x1b, 0xb8, 0xa9, 0xcc,
+	0x6b, 0x91, 0xf3, 0xb5, 0xec, 0xc8, 0x57, 0x6e, 0xc0, 0x66, 0x68, 0xb5, 0xb2, 0x3c, 0x5c, 0xcc,
+	0x03, 0x3f, 0x34, 0x44, 0x90, 0x3e, 0x86, 0xbe, 0xa9, 0xca, 0xb2, 0x58, 0xa5, 0xc7, 0x48, 0x85,
+	0x88, 0xa4, 0x30, 0xa8, 0xb9, 0x36, 0x42, 0xc9, 0xf4, 0xa1, 0x7f, 0x63, 0x21, 0x9c, 0xfe, 0x6c,
+	0x41, 0x0f, 0x5f, 0x3f, 0x79, 0x03, 0xfd, 0x05, 0x3a, 0x00, 0x5f, 0x7e, 0x7c, 0x7e, 0x78, 0xe6,
+	0x0d, 0x72, 0xb6, 0x65, 0x8e, 0x2c, 0x48, 0xc8, 0x7b, 0x48, 0xac, 0xa6, 0xd2, 0x50, 0xe6, 0xee,
+	0xd7, 0xa4, 0xed, 0x49, 0x67, 0xfb, 0x93, 0xaf, 0x1b, 0x2e, 0xdb, 0x11, 0xba, 0x0e, 0x17, 0x5c,
+	0xcc, 0x17, 0x16, 0x6d, 0xd2, 0xcd, 0x42, 0x34, 0xfd, 0x00, 0x87, 0x97, 0xea, 0x87, 0x2c, 0x14,
+	0xcd, 0xaf, 0xd1, 0x56, 0xbe, 0xa9, 0xfb, 0xcc, 0xd8, 0x98, 0xa0, 0xbd, 0x31, 0xc1, 0xf4, 0x02,
+	0xba, 0x97, 0xd4, 0x52, 0xc7, 0xd9, 0x55, 0xc9, 0x51, 0x1f, 0x65, 0x78, 0x76, 0x3f, 0x5f, 0xd2,
+	0x95, 0xcb, 0x1c, 0x3e, 0x69, 0xc2, 0xe9, 0xaf, 0x36, 0xc4, 0x5b, 0xad, 0xfe, 0xaf, 0xda, 0xad,
+	0x56, 0xcb, 0xa6, 0x9a, 0x3b, 0x93, 0x31, 0xb4, 0xad, 0x0a, 0x3e, 0x6f, 0x5b, 0x45, 0x8e, 0xa0,
+	0x57, 0xd3, 0xa2, 0xe2, 0xe8, 0xee, 0x24, 0xf3, 0xc1, 0xae, 0xef, 0x7b, 0x7f, 0xfb, 0x7e, 0x02,
+	0xdd, 0x9c, 0x5a, 0x8a, 0xee, 0x8e, 0xcf, 0x93, 0xe6, 0xe6, 0xdc, 0x5f, 0x64, 0xc8, 0xb8, 0xac,
+	0x52, 0x49, 0xc6, 0xd1, 0xed, 0xdd, 0xcc, 0x07, 0x3b, 0xfb, 0x62, 0x78, 0xef, 0xbe, 0x88, 0xfe,
+	0xdd, 0x17, 0xb0, 0xb5, 0x2f, 0x4e, 0x20, 0x2a, 0xe9, 0x8a, 0xeb, 0x2f, 0x8e, 0xf0, 0xae, 0xde,
+	0x00, 0x8e, 0xc5, 0xa7, 0x8e, 0x6c, 0x32, 0xe9, 0x38, 0x76, 0x0d, 0xcc, 0xfa, 0xb8, 0x3a, 0xdf,
+	0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x27, 0xac, 0x14, 0x49, 0x05, 0x00, 0x00,
 }
@@ -30,6 +30,9 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type Candidate struct {
 	Address    []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Collateral []byte `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Url        string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	PeerId     string `protobuf:"bytes,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
 }
 
 func (m *Candidate) Reset()                    { *m = Candidate{} }
@@ -51,6 +54,27 @@ func (m *Candidate) GetCollateral() []byte {
 	return nil
 }
 
+func (m *Candidate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Candidate) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Candidate) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Candidate)(nil), "corepb.Candidate")
 }
@@ -58,12 +82,15 @@ func init() {
 func init() { proto.RegisterFile("candidates.proto", fileDescriptorCandidates) }
 
 var fileDescriptorCandidates = []byte{
-	// 102 bytes of a gzipped FileDescriptorProto
+	// 152 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x48, 0x4e, 0xcc, 0x4b,
 	0xc9, 0x4c, 0x49, 0x2c, 0x49, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4b, 0xce,
-	0x2f, 0x4a, 0x2d, 0x48, 0x52, 0x72, 0xe5, 0xe2, 0x74, 0x86, 0xc9, 0x09, 0x49, 0x70, 0xb1, 0x27,
-	0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x04, 0xc1, 0xb8, 0x42,
-	0x72, 0x5c, 0x5c, 0xc9, 0xf9, 0x39, 0x39, 0x89, 0x25, 0xa9, 0x45, 0x89, 0x39, 0x12, 0x4c, 0x60,
-	0x49, 0x24, 0x91, 0x24, 0x36, 0xb0, 0xa9, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x09, 0xc0,
-	0xea, 0x79, 0x69, 0x00, 0x00, 0x00,
+	0x2f, 0x4a, 0x2d, 0x48, 0x52, 0x6a, 0x61, 0xe4, 0xe2, 0x74, 0x86, 0x49, 0x0a, 0x49, 0x70, 0xb1,
+	0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x04, 0xc1, 0xb8,
+	0x42, 0x72, 0x5c, 0x5c, 0xc9, 0xf9, 0x39, 0x39, 0x89, 0x25, 0xa9, 0x45, 0x89, 0x39, 0x12, 0x4c,
+	0x60, 0x49, 0x24, 0x11, 0x21, 0x21, 0x2e, 0x96, 0xbc, 0xc4, 0xdc, 0x54, 0x09, 0x66, 0x05, 0x46,
+	0x0d, 0xce, 0x20, 0x30, 0x5b, 0x48, 0x80, 0x8b, 0xb9, 0xb4, 0x28, 0x47, 0x82, 0x05, 0x2c, 0x04,
+	0x62, 0x0a, 0x89, 0x73, 0xb1, 0x17, 0xa4, 0xa6, 0x16, 0xc5, 0x67, 0xa6, 0x48, 0xb0, 0x82, 0x45,
+	0xd9, 0x40, 0x5c, 0xcf, 0x94, 0x24, 0x36, 0xb0, 0xab, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x17, 0xeb, 0xce, 0x9f, 0xa9, 0x00, 0x00, 0x00,
 }
@@ -28,12 +28,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type Certification struct {
-	CertificateHash []byte `protobuf:"bytes,1,opt,name=certificate_hash,json=certificateHash,proto3" json:"certificate_hash,omitempty"`
-	Issuer          []byte `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	Certified       []byte `protobuf:"bytes,3,opt,name=certified,proto3" json:"certified,omitempty"`
-	IssueTime       int64  `protobuf:"varint,4,opt,name=issue_time,json=issueTime,proto3" json:"issue_time,omitempty"`
-	ExpirationTime  int64  `protobuf:"varint,5,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
-	RevocationTime  int64  `protobuf:"varint,6,opt,name=revocation_time,json=revocationTime,proto3" json:"revocation_time,omitempty"`
+	CertificateHash   []byte `protobuf:"bytes,1,opt,name=certificate_hash,json=certificateHash,proto3" json:"certificate_hash,omitempty"`
+	Issuer            []byte `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Certified         []byte `protobuf:"bytes,3,opt,name=certified,proto3" json:"certified,omitempty"`
+	IssueTime         int64  `protobuf:"varint,4,opt,name=issue_time,json=issueTime,proto3" json:"issue_time,omitempty"`
+	ExpirationTime    int64  `protobuf:"varint,5,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	RevocationTime    int64  `protobuf:"varint,6,opt,name=revocation_time,json=revocationTime,proto3" json:"revocation_time,omitempty"`
+	CertificationType string `protobuf:"bytes,7,opt,name=certification_type,json=certificationType,proto3" json:"certification_type,omitempty"`
+	Expired           bool   `protobuf:"varint,8,opt,name=expired,proto3" json:"expired,omitempty"`
 }
 
 func (m *Certification) Reset()                    { *m = Certification{} }
@@ -83,6 +85,20 @@ func (m *Certification) GetRevocationTime() int64 {
 	return 0
 }
 
+func (m *Certification) GetCertificationType() string {
+	if m != nil {
+		return m.CertificationType
+	}
+	return ""
+}
+
+func (m *Certification) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Certification)(nil), "corepb.Certification")
 }
@@ -90,17 +106,19 @@ func init() {
 func init() { proto.RegisterFile("certification.proto", fileDescriptorCertification) }
 
 var fileDescriptorCertification = []byte{
-	// 182 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4e, 0x4e, 0x2d, 0x2a,
-	0xc9, 0x4c, 0xcb, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x62, 0x4b, 0xce, 0x2f, 0x4a, 0x2d, 0x48, 0x52, 0x7a, 0xc8, 0xc8, 0xc5, 0xeb, 0x8c, 0x2c, 0x2f,
-	0xa4, 0xc9, 0x25, 0x80, 0xd0, 0x90, 0x1a, 0x9f, 0x91, 0x58, 0x9c, 0x21, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0x13, 0xc4, 0x8f, 0x24, 0xee, 0x91, 0x58, 0x9c, 0x21, 0x24, 0xc6, 0xc5, 0x96, 0x59, 0x5c,
-	0x5c, 0x9a, 0x5a, 0x24, 0xc1, 0x04, 0x56, 0x00, 0xe5, 0x09, 0xc9, 0x70, 0x71, 0x42, 0x95, 0xa6,
-	0xa6, 0x48, 0x30, 0x83, 0xa5, 0x10, 0x02, 0x42, 0xb2, 0x5c, 0x5c, 0x60, 0x75, 0xf1, 0x25, 0x99,
-	0xb9, 0xa9, 0x12, 0x2c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x9c, 0x60, 0x91, 0x90, 0xcc, 0xdc, 0x54,
-	0x21, 0x75, 0x2e, 0xfe, 0xd4, 0x8a, 0x82, 0xcc, 0x22, 0xb0, 0x6b, 0x20, 0x6a, 0x58, 0xc1, 0x6a,
-	0xf8, 0x10, 0xc2, 0x30, 0x85, 0x45, 0xa9, 0x65, 0xf9, 0xc9, 0x48, 0x0a, 0xd9, 0x20, 0x0a, 0x11,
-	0xc2, 0x20, 0x85, 0x49, 0x6c, 0x60, 0x2f, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xdb, 0xe4,
-	0xef, 0xa2, 0x09, 0x01, 0x00, 0x00,
+	// 218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0xd0, 0xc1, 0x4a, 0x03, 0x31,
+	0x10, 0x06, 0x60, 0xd2, 0x6a, 0xda, 0x1d, 0xd4, 0xea, 0x08, 0x92, 0x83, 0x42, 0xf0, 0x62, 0x3c,
+	0xe8, 0xc5, 0x47, 0xf0, 0xe2, 0x39, 0xf4, 0xbe, 0x6c, 0xd3, 0x91, 0xcd, 0xa1, 0x26, 0x24, 0x51,
+	0xec, 0x2b, 0xf9, 0x94, 0xc2, 0x74, 0x97, 0xec, 0x1e, 0xe7, 0xff, 0xbf, 0x90, 0x61, 0xe0, 0xd6,
+	0x51, 0x2a, 0xfe, 0xd3, 0xbb, 0xae, 0xf8, 0xf0, 0xf5, 0x1a, 0x53, 0x28, 0x01, 0xa5, 0x0b, 0x89,
+	0xe2, 0xee, 0xf1, 0x6f, 0x01, 0x97, 0xef, 0xd3, 0x1e, 0x9f, 0xe1, 0xba, 0x3e, 0xa0, 0xb6, 0xef,
+	0x72, 0xaf, 0x84, 0x16, 0xe6, 0xc2, 0x6e, 0x26, 0xf9, 0x47, 0x97, 0x7b, 0xbc, 0x03, 0xe9, 0x73,
+	0xfe, 0xa6, 0xa4, 0x16, 0x0c, 0x86, 0x09, 0xef, 0xa1, 0x19, 0x28, 0xed, 0xd5, 0x92, 0xab, 0x1a,
+	0xe0, 0x03, 0x00, 0xbb, 0xb6, 0xf8, 0x03, 0xa9, 0x33, 0x2d, 0xcc, 0xd2, 0x36, 0x9c, 0x6c, 0xfd,
+	0x81, 0xf0, 0x09, 0x36, 0xf4, 0x1b, 0x7d, 0xe2, 0x6d, 0x4e, 0xe6, 0x9c, 0xcd, 0x55, 0x8d, 0x47,
+	0x98, 0xe8, 0x27, 0xb8, 0x09, 0x94, 0x27, 0x58, 0x63, 0x86, 0x2f, 0x80, 0xb3, 0x13, 0xb4, 0xe5,
+	0x18, 0x49, 0xad, 0xb4, 0x30, 0x8d, 0xbd, 0x99, 0x35, 0xdb, 0x63, 0x24, 0x54, 0xb0, 0xe2, 0x9f,
+	0x68, 0xaf, 0xd6, 0x5a, 0x98, 0xb5, 0x1d, 0xc7, 0x9d, 0xe4, 0xdb, 0xbd, 0xfd, 0x07, 0x00, 0x00,
+	0xff, 0xff, 0xc9, 0x87, 0x8d, 0x5f, 0x52, 0x01, 0x00, 0x00,
 }
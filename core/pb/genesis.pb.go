@@ -12,7 +12,9 @@ It has these top-level messages:
 	GenesisMeta
 	GenesisConsensus
 	GenesisConsensusDpos
+	GenesisConsensusPoa
 	GenesisTokenDistribution
+	GenesisIssuer
 */
 package corepb
 
@@ -39,6 +41,10 @@ type Genesis struct {
 	// genesis token distribution address
 	// map<string, string> token_distribution = 3;
 	TokenDistribution []*GenesisTokenDistribution `protobuf:"bytes,3,rep,name=token_distribution,json=tokenDistribution" json:"token_distribution,omitempty"`
+	// genesis root issuers of certifications
+	Issuers []*GenesisIssuer `protobuf:"bytes,4,rep,name=issuers" json:"issuers,omitempty"`
+	// genesis chain parameters. Omitted fields are set to default values.
+	ChainParams *ChainParams `protobuf:"bytes,5,opt,name=chain_params,json=chainParams" json:"chain_params,omitempty"`
 }
 
 func (m *Genesis) Reset()                    { *m = Genesis{} }
@@ -67,10 +73,24 @@ func (m *Genesis) GetTokenDistribution() []*GenesisTokenDistribution {
 	return nil
 }
 
+func (m *Genesis) GetIssuers() []*GenesisIssuer {
+	if m != nil {
+		return m.Issuers
+	}
+	return nil
+}
+
+func (m *Genesis) GetChainParams() *ChainParams {
+	if m != nil {
+		return m.ChainParams
+	}
+	return nil
+}
+
 type GenesisMeta struct {
 	// ChainID.
 	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Dynasty size.
+	// Dynasty size. Must not exceed the number of block slots in a dynasty interval.
 	DynastySize uint32 `protobuf:"varint,2,opt,name=dynasty_size,json=dynastySize,proto3" json:"dynasty_size,omitempty"`
 }
 
@@ -95,6 +115,7 @@ func (m *GenesisMeta) GetDynastySize() uint32 {
 
 type GenesisConsensus struct {
 	Dpos *GenesisConsensusDpos `protobuf:"bytes,1,opt,name=dpos" json:"dpos,omitempty"`
+	Poa  *GenesisConsensusPoa  `protobuf:"bytes,2,opt,name=poa" json:"poa,omitempty"`
 }
 
 func (m *GenesisConsensus) Reset()                    { *m = GenesisConsensus{} }
@@ -109,9 +130,25 @@ func (m *GenesisConsensus) GetDpos() *GenesisConsensusDpos {
 	return nil
 }
 
+func (m *GenesisConsensus) GetPoa() *GenesisConsensusPoa {
+	if m != nil {
+		return m.Poa
+	}
+	return nil
+}
+
 type GenesisConsensusDpos struct {
 	// dpos genesis dynasty address.
 	Dynasty []string `protobuf:"bytes,1,rep,name=dynasty" json:"dynasty,omitempty"`
+	// interval between blocks in seconds. (default: 15)
+	BlockInterval int64 `protobuf:"varint,2,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// interval between dynasty elections in seconds. Must be a multiple of block_interval. (default: 3150)
+	DynastyInterval int64 `protobuf:"varint,3,opt,name=dynasty_interval,json=dynastyInterval,proto3" json:"dynasty_interval,omitempty"`
+	// duration in milliseconds before the next slot within which a proposer mints without waiting
+	// for the block of the last slot. Must be shorter than block_interval. (default: 2000)
+	MinMintDuration int64 `protobuf:"varint,4,opt,name=min_mint_duration,json=minMintDuration,proto3" json:"min_mint_duration,omitempty"`
+	// interval in milliseconds of checking the time to mint. Must not be longer than min_mint_duration. (default: 1000)
+	MiningTickInterval int64 `protobuf:"varint,5,opt,name=mining_tick_interval,json=miningTickInterval,proto3" json:"mining_tick_interval,omitempty"`
 }
 
 func (m *GenesisConsensusDpos) Reset()                    { *m = GenesisConsensusDpos{} }
@@ -126,6 +163,70 @@ func (m *GenesisConsensusDpos) GetDynasty() []string {
 	return nil
 }
 
+func (m *GenesisConsensusDpos) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetDynastyInterval() int64 {
+	if m != nil {
+		return m.DynastyInterval
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetMinMintDuration() int64 {
+	if m != nil {
+		return m.MinMintDuration
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetMiningTickInterval() int64 {
+	if m != nil {
+		return m.MiningTickInterval
+	}
+	return 0
+}
+
+type GenesisConsensusPoa struct {
+	// interval between blocks in seconds. (default: 5)
+	BlockInterval int64 `protobuf:"varint,1,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// interval in seconds after which validators are replaced by chain parameters.
+	// Must be a multiple of block_interval. (default: 600)
+	EpochInterval int64 `protobuf:"varint,2,opt,name=epoch_interval,json=epochInterval,proto3" json:"epoch_interval,omitempty"`
+	// interval in milliseconds of checking the time to mint. Must not be longer than block_interval. (default: 1000)
+	MiningTickInterval int64 `protobuf:"varint,3,opt,name=mining_tick_interval,json=miningTickInterval,proto3" json:"mining_tick_interval,omitempty"`
+}
+
+func (m *GenesisConsensusPoa) Reset()                    { *m = GenesisConsensusPoa{} }
+func (m *GenesisConsensusPoa) String() string            { return proto.CompactTextString(m) }
+func (*GenesisConsensusPoa) ProtoMessage()               {}
+func (*GenesisConsensusPoa) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+
+func (m *GenesisConsensusPoa) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+func (m *GenesisConsensusPoa) GetEpochInterval() int64 {
+	if m != nil {
+		return m.EpochInterval
+	}
+	return 0
+}
+
+func (m *GenesisConsensusPoa) GetMiningTickInterval() int64 {
+	if m != nil {
+		return m.MiningTickInterval
+	}
+	return 0
+}
+
 type GenesisTokenDistribution struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -134,7 +235,7 @@ type GenesisTokenDistribution struct {
 func (m *GenesisTokenDistribution) Reset()                    { *m = GenesisTokenDistribution{} }
 func (m *GenesisTokenDistribution) String() string            { return proto.CompactTextString(m) }
 func (*GenesisTokenDistribution) ProtoMessage()               {}
-func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{5} }
 
 func (m *GenesisTokenDistribution) GetAddress() string {
 	if m != nil {
@@ -150,34 +251,82 @@ func (m *GenesisTokenDistribution) GetValue() string {
 	return ""
 }
 
+type GenesisIssuer struct {
+	Address   string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name      string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CertTypes []string `protobuf:"bytes,3,rep,name=cert_types,json=certTypes" json:"cert_types,omitempty"`
+}
+
+func (m *GenesisIssuer) Reset()                    { *m = GenesisIssuer{} }
+func (m *GenesisIssuer) String() string            { return proto.CompactTextString(m) }
+func (*GenesisIssuer) ProtoMessage()               {}
+func (*GenesisIssuer) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{6} }
+
+func (m *GenesisIssuer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GenesisIssuer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GenesisIssuer) GetCertTypes() []string {
+	if m != nil {
+		return m.CertTypes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Genesis)(nil), "corepb.Genesis")
 	proto.RegisterType((*GenesisMeta)(nil), "corepb.GenesisMeta")
 	proto.RegisterType((*GenesisConsensus)(nil), "corepb.GenesisConsensus")
 	proto.RegisterType((*GenesisConsensusDpos)(nil), "corepb.GenesisConsensusDpos")
+	proto.RegisterType((*GenesisConsensusPoa)(nil), "corepb.GenesisConsensusPoa")
 	proto.RegisterType((*GenesisTokenDistribution)(nil), "corepb.GenesisTokenDistribution")
+	proto.RegisterType((*GenesisIssuer)(nil), "corepb.GenesisIssuer")
 }
 
 func init() { proto.RegisterFile("genesis.proto", fileDescriptorGenesis) }
 
 var fileDescriptorGenesis = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x51, 0x4b, 0xc3, 0x30,
-	0x14, 0x85, 0xa9, 0x9d, 0xab, 0xbd, 0xb5, 0xa0, 0x71, 0x0f, 0x11, 0x7c, 0xa8, 0x7d, 0xb1, 0x4f,
-	0x65, 0x4c, 0xf0, 0x0f, 0x58, 0x10, 0x15, 0x11, 0xa2, 0xef, 0x25, 0x6d, 0x82, 0x06, 0x35, 0x29,
-	0xbd, 0xa9, 0xb0, 0xfd, 0x35, 0xff, 0x9c, 0x2c, 0x6d, 0x71, 0x14, 0xf7, 0x78, 0xee, 0xf9, 0x92,
-	0x9c, 0x93, 0x0b, 0xf1, 0x9b, 0xd4, 0x12, 0x15, 0xe6, 0x4d, 0x6b, 0xac, 0x21, 0xf3, 0xda, 0xb4,
-	0xb2, 0xa9, 0xd2, 0x1f, 0x0f, 0x82, 0xbb, 0xde, 0x21, 0x57, 0x30, 0xfb, 0x92, 0x96, 0x53, 0x2f,
-	0xf1, 0xb2, 0x68, 0x75, 0x96, 0xf7, 0x48, 0x3e, 0xd8, 0x4f, 0xd2, 0x72, 0xe6, 0x00, 0x72, 0x03,
-	0x61, 0x6d, 0x34, 0x4a, 0x8d, 0x1d, 0xd2, 0x03, 0x47, 0xd3, 0x09, 0x7d, 0x3b, 0xfa, 0xec, 0x0f,
-	0x25, 0xcf, 0x40, 0xac, 0xf9, 0x90, 0xba, 0x14, 0x0a, 0x6d, 0xab, 0xaa, 0xce, 0x2a, 0xa3, 0xa9,
-	0x9f, 0xf8, 0x59, 0xb4, 0x4a, 0x26, 0x17, 0xbc, 0x6e, 0xc1, 0x62, 0x87, 0x63, 0xa7, 0x76, 0x3a,
-	0x4a, 0x1f, 0x21, 0xda, 0x49, 0x47, 0xce, 0xe1, 0xa8, 0x7e, 0xe7, 0x4a, 0x97, 0x4a, 0xb8, 0x12,
-	0x31, 0x0b, 0x9c, 0xbe, 0x17, 0xe4, 0x12, 0x8e, 0xc5, 0x5a, 0x73, 0xb4, 0xeb, 0x12, 0xd5, 0x46,
-	0xba, 0xd4, 0x31, 0x8b, 0x86, 0xd9, 0x8b, 0xda, 0xc8, 0xb4, 0x80, 0x93, 0x69, 0x78, 0xb2, 0x84,
-	0x99, 0x68, 0x0c, 0x0e, 0x5f, 0x72, 0xb1, 0xaf, 0x64, 0xd1, 0x18, 0x64, 0x8e, 0x4c, 0x97, 0xb0,
-	0xf8, 0xcf, 0x25, 0x14, 0x82, 0xe1, 0x31, 0xea, 0x25, 0x7e, 0x16, 0xb2, 0x51, 0xa6, 0x0f, 0x40,
-	0xf7, 0x75, 0xde, 0x9e, 0xe2, 0x42, 0xb4, 0x12, 0xfb, 0x08, 0x21, 0x1b, 0x25, 0x59, 0xc0, 0xe1,
-	0x37, 0xff, 0xec, 0xfa, 0x26, 0x21, 0xeb, 0x45, 0x35, 0x77, 0xdb, 0xbd, 0xfe, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x35, 0x12, 0x14, 0xd6, 0xee, 0x01, 0x00, 0x00,
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xef, 0x6a, 0xdb, 0x30,
+	0x14, 0xc5, 0x71, 0x9d, 0x34, 0xf3, 0x75, 0xdd, 0xb5, 0xb7, 0x19, 0x78, 0xff, 0x20, 0x33, 0x94,
+	0x65, 0x83, 0x65, 0xa5, 0x83, 0xbe, 0x40, 0x03, 0x23, 0x1b, 0x65, 0x45, 0xcb, 0xc7, 0x81, 0x51,
+	0x6c, 0xd1, 0x8a, 0xc6, 0x92, 0xb1, 0xe4, 0x42, 0xfa, 0x1e, 0x7b, 0x83, 0x3d, 0xd8, 0x1e, 0x65,
+	0x58, 0x96, 0x93, 0xd4, 0x8b, 0xfb, 0x2d, 0xf7, 0x9c, 0x9f, 0x6e, 0xee, 0x3d, 0x52, 0x02, 0xc1,
+	0x0d, 0x13, 0x4c, 0x71, 0x35, 0xc9, 0x0b, 0xa9, 0x25, 0xee, 0x27, 0xb2, 0x60, 0xf9, 0xe2, 0x15,
+	0x26, 0xb7, 0x94, 0x8b, 0x38, 0xa7, 0x05, 0xcd, 0xac, 0x17, 0xfd, 0xd9, 0x83, 0xc1, 0xd7, 0x9a,
+	0xc6, 0xf7, 0xd0, 0xcb, 0x98, 0xa6, 0xa1, 0x33, 0x72, 0xc6, 0xfe, 0xf9, 0xc9, 0xa4, 0x3e, 0x36,
+	0xb1, 0xf6, 0x15, 0xd3, 0x94, 0x18, 0x00, 0x2f, 0xc0, 0x4b, 0xa4, 0x50, 0x4c, 0xa8, 0x52, 0x85,
+	0x7b, 0x86, 0x0e, 0x5b, 0xf4, 0x65, 0xe3, 0x93, 0x0d, 0x8a, 0x3f, 0x00, 0xb5, 0xbc, 0x63, 0x22,
+	0x4e, 0xb9, 0xd2, 0x05, 0x5f, 0x94, 0x9a, 0x4b, 0x11, 0xba, 0x23, 0x77, 0xec, 0x9f, 0x8f, 0x5a,
+	0x0d, 0xe6, 0x15, 0x38, 0xdd, 0xe2, 0xc8, 0xb1, 0x6e, 0x4b, 0xf8, 0x19, 0x06, 0x5c, 0xa9, 0x92,
+	0x15, 0x2a, 0xec, 0x99, 0x2e, 0x2f, 0x5a, 0x5d, 0x66, 0xc6, 0x25, 0x0d, 0x85, 0x17, 0x70, 0xb0,
+	0x1d, 0x42, 0xd8, 0x7f, 0xbc, 0xea, 0x65, 0xe5, 0x5d, 0x1b, 0x8b, 0xf8, 0xc9, 0xa6, 0x88, 0xbe,
+	0x83, 0xbf, 0x15, 0x03, 0xbe, 0x84, 0x67, 0x75, 0x1b, 0x9e, 0x9a, 0xb4, 0x02, 0x32, 0x30, 0xf5,
+	0x2c, 0xc5, 0x77, 0x70, 0x90, 0xae, 0x04, 0x55, 0x7a, 0x15, 0x2b, 0xfe, 0xc0, 0x4c, 0x3c, 0x01,
+	0xf1, 0xad, 0xf6, 0x93, 0x3f, 0xb0, 0x48, 0xc1, 0x51, 0x3b, 0x25, 0x3c, 0x83, 0x5e, 0x9a, 0x4b,
+	0x65, 0xb3, 0x7f, 0xd3, 0x95, 0xe6, 0x34, 0x97, 0x8a, 0x18, 0x12, 0x3f, 0x81, 0x9b, 0x4b, 0x6a,
+	0xe3, 0x7f, 0xdd, 0x75, 0xe0, 0x5a, 0x52, 0x52, 0x71, 0xd1, 0x5f, 0x07, 0x86, 0xbb, 0xba, 0x61,
+	0x08, 0x03, 0x3b, 0x5c, 0xe8, 0x8c, 0xdc, 0xb1, 0x47, 0x9a, 0x12, 0x4f, 0xe1, 0x70, 0xb1, 0x94,
+	0xc9, 0x5d, 0xcc, 0x85, 0x66, 0xc5, 0x3d, 0x5d, 0x9a, 0x2f, 0x73, 0x49, 0x60, 0xd4, 0x99, 0x15,
+	0xf1, 0x03, 0x1c, 0x35, 0x1b, 0xaf, 0x41, 0xd7, 0x80, 0xcf, 0xad, 0xbe, 0x46, 0x3f, 0xc2, 0x71,
+	0xc6, 0x45, 0x9c, 0x71, 0xa1, 0xe3, 0xb4, 0x2c, 0xa8, 0xb9, 0xff, 0x5e, 0xcd, 0x66, 0x5c, 0x5c,
+	0x71, 0xa1, 0xa7, 0x56, 0xc6, 0x33, 0x18, 0x66, 0x5c, 0x70, 0x71, 0x13, 0x6b, 0xbe, 0x3d, 0x43,
+	0xdf, 0xe0, 0x58, 0x7b, 0x73, 0xbe, 0x19, 0x24, 0xfa, 0xed, 0xc0, 0xc9, 0x8e, 0xfd, 0x77, 0xec,
+	0xe1, 0xec, 0xda, 0xe3, 0x14, 0x0e, 0x59, 0x2e, 0x93, 0xdb, 0xff, 0xd6, 0x35, 0xea, 0x1a, 0xeb,
+	0x9a, 0xcb, 0xed, 0x9c, 0xeb, 0x1b, 0x84, 0x5d, 0x8f, 0xba, 0x4a, 0x9f, 0xa6, 0x69, 0xc1, 0x54,
+	0x7d, 0xf5, 0x1e, 0x69, 0x4a, 0x1c, 0x42, 0xff, 0x9e, 0x2e, 0xcb, 0xfa, 0x05, 0x79, 0xa4, 0x2e,
+	0xa2, 0x5f, 0x10, 0x3c, 0x7a, 0xda, 0x4f, 0x34, 0x40, 0xe8, 0x09, 0x9a, 0x35, 0xe7, 0xcd, 0x67,
+	0x7c, 0x0b, 0x90, 0xb0, 0x42, 0xc7, 0x7a, 0x95, 0x33, 0x65, 0x7e, 0x79, 0x1e, 0xf1, 0x2a, 0x65,
+	0x5e, 0x09, 0x8b, 0x7d, 0xf3, 0xa7, 0xf0, 0xe5, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfc, 0xf5,
+	0x5a, 0x0c, 0x41, 0x04, 0x00, 0x00,
 }
@@ -33,6 +33,7 @@ type ReservedTask struct {
 	From      []byte `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
 	Payload   []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
 	Timestamp int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Height    uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *ReservedTask) Reset()                    { *m = ReservedTask{} }
@@ -68,6 +69,13 @@ func (m *ReservedTask) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *ReservedTask) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type ReservedTasks struct {
 	Tasks []*ReservedTask `protobuf:"bytes,1,rep,name=tasks" json:"tasks,omitempty"`
 }
@@ -92,16 +100,17 @@ func init() {
 func init() { proto.RegisterFile("reserved_task.proto", fileDescriptorReservedTask) }
 
 var fileDescriptorReservedTask = []byte{
-	// 170 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8e, 0x31, 0x0b, 0xc2, 0x30,
-	0x10, 0x85, 0x89, 0xad, 0x95, 0x9e, 0x75, 0x89, 0x0e, 0x19, 0x1c, 0x42, 0xa7, 0xe0, 0xd0, 0x41,
-	0x47, 0xff, 0x45, 0x70, 0x97, 0xd4, 0x9e, 0x20, 0x35, 0x26, 0xe4, 0x82, 0xd0, 0x7f, 0x2f, 0x4d,
-	0x15, 0xbb, 0xbd, 0xfb, 0xee, 0xc1, 0xfb, 0x60, 0x1b, 0x90, 0x30, 0xbc, 0xb1, 0xbb, 0x46, 0x43,
-	0x7d, 0xe3, 0x83, 0x8b, 0x8e, 0x17, 0x37, 0x17, 0xd0, 0xb7, 0xf5, 0x0b, 0x2a, 0xfd, 0x7d, 0x5f,
-	0x0c, 0xf5, 0x9c, 0x43, 0x1e, 0x07, 0x8f, 0x82, 0x49, 0xa6, 0x4a, 0x9d, 0xf2, 0xc8, 0xee, 0xc1,
-	0x59, 0xb1, 0x90, 0x4c, 0x55, 0x3a, 0x65, 0x2e, 0x60, 0xe5, 0xcd, 0xf0, 0x74, 0xa6, 0x13, 0x59,
-	0xc2, 0xbf, 0x93, 0xef, 0xa1, 0x8c, 0x0f, 0x8b, 0x14, 0x8d, 0xf5, 0x22, 0x97, 0x4c, 0x65, 0xfa,
-	0x0f, 0xea, 0x33, 0x6c, 0xe6, 0x7b, 0xc4, 0x0f, 0xb0, 0x1c, 0xb5, 0x48, 0x30, 0x99, 0xa9, 0xf5,
-	0x71, 0xd7, 0x4c, 0x62, 0xcd, 0xbc, 0xa5, 0xa7, 0x4a, 0x5b, 0x24, 0xf7, 0xd3, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0xe8, 0x00, 0x6c, 0x9c, 0xd2, 0x00, 0x00, 0x00,
+	// 189 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8f, 0xbf, 0xce, 0x82, 0x30,
+	0x14, 0xc5, 0xd3, 0x8f, 0x3f, 0x5f, 0xb8, 0xe2, 0x52, 0x8d, 0xe9, 0xe0, 0xd0, 0x30, 0x35, 0x0e,
+	0x0c, 0x3a, 0xfa, 0x16, 0x8d, 0xbb, 0x29, 0x72, 0x15, 0x82, 0xa4, 0x4d, 0xdb, 0x98, 0xf0, 0x02,
+	0x3e, 0xb7, 0xa1, 0x60, 0x64, 0x3b, 0xe7, 0x77, 0xcf, 0xf0, 0xbb, 0xb0, 0xb1, 0xe8, 0xd0, 0xbe,
+	0xb0, 0xbe, 0x7a, 0xe5, 0xba, 0xd2, 0x58, 0xed, 0x35, 0x4d, 0x6f, 0xda, 0xa2, 0xa9, 0x8a, 0x37,
+	0x81, 0x5c, 0xce, 0xf7, 0x8b, 0x72, 0x1d, 0xa5, 0x10, 0xfb, 0xc1, 0x20, 0x23, 0x9c, 0x88, 0x4c,
+	0x86, 0x3c, 0xb2, 0xbb, 0xd5, 0x3d, 0xfb, 0xe3, 0x44, 0xe4, 0x32, 0x64, 0xca, 0xe0, 0xdf, 0xa8,
+	0xe1, 0xa9, 0x55, 0xcd, 0xa2, 0x80, 0xbf, 0x95, 0xee, 0x21, 0xf3, 0x6d, 0x8f, 0xce, 0xab, 0xde,
+	0xb0, 0x98, 0x13, 0x11, 0xc9, 0x1f, 0xa0, 0x3b, 0x48, 0x1b, 0x6c, 0x1f, 0x8d, 0x67, 0x09, 0x27,
+	0x22, 0x96, 0x73, 0x2b, 0xce, 0xb0, 0x5e, 0x7a, 0x38, 0x7a, 0x80, 0x64, 0xf4, 0x75, 0x8c, 0xf0,
+	0x48, 0xac, 0x8e, 0xdb, 0x72, 0x32, 0x2e, 0x97, 0x2b, 0x39, 0x4d, 0xaa, 0x34, 0x3c, 0x75, 0xfa,
+	0x04, 0x00, 0x00, 0xff, 0xff, 0x9a, 0xc3, 0x83, 0xf1, 0xeb, 0x00, 0x00, 0x00,
 }
@@ -22,6 +22,8 @@ import (
 	"github.com/medibloc/go-medibloc/core/pb"
 	"github.com/medibloc/go-medibloc/storage"
 	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -169,21 +171,51 @@ func (rq *ReservationQueue) AddTask(t *ReservedTask) error {
 	}
 	rq.tasks = append(rq.tasks, t)
 	sort.Sort(rq.tasks)
-	hash, err := rq.calcHash()
-	if err != nil {
-		return err
+	return rq.updateHash()
+}
+
+// RemoveTask removes a task of which hash is equal to taskHash and calculate new hash
+func (rq *ReservationQueue) RemoveTask(taskHash []byte) (*ReservedTask, error) {
+	if !rq.batching {
+		return nil, ErrReservationQueueNotBatching
 	}
-	rq.hash = hash
-	return nil
+	for i, t := range rq.tasks {
+		hash, err := t.calcHash()
+		if err != nil {
+			return nil, err
+		}
+		if !byteutils.Equal(hash, taskHash) {
+			continue
+		}
+		rq.tasks = append(rq.tasks[:i:i], rq.tasks[i+1:]...)
+		if err := rq.updateHash(); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+	return nil, ErrReservedTaskNotFound
 }
 
-// PopTasksBefore pop tasks of which timestamp is
-func (rq *ReservationQueue) PopTasksBefore(timestamp int64) []*ReservedTask {
+// PopTasksBefore pop tasks of which timestamp is not after timestamp.
+// Tasks reserved at a height above the given height are left in queue.
+func (rq *ReservationQueue) PopTasksBefore(timestamp int64, height uint64) []*ReservedTask {
 	tasks := []*ReservedTask{}
-	t := rq.popOnlyBefore(timestamp)
-	for t != nil {
+	remains := ReservedTasks{}
+	for len(rq.tasks) > 0 && rq.Peek().timestamp <= timestamp {
+		t := rq.pop()
+		if t.height > height {
+			remains = append(remains, t)
+			continue
+		}
 		tasks = append(tasks, t)
-		t = rq.popOnlyBefore(timestamp)
+	}
+	rq.tasks = append(remains, rq.tasks...)
+	if len(tasks) > 0 {
+		if err := rq.updateHash(); err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"err": err,
+			}).Error("Failed to calculate hash of reservation queue.")
+		}
 	}
 	return tasks
 }
@@ -202,18 +234,6 @@ func (rq *ReservationQueue) pop() *ReservedTask {
 	return t
 }
 
-func (rq *ReservationQueue) popOnlyBefore(timestamp int64) *ReservedTask {
-	if len(rq.tasks) == 0 {
-		return nil
-	}
-
-	head := rq.Peek()
-	if head.timestamp <= timestamp {
-		return rq.pop()
-	}
-	return nil
-}
-
 func (rq *ReservationQueue) save() error {
 	if rq.hash == nil {
 		return nil
@@ -229,6 +249,15 @@ func (rq *ReservationQueue) save() error {
 	return rq.storage.Put(rq.hash, b)
 }
 
+func (rq *ReservationQueue) updateHash() error {
+	hash, err := rq.calcHash()
+	if err != nil {
+		return err
+	}
+	rq.hash = hash
+	return nil
+}
+
 func (rq *ReservationQueue) calcHash() ([]byte, error) {
 	hasher := sha3.New256()
 	for _, t := range rq.tasks {
@@ -109,7 +109,7 @@ func TestPopTasksBefore(t *testing.T) {
 		)))
 	}
 	rq.Commit()
-	tasks := rq.PopTasksBefore(1350000000)
+	tasks := rq.PopTasksBefore(1350000000, 0)
 	assert.Equal(t, 2, len(tasks))
 	assert.Equal(t, 1, len(rq.Tasks()))
 	assert.Equal(t, data[0].taskType, tasks[0].TaskType())
@@ -127,3 +127,66 @@ func TestPopTasksBefore(t *testing.T) {
 	assert.Equal(t, data[2].payload, rq.Tasks()[0].Payload())
 	assert.Equal(t, data[2].timestamp, rq.Tasks()[0].Timestamp())
 }
+
+func TestProtoReservedTaskTypes(t *testing.T) {
+	from := common.HexToAddress("02fc22ea22d02fc2469f5ec8fab44bc3de42dda2bf9ebc0c0055a9eb7df579056c")
+	to := common.HexToAddress("03528fa3684218f32c9fd7726a2839cff3ddef49d89bf4904af11bc12335f7c939")
+	transfer, err := core.NewRtTransfer(to, util.NewUint128FromUint(100))
+	assert.NoError(t, err)
+	unbond, err := core.NewRtUnbondCollateral(util.NewUint128FromUint(200))
+	assert.NoError(t, err)
+
+	data := []struct {
+		taskType string
+		payload  core.Serializable
+	}{
+		{core.RtTransferType, transfer},
+		{core.RtUnbondCollateralType, unbond},
+	}
+	for _, d := range data {
+		task := core.NewReservedTask(d.taskType, from, d.payload, int64(1524192961))
+		msg, err := task.ToProto()
+		assert.NoError(t, err)
+		task2 := new(core.ReservedTask)
+		assert.NoError(t, task2.FromProto(msg))
+		assert.Equal(t, task, task2)
+	}
+
+	task := core.NewReservedTask("unknown", from, transfer, int64(1524192961))
+	msg, err := task.ToProto()
+	assert.NoError(t, err)
+	assert.Equal(t, core.ErrInvalidReservedTaskType, new(core.ReservedTask).FromProto(msg))
+}
+
+func TestRemoveTask(t *testing.T) {
+	from := common.HexToAddress("02fc22ea22d02fc2469f5ec8fab44bc3de42dda2bf9ebc0c0055a9eb7df579056c")
+	genesis, _, _ := testutil.NewTestGenesisBlock(t)
+
+	rq := core.NewEmptyReservationQueue(genesis.Storage())
+	rq.BeginBatch()
+	var hashes [][]byte
+	for i := 0; i < 3; i++ {
+		w, err := core.NewRtWithdraw(util.NewUint128FromUint(uint64(i)))
+		assert.NoError(t, err)
+		task := core.NewReservedTask(core.RtWithdrawType, from, w, int64(1200000000+i))
+		assert.NoError(t, rq.AddTask(task))
+		hash, err := task.Hash()
+		assert.NoError(t, err)
+		hashes = append(hashes, hash)
+	}
+
+	removed, err := rq.RemoveTask(hashes[1])
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1200000001), removed.Timestamp())
+	_, err = rq.RemoveTask(hashes[1])
+	assert.Equal(t, core.ErrReservedTaskNotFound, err)
+	rq.Commit()
+
+	assert.Equal(t, 2, len(rq.Tasks()))
+	_, err = rq.RemoveTask(hashes[0])
+	assert.Equal(t, core.ErrReservationQueueNotBatching, err)
+
+	rq2, err := core.LoadReservationQueue(rq.Storage(), rq.Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, rq.Tasks(), rq2.Tasks())
+}
@@ -24,12 +24,55 @@ import (
 	"golang.org/x/crypto/sha3"
 )
 
+// ReservedTaskHandler defines how a type of reserved task is decoded, executed and cancelled
+type ReservedTaskHandler struct {
+	// NewPayload returns an empty payload to be deserialized
+	NewPayload func() Serializable
+	// Execute applies the task on block state when its timestamp has come
+	Execute func(t *ReservedTask, bs *BlockState) error
+	// Cancel reverts what was done when the task was reserved. Tasks without Cancel are not cancellable.
+	Cancel func(t *ReservedTask, bs *BlockState) error
+}
+
+var reservedTaskHandlers = make(map[string]*ReservedTaskHandler)
+
+// RegisterReservedTaskType registers a handler for a type of reserved task
+func RegisterReservedTaskType(taskType string, handler *ReservedTaskHandler) {
+	reservedTaskHandlers[taskType] = handler
+}
+
+func init() {
+	RegisterReservedTaskType(RtWithdrawType, &ReservedTaskHandler{
+		NewPayload: func() Serializable { return &RtWithdraw{Amount: util.NewUint128()} },
+		Execute:    withdraw,
+		Cancel:     cancelWithdraw,
+	})
+	RegisterReservedTaskType(RtTransferType, &ReservedTaskHandler{
+		NewPayload: func() Serializable { return &RtTransfer{Amount: util.NewUint128()} },
+		Execute:    scheduledTransfer,
+		Cancel:     cancelScheduledTransfer,
+	})
+	RegisterReservedTaskType(RtUnbondCollateralType, &ReservedTaskHandler{
+		NewPayload: func() Serializable { return &RtUnbondCollateral{Amount: util.NewUint128()} },
+		Execute:    unbondCollateral,
+	})
+	RegisterReservedTaskType(RtTallyProposalType, &ReservedTaskHandler{
+		NewPayload: func() Serializable { return new(RtTallyProposal) },
+		Execute:    tallyProposal,
+	})
+	RegisterReservedTaskType(RtApplyProposalType, &ReservedTaskHandler{
+		NewPayload: func() Serializable { return new(RtApplyProposal) },
+		Execute:    applyProposal,
+	})
+}
+
 // ReservedTask is a data representing reserved task
 type ReservedTask struct {
 	taskType  string
 	from      common.Address
 	payload   Serializable
 	timestamp int64
+	height    uint64
 }
 
 // NewReservedTask generates a new instance of ReservedTask
@@ -42,6 +85,15 @@ func NewReservedTask(taskType string, from common.Address, payload Serializable,
 	}
 }
 
+// NewReservedTaskAtHeight generates a new instance of ReservedTask which is not executed
+// before the block of given height even if its timestamp has come
+func NewReservedTaskAtHeight(taskType string, from common.Address, payload Serializable, timestamp int64,
+	height uint64) *ReservedTask {
+	t := NewReservedTask(taskType, from, payload, timestamp)
+	t.height = height
+	return t
+}
+
 // ToProto converts ReservedTask to corepb.ReservedTask
 func (t *ReservedTask) ToProto() (proto.Message, error) {
 	payloadBytes, err := t.payload.Serialize()
@@ -53,6 +105,7 @@ func (t *ReservedTask) ToProto() (proto.Message, error) {
 		From:      t.from.Bytes(),
 		Payload:   payloadBytes,
 		Timestamp: t.timestamp,
+		Height:    t.height,
 	}, nil
 }
 
@@ -62,23 +115,17 @@ func (t *ReservedTask) FromProto(msg proto.Message) error {
 		t.taskType = msg.Type
 		t.from = common.BytesToAddress(msg.From)
 
-		var payload Serializable
-		var err error
-		switch msg.Type {
-		case RtWithdrawType:
-			payload, err = NewRtWithdraw(util.NewUint128())
-		default:
+		handler, ok := reservedTaskHandlers[msg.Type]
+		if !ok {
 			return ErrInvalidReservedTaskType
 		}
-		if err != nil {
-			return err
-		}
-
+		payload := handler.NewPayload()
 		if err := payload.Deserialize(msg.Payload); err != nil {
 			return err
 		}
 		t.payload = payload
 		t.timestamp = msg.Timestamp
+		t.height = msg.Height
 		return nil
 	}
 
@@ -105,6 +152,16 @@ func (t *ReservedTask) Timestamp() int64 {
 	return t.timestamp
 }
 
+// Height returns t.height
+func (t *ReservedTask) Height() uint64 {
+	return t.height
+}
+
+// Hash returns hash of the task which identifies it in reservation queue
+func (t *ReservedTask) Hash() ([]byte, error) {
+	return t.calcHash()
+}
+
 func (t *ReservedTask) calcHash() ([]byte, error) {
 	hasher := sha3.New256()
 
@@ -116,24 +173,70 @@ func (t *ReservedTask) calcHash() ([]byte, error) {
 	}
 	hasher.Write(payloadBytes)
 	hasher.Write(byteutils.FromInt64(t.timestamp))
+	if t.height > 0 {
+		hasher.Write(byteutils.FromUint64(t.height))
+	}
 
 	return hasher.Sum(nil), nil
 }
 
 // ExecuteOnState following task's type and payload
 func (t *ReservedTask) ExecuteOnState(bs *BlockState) error {
-	switch t.taskType {
-	case RtWithdrawType:
-		return t.withdraw(bs)
-	default:
+	handler, ok := reservedTaskHandlers[t.taskType]
+	if !ok {
+		return ErrInvalidReservedTaskType
+	}
+	return handler.Execute(t, bs)
+}
+
+// Cancellable returns true if the task can be cancelled by its owner
+func (t *ReservedTask) Cancellable() bool {
+	handler, ok := reservedTaskHandlers[t.taskType]
+	return ok && handler.Cancel != nil
+}
+
+// CancelOnState reverts what was done on state when the task was reserved
+func (t *ReservedTask) CancelOnState(bs *BlockState) error {
+	handler, ok := reservedTaskHandlers[t.taskType]
+	if !ok {
 		return ErrInvalidReservedTaskType
 	}
+	if handler.Cancel == nil {
+		return ErrReservedTaskNotCancellable
+	}
+	return handler.Cancel(t, bs)
 }
 
-func (t *ReservedTask) withdraw(bs *BlockState) error {
+func withdraw(t *ReservedTask, bs *BlockState) error {
 	amount := t.payload.(*RtWithdraw).Amount
 	if err := bs.SubVesting(t.from, amount); err != nil {
 		return err
 	}
 	return bs.AddBalance(t.from, amount)
 }
+
+// vesting is subtracted only when a withdraw task is executed, so there is nothing to revert.
+func cancelWithdraw(t *ReservedTask, bs *BlockState) error {
+	return nil
+}
+
+func scheduledTransfer(t *ReservedTask, bs *BlockState) error {
+	payload := t.payload.(*RtTransfer)
+	return bs.AddBalance(payload.To, payload.Amount)
+}
+
+func cancelScheduledTransfer(t *ReservedTask, bs *BlockState) error {
+	return bs.AddBalance(t.from, t.payload.(*RtTransfer).Amount)
+}
+
+func unbondCollateral(t *ReservedTask, bs *BlockState) error {
+	return bs.AddBalance(t.from, t.payload.(*RtUnbondCollateral).Amount)
+}
+
+func tallyProposal(t *ReservedTask, bs *BlockState) error {
+	return bs.TallyProposal(t.payload.(*RtTallyProposal).ProposalHash)
+}
+
+func applyProposal(t *ReservedTask, bs *BlockState) error {
+	return bs.ApplyProposal(t.payload.(*RtApplyProposal).ProposalHash)
+}
@@ -16,6 +16,7 @@
 package core
 
 import (
+	"github.com/medibloc/go-medibloc/common"
 	"github.com/medibloc/go-medibloc/util"
 )
 
@@ -38,3 +39,100 @@ func (w *RtWithdraw) Serialize() ([]byte, error) {
 func (w *RtWithdraw) Deserialize(b []byte) error {
 	return w.Amount.FromFixedSizeByteSlice(b)
 }
+
+// RtTransfer represents payload of a scheduled transfer
+type RtTransfer struct {
+	To     common.Address
+	Amount *util.Uint128
+}
+
+// NewRtTransfer generates a RtTransfer
+func NewRtTransfer(to common.Address, amount *util.Uint128) (*RtTransfer, error) {
+	return &RtTransfer{To: to, Amount: amount}, nil
+}
+
+// Serialize a RtTransfer to a byte array
+func (t *RtTransfer) Serialize() ([]byte, error) {
+	amountBytes, err := t.Amount.ToFixedSizeByteSlice()
+	if err != nil {
+		return nil, err
+	}
+	return append(t.To.Bytes(), amountBytes...), nil
+}
+
+// Deserialize a byte array and get a RtTransfer
+func (t *RtTransfer) Deserialize(b []byte) error {
+	if len(b) < common.AddressLength {
+		return ErrInvalidReservedTaskPayload
+	}
+	t.To = common.BytesToAddress(b[:common.AddressLength])
+	return t.Amount.FromFixedSizeByteSlice(b[common.AddressLength:])
+}
+
+// RtUnbondCollateral represents payload of for releasing collateral of a candidate
+type RtUnbondCollateral struct {
+	Amount *util.Uint128
+}
+
+// NewRtUnbondCollateral generates a RtUnbondCollateral
+func NewRtUnbondCollateral(amount *util.Uint128) (*RtUnbondCollateral, error) {
+	return &RtUnbondCollateral{Amount: amount}, nil
+}
+
+// Serialize a RtUnbondCollateral to a byte array
+func (u *RtUnbondCollateral) Serialize() ([]byte, error) {
+	return u.Amount.ToFixedSizeByteSlice()
+}
+
+// Deserialize a byte array and get a RtUnbondCollateral
+func (u *RtUnbondCollateral) Deserialize(b []byte) error {
+	return u.Amount.FromFixedSizeByteSlice(b)
+}
+
+// RtTallyProposal represents payload of for tallying votes on a governance proposal
+type RtTallyProposal struct {
+	ProposalHash []byte
+}
+
+// NewRtTallyProposal generates a RtTallyProposal
+func NewRtTallyProposal(hash []byte) (*RtTallyProposal, error) {
+	return &RtTallyProposal{ProposalHash: hash}, nil
+}
+
+// Serialize a RtTallyProposal to a byte array
+func (p *RtTallyProposal) Serialize() ([]byte, error) {
+	return p.ProposalHash, nil
+}
+
+// Deserialize a byte array and get a RtTallyProposal
+func (p *RtTallyProposal) Deserialize(b []byte) error {
+	if len(b) == 0 {
+		return ErrInvalidReservedTaskPayload
+	}
+	p.ProposalHash = b
+	return nil
+}
+
+// RtApplyProposal represents payload of for applying changes of a passed governance proposal
+type RtApplyProposal struct {
+	ProposalHash []byte
+}
+
+// NewRtApplyProposal generates a RtApplyProposal
+func NewRtApplyProposal(hash []byte) (*RtApplyProposal, error) {
+	return &RtApplyProposal{ProposalHash: hash}, nil
+}
+
+// Serialize a RtApplyProposal to a byte array
+func (p *RtApplyProposal) Serialize() ([]byte, error) {
+	return p.ProposalHash, nil
+}
+
+// Deserialize a byte array and get a RtApplyProposal
+func (p *RtApplyProposal) Deserialize(b []byte) error {
+	if len(b) == 0 {
+		return ErrInvalidReservedTaskPayload
+	}
+	p.ProposalHash = b
+	return nil
+}
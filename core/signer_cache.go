@@ -0,0 +1,164 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package core
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/hashicorp/golang-lru"
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+)
+
+const defaultSignerCacheSize = 32768
+
+// signerCache keeps addresses recovered from transaction signatures, keyed by transaction hash.
+var signerCache, _ = lru.New(defaultSignerCacheSize)
+
+// recoveredSigners holds addresses recovered from the signatures of a transaction.
+type recoveredSigners struct {
+	sign      []byte
+	multiSign [][]byte
+	payerSign []byte
+
+	signers []common.Address
+	payer   *common.Address
+}
+
+func (r *recoveredSigners) matches(tx *Transaction) bool {
+	if !byteutils.Equal(r.sign, tx.sign) || len(r.multiSign) != len(tx.multiSign) {
+		return false
+	}
+	for i := range r.multiSign {
+		if !byteutils.Equal(r.multiSign[i], tx.multiSign[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cachedSigners(tx *Transaction) *recoveredSigners {
+	v, ok := signerCache.Get(string(tx.hash))
+	if !ok {
+		return nil
+	}
+	r := v.(*recoveredSigners)
+	if !r.matches(tx) {
+		return nil
+	}
+	return r
+}
+
+// recoverSigners returns the signer of the transaction, or the owners who signed it for a multisig account.
+func (tx *Transaction) recoverSigners() ([]common.Address, error) {
+	cached := cachedSigners(tx)
+	if cached != nil && cached.signers != nil {
+		return cached.signers, nil
+	}
+
+	var signers []common.Address
+	if len(tx.multiSign) > 0 {
+		multiSigners, err := tx.calcMultiSigners()
+		if err != nil {
+			return nil, err
+		}
+		signers = multiSigners
+	} else {
+		signer, err := tx.calcSigner()
+		if err != nil {
+			return nil, err
+		}
+		signers = []common.Address{signer}
+	}
+
+	r := &recoveredSigners{
+		sign:      tx.sign,
+		multiSign: tx.multiSign,
+		signers:   signers,
+	}
+	if cached != nil && byteutils.Equal(cached.payerSign, tx.payerSign) {
+		r.payerSign, r.payer = cached.payerSign, cached.payer
+	}
+	signerCache.Add(string(tx.hash), r)
+	return signers, nil
+}
+
+// recoverPayer returns the address of the payer who signed the transaction.
+func (tx *Transaction) recoverPayer() (common.Address, error) {
+	cached := cachedSigners(tx)
+	if cached != nil && cached.payer != nil && byteutils.Equal(cached.payerSign, tx.payerSign) {
+		return *cached.payer, nil
+	}
+
+	payer, err := tx.calcPayer()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	r := &recoveredSigners{
+		sign:      tx.sign,
+		multiSign: tx.multiSign,
+		payerSign: tx.payerSign,
+		payer:     &payer,
+	}
+	if cached != nil {
+		r.signers = cached.signers
+	}
+	signerCache.Add(string(tx.hash), r)
+	return payer, nil
+}
+
+// PreVerifyTransactions recovers signers and payers of transactions concurrently and caches them,
+// so that following verification and execution of the transactions do not recover them again.
+// Errors are not reported here but by the verification of each transaction.
+func PreVerifyTransactions(txs Transactions) {
+	workers := runtime.NumCPU()
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	if workers < 2 {
+		for _, tx := range txs {
+			tx.preVerify()
+		}
+		return
+	}
+
+	ch := make(chan *Transaction, len(txs))
+	for _, tx := range txs {
+		ch <- tx
+	}
+	close(ch)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for tx := range ch {
+				tx.preVerify()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (tx *Transaction) preVerify() {
+	tx.recoverSigners()
+	if len(tx.payerSign) > 0 {
+		tx.recoverPayer()
+	}
+}
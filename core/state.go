@@ -35,14 +35,20 @@ type account struct {
 	vesting *util.Uint128
 	// nonce account sequential number
 	nonce uint64
-	// voted account
-	voted []byte
+	// voted candidates
+	voted [][]byte
 	// records
 	records [][]byte
 	// certs received by a certifier
 	certsReceived [][]byte
 	// certs issued as a certifier
 	certsIssued [][]byte
+	// proxy account which votes on behalf of the account
+	proxy []byte
+	// sum of vesting delegated to the account
+	proxiedVesting *util.Uint128
+	// number of accounts which delegated voting power to the account
+	delegators uint32
 }
 
 func (acc *account) Address() []byte {
@@ -61,7 +67,7 @@ func (acc *account) Nonce() uint64 {
 	return acc.nonce
 }
 
-func (acc *account) Voted() []byte {
+func (acc *account) Voted() [][]byte {
 	return acc.voted
 }
 
@@ -77,6 +83,26 @@ func (acc *account) CertsIssued() [][]byte {
 	return acc.certsIssued
 }
 
+func (acc *account) Proxy() []byte {
+	return acc.proxy
+}
+
+func (acc *account) ProxiedVesting() *util.Uint128 {
+	return acc.proxiedVesting
+}
+
+func (acc *account) Delegators() uint32 {
+	return acc.delegators
+}
+
+// VotingPower returns vesting and proxied vesting if the account has not delegated its voting power
+func (acc *account) VotingPower() (*util.Uint128, error) {
+	if len(acc.proxy) > 0 {
+		return util.Uint128Zero(), nil
+	}
+	return acc.vesting.Add(acc.proxiedVesting)
+}
+
 func (acc *account) toBytes() ([]byte, error) {
 	balanceBytes, err := acc.balance.ToFixedSizeByteSlice()
 	if err != nil {
@@ -86,15 +112,22 @@ func (acc *account) toBytes() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	proxiedVestingBytes, err := acc.proxiedVesting.ToFixedSizeByteSlice()
+	if err != nil {
+		return nil, err
+	}
 	pbAcc := &corepb.Account{
-		Address:       acc.address,
-		Balance:       balanceBytes,
-		Vesting:       vestingBytes,
-		Voted:         acc.voted,
-		Nonce:         acc.nonce,
-		Records:       acc.records,
-		CertsReceived: acc.certsReceived,
-		CertsIssued:   acc.certsIssued,
+		Address:        acc.address,
+		Balance:        balanceBytes,
+		Vesting:        vestingBytes,
+		Voted:          acc.voted,
+		Nonce:          acc.nonce,
+		Records:        acc.records,
+		CertsReceived:  acc.certsReceived,
+		CertsIssued:    acc.certsIssued,
+		Proxy:          acc.proxy,
+		ProxiedVesting: proxiedVestingBytes,
+		Delegators:     acc.delegators,
 	}
 	bytes, err := proto.Marshal(pbAcc)
 	if err != nil {
@@ -112,15 +145,22 @@ func loadAccount(bytes []byte) (*account, error) {
 	balance.FromFixedSizeByteSlice(pbAcc.Balance)
 	vesting := util.NewUint128()
 	vesting.FromFixedSizeByteSlice(pbAcc.Vesting)
+	proxiedVesting := util.NewUint128()
+	if len(pbAcc.ProxiedVesting) > 0 {
+		proxiedVesting.FromFixedSizeByteSlice(pbAcc.ProxiedVesting)
+	}
 	acc := &account{
-		address:       pbAcc.Address,
-		balance:       balance,
-		vesting:       vesting,
-		voted:         pbAcc.Voted,
-		nonce:         pbAcc.Nonce,
-		records:       pbAcc.Records,
-		certsReceived: pbAcc.CertsReceived,
-		certsIssued:   pbAcc.CertsIssued,
+		address:        pbAcc.Address,
+		balance:        balance,
+		vesting:        vesting,
+		voted:          pbAcc.Voted,
+		nonce:          pbAcc.Nonce,
+		records:        pbAcc.Records,
+		certsReceived:  pbAcc.CertsReceived,
+		certsIssued:    pbAcc.CertsIssued,
+		proxy:          pbAcc.Proxy,
+		proxiedVesting: proxiedVesting,
+		delegators:     pbAcc.Delegators,
 	}
 	return acc, nil
 }
@@ -196,13 +236,21 @@ type Account interface {
 
 	Nonce() uint64
 
-	Voted() []byte
+	Voted() [][]byte
 
 	Records() [][]byte
 
 	CertsReceived() [][]byte
 
 	CertsIssued() [][]byte
+
+	Proxy() []byte
+
+	ProxiedVesting() *util.Uint128
+
+	Delegators() uint32
+
+	VotingPower() (*util.Uint128, error)
 }
 
 // AccountState account state interface
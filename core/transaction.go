@@ -17,7 +17,6 @@ package core
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/medibloc/go-medibloc/common"
@@ -43,6 +42,7 @@ type Transaction struct {
 	alg       algorithm.Algorithm
 	sign      []byte
 	payerSign []byte
+	multiSign [][]byte
 }
 
 // Transactions is just multiple txs
@@ -67,6 +67,7 @@ func (tx *Transaction) ToProto() (proto.Message, error) {
 		Alg:       uint32(tx.alg),
 		Sign:      tx.sign,
 		PayerSign: tx.payerSign,
+		MultiSign: tx.multiSign,
 	}, nil
 }
 
@@ -94,6 +95,7 @@ func (tx *Transaction) FromProto(msg proto.Message) error {
 		tx.alg = alg
 		tx.sign = msg.Sign
 		tx.payerSign = msg.PayerSign
+		tx.multiSign = msg.MultiSign
 
 		return nil
 	}
@@ -112,7 +114,8 @@ func BuildTransaction(
 	hash []byte,
 	alg uint32,
 	sign []byte,
-	payerSign []byte) (*Transaction, error) {
+	payerSign []byte,
+	multiSign [][]byte) (*Transaction, error) {
 	return &Transaction{
 		from:      from,
 		to:        to,
@@ -125,6 +128,7 @@ func BuildTransaction(
 		alg:       algorithm.Algorithm(alg),
 		sign:      sign,
 		payerSign: payerSign,
+		multiSign: multiSign,
 	}, nil
 }
 
@@ -155,7 +159,7 @@ func NewTransactionWithSign(
 		from:      from,
 		to:        to,
 		value:     value,
-		timestamp: time.Now().Unix(),
+		timestamp: Clock().Now().Unix(),
 		data:      &corepb.Data{Type: payloadType, Payload: payload},
 		nonce:     nonce,
 		chainID:   chainID,
@@ -208,12 +212,15 @@ func (tx *Transaction) getPayerSignTarget() []byte {
 
 	hasher.Write(tx.hash)
 	hasher.Write(tx.sign)
+	for _, sign := range tx.multiSign {
+		hasher.Write(sign)
+	}
 
 	hash := hasher.Sum(nil)
 	return hash
 }
 
-func (tx *Transaction) recoverPayer() (common.Address, error) {
+func (tx *Transaction) calcPayer() (common.Address, error) {
 	if tx.payerSign == nil || len(tx.payerSign) == 0 {
 		return common.Address{}, ErrPayerSignatureNotExist
 	}
@@ -244,6 +251,25 @@ func (tx *Transaction) SignByPayer(signer signature.Signature) error {
 	return nil
 }
 
+// SignByMultisigOwner appends an owner's partial signature to a tx sent from a multisig account
+func (tx *Transaction) SignByMultisigOwner(signer signature.Signature) error {
+	if tx.hash == nil || len(tx.hash) == 0 {
+		tx.alg = signer.Algorithm()
+		hash, err := tx.calcHash()
+		if err != nil {
+			return err
+		}
+		tx.hash = hash
+	}
+
+	sig, err := signer.Sign(tx.hash)
+	if err != nil {
+		return err
+	}
+	tx.multiSign = append(tx.multiSign, sig)
+	return nil
+}
+
 // VerifyIntegrity returns transaction verify result, including Hash and Signature.
 func (tx *Transaction) VerifyIntegrity(chainID uint32) error {
 	// check ChainID.
@@ -251,7 +277,20 @@ func (tx *Transaction) VerifyIntegrity(chainID uint32) error {
 		return ErrInvalidChainID
 	}
 
-	// check Hash.
+	if err := tx.VerifyHash(); err != nil {
+		return err
+	}
+
+	// check Signature.
+	if len(tx.multiSign) > 0 {
+		_, err := tx.recoverSigners()
+		return err
+	}
+	return tx.verifySign()
+}
+
+// VerifyHash checks whether the hash of the transaction is calculated from its fields.
+func (tx *Transaction) VerifyHash() error {
 	wantedHash, err := tx.calcHash()
 	if err != nil {
 		return err
@@ -259,23 +298,21 @@ func (tx *Transaction) VerifyIntegrity(chainID uint32) error {
 	if !byteutils.Equal(wantedHash, tx.hash) {
 		return ErrInvalidTransactionHash
 	}
-
-	// check Signature.
-	return tx.verifySign()
+	return nil
 }
 
 func (tx *Transaction) verifySign() error {
-	signer, err := tx.recoverSigner()
+	signers, err := tx.recoverSigners()
 	if err != nil {
 		return err
 	}
-	if !tx.from.Equals(signer) {
+	if !tx.from.Equals(signers[0]) {
 		return ErrInvalidTransactionSigner
 	}
 	return nil
 }
 
-func (tx *Transaction) recoverSigner() (common.Address, error) {
+func (tx *Transaction) calcSigner() (common.Address, error) {
 	signature, err := crypto.NewSignature(tx.alg)
 	if err != nil {
 		return common.Address{}, err
@@ -289,6 +326,39 @@ func (tx *Transaction) recoverSigner() (common.Address, error) {
 	return common.PublicKeyToAddress(pubKey)
 }
 
+func (tx *Transaction) recoverMultiSigners() ([]common.Address, error) {
+	if len(tx.multiSign) == 0 {
+		return nil, nil
+	}
+	return tx.recoverSigners()
+}
+
+func (tx *Transaction) calcMultiSigners() ([]common.Address, error) {
+	signature, err := crypto.NewSignature(tx.alg)
+	if err != nil {
+		return nil, err
+	}
+
+	var signers []common.Address
+	for _, sign := range tx.multiSign {
+		pubKey, err := signature.RecoverPublic(tx.hash, sign)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := common.PublicKeyToAddress(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range signers {
+			if s.Equals(signer) {
+				return nil, ErrDuplicatedMultisigSigner
+			}
+		}
+		signers = append(signers, signer)
+	}
+	return signers, nil
+}
+
 // From returns from
 func (tx *Transaction) From() common.Address {
 	return tx.from
@@ -344,6 +414,11 @@ func (tx *Transaction) PayerSignature() []byte {
 	return tx.payerSign
 }
 
+// MultiSignature returns partial signatures of multisig owners
+func (tx *Transaction) MultiSignature() [][]byte {
+	return tx.multiSign
+}
+
 // String returns string representation of tx
 func (tx *Transaction) String() string {
 	return fmt.Sprintf(`{chainID:%v, hash:%v, from:%v, to:%v, value:%v, type:%v, alg:%v, nonce:%v'}`,
@@ -358,11 +433,34 @@ func (tx *Transaction) String() string {
 	)
 }
 
+// txOperationForks maps transaction operations to forks activating them.
+// An operation is executable only after its fork is active.
+var txOperationForks = map[string]string{
+	TxOperationRegisterIssuer:     ForkIssuerRegistry,
+	TxOperationDeregisterIssuer:   ForkIssuerRegistry,
+	TxOperationCreateMultisig:     ForkMultisig,
+	TxOperationScheduleTransfer:   ForkScheduledTransfer,
+	TxOperationCancelReservedTask: ForkScheduledTransfer,
+	TxOperationSubmitProposal:     ForkGovernance,
+	TxOperationVoteProposal:       ForkGovernance,
+	TxOperationUpdateCandidate:    ForkCandidateMeta,
+	TxOperationReportDoubleSign:   ForkDoubleSignSlashing,
+	TxOperationUnvote:             ForkMultiVote,
+	TxOperationDelegate:           ForkDelegation,
+	TxOperationUndelegate:         ForkDelegation,
+}
+
 // ExecuteOnState executes tx on block state and change the state if valid
 func (tx *Transaction) ExecuteOnState(bs *BlockState) error {
 	if err := bs.checkNonce(tx); err != nil {
 		return err
 	}
+	if err := bs.checkSigners(tx); err != nil {
+		return err
+	}
+	if fork, ok := txOperationForks[tx.Type()]; ok && !bs.IsForkActive(fork) {
+		return ErrTxTypeNotActivated
+	}
 
 	switch tx.Type() {
 	case TxOperationAddRecord:
@@ -373,14 +471,38 @@ func (tx *Transaction) ExecuteOnState(bs *BlockState) error {
 		return tx.withdrawVesting(bs)
 	case TxOperationBecomeCandidate:
 		return tx.becomeCandidate(bs)
+	case TxOperationUpdateCandidate:
+		return tx.updateCandidate(bs)
 	case TxOperationQuitCandidacy:
 		return tx.quitCandidacy(bs)
+	case TxOperationReportDoubleSign:
+		return tx.reportDoubleSign(bs)
 	case TxOperationVote:
 		return tx.vote(bs)
+	case TxOperationUnvote:
+		return tx.unvote(bs)
+	case TxOperationDelegate:
+		return tx.delegate(bs)
+	case TxOperationUndelegate:
+		return tx.undelegate(bs)
 	case TxOperationAddCertification:
 		return tx.addCertification(bs)
 	case TxOperationRevokeCertification:
 		return tx.revokeCertification(bs)
+	case TxOperationRegisterIssuer:
+		return tx.registerIssuer(bs)
+	case TxOperationDeregisterIssuer:
+		return tx.deregisterIssuer(bs)
+	case TxOperationCreateMultisig:
+		return tx.createMultisig(bs)
+	case TxOperationScheduleTransfer:
+		return tx.scheduleTransfer(bs)
+	case TxOperationCancelReservedTask:
+		return tx.cancelReservedTask(bs)
+	case TxOperationSubmitProposal:
+		return tx.submitProposal(bs)
+	case TxOperationVoteProposal:
+		return tx.voteProposal(bs)
 	default:
 		return tx.transfer(bs)
 	}
@@ -415,15 +537,72 @@ func (tx *Transaction) withdrawVesting(bs *BlockState) error {
 }
 
 func (tx *Transaction) becomeCandidate(bs *BlockState) error {
-	return bs.AddCandidate(tx.from, tx.value)
+	if tx.value.Cmp(bs.ChainParams().MinCollateral()) < 0 {
+		return ErrCollateralNotEnough
+	}
+	payload := new(BecomeCandidatePayload)
+	if len(tx.Data()) > 0 {
+		var err error
+		payload, err = BytesToBecomeCandidatePayload(tx.Data())
+		if err != nil {
+			return err
+		}
+	}
+	if err := bs.AddCandidate(tx.from, tx.value); err != nil {
+		return err
+	}
+	return bs.SetCandidateMeta(tx.from, payload.Name, payload.URL, payload.PeerID)
+}
+
+func (tx *Transaction) updateCandidate(bs *BlockState) error {
+	payload, err := BytesToUpdateCandidatePayload(tx.Data())
+	if err != nil {
+		return err
+	}
+	return bs.SetCandidateMeta(tx.from, payload.Name, payload.URL, payload.PeerID)
 }
 
 func (tx *Transaction) quitCandidacy(bs *BlockState) error {
-	return bs.QuitCandidacy(tx.from)
+	return bs.QuitCandidacy(tx.from, bs.Timestamp())
+}
+
+func (tx *Transaction) reportDoubleSign(bs *BlockState) error {
+	payload, err := BytesToReportDoubleSignPayload(tx.Data())
+	if err != nil {
+		return err
+	}
+	evidence, err := BytesToDoubleSignEvidence(payload.Evidence)
+	if err != nil {
+		return err
+	}
+	return bs.SlashDoubleSign(evidence, tx.chainID, bs.Timestamp())
 }
 
 func (tx *Transaction) vote(bs *BlockState) error {
-	return bs.Vote(tx.from, tx.to)
+	if len(tx.Data()) == 0 {
+		return bs.Vote(tx.from, []common.Address{tx.to})
+	}
+	payload, err := BytesToVotePayload(tx.Data())
+	if err != nil {
+		return err
+	}
+	candidates := make([]common.Address, len(payload.Candidates))
+	for i, candidate := range payload.Candidates {
+		candidates[i] = common.BytesToAddress(candidate)
+	}
+	return bs.Vote(tx.from, candidates)
+}
+
+func (tx *Transaction) unvote(bs *BlockState) error {
+	return bs.Unvote(tx.from)
+}
+
+func (tx *Transaction) delegate(bs *BlockState) error {
+	return bs.Delegate(tx.from, tx.to)
+}
+
+func (tx *Transaction) undelegate(bs *BlockState) error {
+	return bs.Undelegate(tx.from)
 }
 
 func (tx *Transaction) addCertification(bs *BlockState) error {
@@ -432,7 +611,7 @@ func (tx *Transaction) addCertification(bs *BlockState) error {
 		return err
 	}
 	return bs.AddCertification(payload.CertificateHash, tx.from, tx.to,
-		payload.IssueTime, payload.ExpirationTime)
+		payload.IssueTime, payload.ExpirationTime, payload.CertificationType)
 }
 
 func (tx *Transaction) revokeCertification(bs *BlockState) error {
@@ -442,3 +621,69 @@ func (tx *Transaction) revokeCertification(bs *BlockState) error {
 	}
 	return bs.RevokeCertification(payload.CertificateHash, tx.from, tx.Timestamp())
 }
+
+func (tx *Transaction) registerIssuer(bs *BlockState) error {
+	payload, err := BytesToRegisterIssuerPayload(tx.Data())
+	if err != nil {
+		return err
+	}
+	return bs.RegisterIssuer(tx.from, tx.to, payload.Name, payload.CertTypes, bs.Timestamp())
+}
+
+func (tx *Transaction) deregisterIssuer(bs *BlockState) error {
+	return bs.DeregisterIssuer(tx.from, tx.to)
+}
+
+func (tx *Transaction) createMultisig(bs *BlockState) error {
+	payload, err := BytesToCreateMultisigPayload(tx.Data())
+	if err != nil {
+		return err
+	}
+	var owners []*corepb.MultisigOwner
+	for _, owner := range payload.Owners {
+		owners = append(owners, &corepb.MultisigOwner{
+			Address: owner.Address,
+			Weight:  owner.Weight,
+		})
+	}
+	return bs.CreateMultisig(NewMultisigAddress(tx.from, tx.nonce), payload.Threshold, owners)
+}
+
+func (tx *Transaction) scheduleTransfer(bs *BlockState) error {
+	payload, err := BytesToScheduleTransferPayload(tx.Data())
+	if err != nil {
+		return err
+	}
+	return bs.ScheduleTransfer(tx.from, tx.to, tx.value, bs.Timestamp(), payload.ExecutionTime)
+}
+
+func (tx *Transaction) cancelReservedTask(bs *BlockState) error {
+	payload, err := BytesToCancelReservedTaskPayload(tx.Data())
+	if err != nil {
+		return err
+	}
+	return bs.CancelReservedTask(tx.from, payload.TaskHash)
+}
+
+func (tx *Transaction) submitProposal(bs *BlockState) error {
+	payload, err := BytesToSubmitProposalPayload(tx.Data())
+	if err != nil {
+		return err
+	}
+	var changes []*corepb.ParamChange
+	for _, change := range payload.Changes {
+		changes = append(changes, &corepb.ParamChange{
+			Name:  change.Name,
+			Value: change.Value,
+		})
+	}
+	return bs.SubmitProposal(tx.hash, tx.from, changes, bs.Timestamp())
+}
+
+func (tx *Transaction) voteProposal(bs *BlockState) error {
+	payload, err := BytesToVoteProposalPayload(tx.Data())
+	if err != nil {
+		return err
+	}
+	return bs.VoteProposal(payload.ProposalHash, tx.from, payload.Approve, bs.Timestamp())
+}
@@ -47,9 +47,10 @@ func (payload *AddRecordPayload) ToBytes() ([]byte, error) {
 
 // AddCertificationPayload is payload type for TxOperationAddCertification
 type AddCertificationPayload struct {
-	IssueTime       int64
-	ExpirationTime  int64
-	CertificateHash []byte
+	IssueTime         int64
+	ExpirationTime    int64
+	CertificateHash   []byte
+	CertificationType string
 }
 
 // NewAddCertificationPayload generates a AddCertificationPayload
@@ -100,3 +101,289 @@ func BytesToRevokeCertificationPayload(b []byte) (*RevokeCertificationPayload, e
 func (payload *RevokeCertificationPayload) ToBytes() ([]byte, error) {
 	return json.Marshal(payload)
 }
+
+// RegisterIssuerPayload is payload type for TxOperationRegisterIssuer
+type RegisterIssuerPayload struct {
+	Name      string
+	CertTypes []string
+}
+
+// NewRegisterIssuerPayload generates a RegisterIssuerPayload
+func NewRegisterIssuerPayload(name string, certTypes []string) *RegisterIssuerPayload {
+	return &RegisterIssuerPayload{
+		Name:      name,
+		CertTypes: certTypes,
+	}
+}
+
+// BytesToRegisterIssuerPayload converts bytes to RegisterIssuerPayload struct
+func BytesToRegisterIssuerPayload(b []byte) (*RegisterIssuerPayload, error) {
+	payload := new(RegisterIssuerPayload)
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, ErrInvalidTxPayload
+	}
+	return payload, nil
+}
+
+// ToBytes returns marshalled RegisterIssuerPayload
+func (payload *RegisterIssuerPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// MultisigOwner is an owner of multisig account with its weight
+type MultisigOwner struct {
+	Address []byte
+	Weight  uint32
+}
+
+// CreateMultisigPayload is payload type for TxOperationCreateMultisig
+type CreateMultisigPayload struct {
+	Threshold uint32
+	Owners    []*MultisigOwner
+}
+
+// NewCreateMultisigPayload generates a CreateMultisigPayload
+func NewCreateMultisigPayload(threshold uint32, owners []*MultisigOwner) *CreateMultisigPayload {
+	return &CreateMultisigPayload{
+		Threshold: threshold,
+		Owners:    owners,
+	}
+}
+
+// BytesToCreateMultisigPayload converts bytes to CreateMultisigPayload struct
+func BytesToCreateMultisigPayload(b []byte) (*CreateMultisigPayload, error) {
+	payload := new(CreateMultisigPayload)
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, ErrInvalidTxPayload
+	}
+	return payload, nil
+}
+
+// ToBytes returns marshalled CreateMultisigPayload
+func (payload *CreateMultisigPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// ScheduleTransferPayload is payload type for TxOperationScheduleTransfer
+type ScheduleTransferPayload struct {
+	ExecutionTime int64
+}
+
+// NewScheduleTransferPayload generates a ScheduleTransferPayload
+func NewScheduleTransferPayload(executionTime int64) *ScheduleTransferPayload {
+	return &ScheduleTransferPayload{
+		ExecutionTime: executionTime,
+	}
+}
+
+// BytesToScheduleTransferPayload converts bytes to ScheduleTransferPayload struct
+func BytesToScheduleTransferPayload(b []byte) (*ScheduleTransferPayload, error) {
+	payload := new(ScheduleTransferPayload)
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, ErrInvalidTxPayload
+	}
+	return payload, nil
+}
+
+// ToBytes returns marshalled ScheduleTransferPayload
+func (payload *ScheduleTransferPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// CancelReservedTaskPayload is payload type for TxOperationCancelReservedTask
+type CancelReservedTaskPayload struct {
+	TaskHash []byte
+}
+
+// NewCancelReservedTaskPayload generates a CancelReservedTaskPayload
+func NewCancelReservedTaskPayload(taskHash []byte) *CancelReservedTaskPayload {
+	return &CancelReservedTaskPayload{
+		TaskHash: taskHash,
+	}
+}
+
+// BytesToCancelReservedTaskPayload converts bytes to CancelReservedTaskPayload struct
+func BytesToCancelReservedTaskPayload(b []byte) (*CancelReservedTaskPayload, error) {
+	payload := new(CancelReservedTaskPayload)
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, ErrInvalidTxPayload
+	}
+	return payload, nil
+}
+
+// ToBytes returns marshalled CancelReservedTaskPayload
+func (payload *CancelReservedTaskPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// ParamChange represents a change of chain parameter in a governance proposal
+type ParamChange struct {
+	Name  string
+	Value string
+}
+
+// SubmitProposalPayload is payload type for TxOperationSubmitProposal
+type SubmitProposalPayload struct {
+	Changes []*ParamChange
+}
+
+// NewSubmitProposalPayload generates a SubmitProposalPayload
+func NewSubmitProposalPayload(changes []*ParamChange) *SubmitProposalPayload {
+	return &SubmitProposalPayload{
+		Changes: changes,
+	}
+}
+
+// BytesToSubmitProposalPayload converts bytes to SubmitProposalPayload struct
+func BytesToSubmitProposalPayload(b []byte) (*SubmitProposalPayload, error) {
+	payload := new(SubmitProposalPayload)
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, ErrInvalidTxPayload
+	}
+	return payload, nil
+}
+
+// ToBytes returns marshalled SubmitProposalPayload
+func (payload *SubmitProposalPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// VoteProposalPayload is payload type for TxOperationVoteProposal
+type VoteProposalPayload struct {
+	ProposalHash []byte
+	Approve      bool
+}
+
+// NewVoteProposalPayload generates a VoteProposalPayload
+func NewVoteProposalPayload(proposalHash []byte, approve bool) *VoteProposalPayload {
+	return &VoteProposalPayload{
+		ProposalHash: proposalHash,
+		Approve:      approve,
+	}
+}
+
+// BytesToVoteProposalPayload converts bytes to VoteProposalPayload struct
+func BytesToVoteProposalPayload(b []byte) (*VoteProposalPayload, error) {
+	payload := new(VoteProposalPayload)
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, ErrInvalidTxPayload
+	}
+	return payload, nil
+}
+
+// ToBytes returns marshalled VoteProposalPayload
+func (payload *VoteProposalPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BecomeCandidatePayload is payload type for TxOperationBecomeCandidate
+type BecomeCandidatePayload struct {
+	Name   string
+	URL    string
+	PeerID string
+}
+
+// NewBecomeCandidatePayload generates a BecomeCandidatePayload
+func NewBecomeCandidatePayload(name, url, peerID string) *BecomeCandidatePayload {
+	return &BecomeCandidatePayload{
+		Name:   name,
+		URL:    url,
+		PeerID: peerID,
+	}
+}
+
+// BytesToBecomeCandidatePayload converts bytes to BecomeCandidatePayload struct
+func BytesToBecomeCandidatePayload(b []byte) (*BecomeCandidatePayload, error) {
+	payload := new(BecomeCandidatePayload)
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, ErrInvalidTxPayload
+	}
+	return payload, nil
+}
+
+// ToBytes returns marshalled BecomeCandidatePayload
+func (payload *BecomeCandidatePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// UpdateCandidatePayload is payload type for TxOperationUpdateCandidate
+type UpdateCandidatePayload struct {
+	Name   string
+	URL    string
+	PeerID string
+}
+
+// NewUpdateCandidatePayload generates an UpdateCandidatePayload
+func NewUpdateCandidatePayload(name, url, peerID string) *UpdateCandidatePayload {
+	return &UpdateCandidatePayload{
+		Name:   name,
+		URL:    url,
+		PeerID: peerID,
+	}
+}
+
+// BytesToUpdateCandidatePayload converts bytes to UpdateCandidatePayload struct
+func BytesToUpdateCandidatePayload(b []byte) (*UpdateCandidatePayload, error) {
+	payload := new(UpdateCandidatePayload)
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, ErrInvalidTxPayload
+	}
+	return payload, nil
+}
+
+// ToBytes returns marshalled UpdateCandidatePayload
+func (payload *UpdateCandidatePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// ReportDoubleSignPayload is payload type for TxOperationReportDoubleSign
+type ReportDoubleSignPayload struct {
+	Evidence []byte
+}
+
+// NewReportDoubleSignPayload generates a ReportDoubleSignPayload
+func NewReportDoubleSignPayload(evidence []byte) *ReportDoubleSignPayload {
+	return &ReportDoubleSignPayload{
+		Evidence: evidence,
+	}
+}
+
+// BytesToReportDoubleSignPayload converts bytes to ReportDoubleSignPayload struct
+func BytesToReportDoubleSignPayload(b []byte) (*ReportDoubleSignPayload, error) {
+	payload := new(ReportDoubleSignPayload)
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, ErrInvalidTxPayload
+	}
+	return payload, nil
+}
+
+// ToBytes returns marshalled ReportDoubleSignPayload
+func (payload *ReportDoubleSignPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// VotePayload is payload type for TxOperationVote
+type VotePayload struct {
+	Candidates [][]byte
+}
+
+// NewVotePayload generates a VotePayload
+func NewVotePayload(candidates [][]byte) *VotePayload {
+	return &VotePayload{
+		Candidates: candidates,
+	}
+}
+
+// BytesToVotePayload converts bytes to VotePayload struct
+func BytesToVotePayload(b []byte) (*VotePayload, error) {
+	payload := new(VotePayload)
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, ErrInvalidTxPayload
+	}
+	return payload, nil
+}
+
+// ToBytes returns marshalled VotePayload
+func (payload *VotePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
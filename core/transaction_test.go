@@ -22,15 +22,19 @@ import (
 	"github.com/medibloc/go-medibloc/common"
 	"github.com/medibloc/go-medibloc/consensus/dpos"
 	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
 	"github.com/medibloc/go-medibloc/crypto"
 	"github.com/medibloc/go-medibloc/crypto/signature"
 	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
 	"github.com/medibloc/go-medibloc/crypto/signature/secp256k1"
 	"github.com/medibloc/go-medibloc/keystore"
+	"github.com/medibloc/go-medibloc/storage"
 	"github.com/medibloc/go-medibloc/util"
 	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/util/clock"
 	"github.com/medibloc/go-medibloc/util/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTransaction_VerifyIntegrity(t *testing.T) {
@@ -209,7 +213,7 @@ func TestWithdrawVesting(t *testing.T) {
 	for i := 0; i < len(tasks); i++ {
 		assert.Equal(t, core.RtWithdrawType, tasks[i].TaskType())
 		assert.Equal(t, from.Addr, tasks[i].From())
-		assert.Equal(t, withdrawTx.Timestamp()+int64(i+1)*core.RtWithdrawInterval, tasks[i].Timestamp())
+		assert.Equal(t, withdrawTx.Timestamp()+int64(i+1)*genesis.State().ChainParams().WithdrawInterval(), tasks[i].Timestamp())
 	}
 }
 
@@ -218,7 +222,7 @@ func TestBecomeCandidate(t *testing.T) {
 
 	tx, err := core.NewTransaction(
 		testutil.ChainID,
-		distributed[dpos.DynastySize].Addr,
+		distributed[dpos.DefaultDynastySize].Addr,
 		common.Address{},
 		util.NewUint128FromUint(10), 1,
 		core.TxOperationBecomeCandidate, []byte{},
@@ -226,7 +230,7 @@ func TestBecomeCandidate(t *testing.T) {
 	assert.NoError(t, err)
 	sig, err := crypto.NewSignature(algorithm.SECP256K1)
 	assert.NoError(t, err)
-	sig.InitSign(distributed[dpos.DynastySize].PrivKey)
+	sig.InitSign(distributed[dpos.DefaultDynastySize].PrivKey)
 	assert.NoError(t, tx.SignThis(sig))
 
 	genesisState, err := genesisBlock.State().Clone()
@@ -237,29 +241,95 @@ func TestBecomeCandidate(t *testing.T) {
 	assert.NoError(t, genesisState.AcceptTransaction(tx, genesisBlock.Timestamp()))
 	genesisState.Commit()
 
-	acc, err := genesisState.GetAccount(distributed[dpos.DynastySize].Addr)
+	acc, err := genesisState.GetAccount(distributed[dpos.DefaultDynastySize].Addr)
 	assert.NoError(t, err)
 	assert.Equal(t, acc.Balance(), util.NewUint128FromUint(uint64(1000000000-10)))
-	candidate, err := genesisState.GetCandidate(distributed[dpos.DynastySize].Addr)
+	candidate, err := genesisState.GetCandidate(distributed[dpos.DefaultDynastySize].Addr)
 	assert.NoError(t, err)
 	tenBytes, err := util.NewUint128FromUint(10).ToFixedSizeByteSlice()
 	assert.NoError(t, err)
 	assert.Equal(t, candidate.Collateral, tenBytes)
 }
 
+func TestBecomeCandidateWithMeta(t *testing.T) {
+	genesis, _, distributed := testutil.NewTestGenesisBlock(t)
+	user := distributed[len(distributed)-1]
+
+	payloadBuf, err := core.NewBecomeCandidatePayload("medi", "https://medibloc.org", "peer").ToBytes()
+	require.NoError(t, err)
+	zeroTx, err := core.NewTransaction(testutil.ChainID, user.Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationBecomeCandidate, payloadBuf)
+	require.NoError(t, err)
+	testutil.SignTx(t, zeroTx, user.PrivKey)
+	becomeTx, err := core.NewTransaction(testutil.ChainID, user.Addr, common.Address{},
+		util.NewUint128FromUint(10), 1, core.TxOperationBecomeCandidate, payloadBuf)
+	require.NoError(t, err)
+	testutil.SignTx(t, becomeTx, user.PrivKey)
+
+	st, err := genesis.State().Clone()
+	require.NoError(t, err)
+	st.BeginBatch()
+	assert.Equal(t, core.ErrCollateralNotEnough, zeroTx.ExecuteOnState(st))
+	require.NoError(t, becomeTx.ExecuteOnState(st))
+	require.NoError(t, st.Commit())
+
+	candidate, err := st.GetCandidate(user.Addr)
+	require.NoError(t, err)
+	assert.Equal(t, "medi", candidate.Name)
+	assert.Equal(t, "https://medibloc.org", candidate.Url)
+	assert.Equal(t, "peer", candidate.PeerId)
+}
+
+func TestUpdateCandidate(t *testing.T) {
+	genesis, dynasties, distributed := testutil.NewTestGenesisBlock(t)
+	user := distributed[len(distributed)-1]
+
+	payloadBuf, err := core.NewUpdateCandidatePayload("node1", "https://node1.medibloc.org", "peer1").ToBytes()
+	require.NoError(t, err)
+	updateTx, err := core.NewTransaction(testutil.ChainID, dynasties[0].Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationUpdateCandidate, payloadBuf)
+	require.NoError(t, err)
+	testutil.SignTx(t, updateTx, dynasties[0].PrivKey)
+	notCandidateTx, err := core.NewTransaction(testutil.ChainID, user.Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationUpdateCandidate, payloadBuf)
+	require.NoError(t, err)
+	testutil.SignTx(t, notCandidateTx, user.PrivKey)
+
+	longName, err := core.NewUpdateCandidatePayload(string(make([]byte, core.MaxCandidateNameLength+1)), "", "").ToBytes()
+	require.NoError(t, err)
+	longTx, err := core.NewTransaction(testutil.ChainID, dynasties[0].Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationUpdateCandidate, longName)
+	require.NoError(t, err)
+	testutil.SignTx(t, longTx, dynasties[0].PrivKey)
+
+	st, err := genesis.State().Clone()
+	require.NoError(t, err)
+	st.BeginBatch()
+	require.NoError(t, updateTx.ExecuteOnState(st))
+	assert.Equal(t, core.ErrCandidateNotFound, notCandidateTx.ExecuteOnState(st))
+	assert.Equal(t, core.ErrInvalidCandidateMeta, longTx.ExecuteOnState(st))
+	require.NoError(t, st.Commit())
+
+	candidate, err := st.GetCandidate(dynasties[0].Addr)
+	require.NoError(t, err)
+	assert.Equal(t, "node1", candidate.Name)
+	assert.Equal(t, "https://node1.medibloc.org", candidate.Url)
+	assert.Equal(t, "peer1", candidate.PeerId)
+}
+
 func TestBecomeCandidateAlreadyCandidate(t *testing.T) {
 	genesisBlock, _, distributed := testutil.NewTestGenesisBlock(t)
 
 	tx1, err := core.NewTransaction(
 		testutil.ChainID,
-		distributed[dpos.DynastySize].Addr,
+		distributed[dpos.DefaultDynastySize].Addr,
 		common.Address{},
 		util.NewUint128FromUint(10), 1,
 		core.TxOperationBecomeCandidate, []byte{},
 	)
 	tx2, err := core.NewTransaction(
 		testutil.ChainID,
-		distributed[dpos.DynastySize].Addr,
+		distributed[dpos.DefaultDynastySize].Addr,
 		common.Address{},
 		util.NewUint128FromUint(10), 2,
 		core.TxOperationBecomeCandidate, []byte{},
@@ -267,7 +337,7 @@ func TestBecomeCandidateAlreadyCandidate(t *testing.T) {
 	assert.NoError(t, err)
 	sig, err := crypto.NewSignature(algorithm.SECP256K1)
 	assert.NoError(t, err)
-	sig.InitSign(distributed[dpos.DynastySize].PrivKey)
+	sig.InitSign(distributed[dpos.DefaultDynastySize].PrivKey)
 	assert.NoError(t, tx1.SignThis(sig))
 	assert.NoError(t, tx2.SignThis(sig))
 
@@ -285,7 +355,7 @@ func TestBecomeCandidateTooMuchCollateral(t *testing.T) {
 
 	tx, err := core.NewTransaction(
 		testutil.ChainID,
-		distributed[dpos.DynastySize].Addr,
+		distributed[dpos.DefaultDynastySize].Addr,
 		common.Address{},
 		util.NewUint128FromUint(1000000001), 1,
 		core.TxOperationBecomeCandidate, []byte{},
@@ -293,7 +363,7 @@ func TestBecomeCandidateTooMuchCollateral(t *testing.T) {
 	assert.NoError(t, err)
 	sig, err := crypto.NewSignature(algorithm.SECP256K1)
 	assert.NoError(t, err)
-	sig.InitSign(distributed[dpos.DynastySize].PrivKey)
+	sig.InitSign(distributed[dpos.DefaultDynastySize].PrivKey)
 	assert.NoError(t, tx.SignThis(sig))
 
 	genesisState, err := genesisBlock.State().Clone()
@@ -307,14 +377,14 @@ func TestQuitCandidacy(t *testing.T) {
 	genesisBlock, _, distributed := testutil.NewTestGenesisBlock(t)
 	becomeTx, err := core.NewTransaction(
 		testutil.ChainID,
-		distributed[dpos.DynastySize].Addr,
+		distributed[dpos.DefaultDynastySize].Addr,
 		common.Address{},
 		util.NewUint128FromUint(10), 1,
 		core.TxOperationBecomeCandidate, []byte{},
 	)
 	quitTx, err := core.NewTransaction(
 		testutil.ChainID,
-		distributed[dpos.DynastySize].Addr,
+		distributed[dpos.DefaultDynastySize].Addr,
 		common.Address{},
 		util.NewUint128FromUint(0), 2,
 		core.TxOperationQuitCandidacy, []byte{},
@@ -322,7 +392,7 @@ func TestQuitCandidacy(t *testing.T) {
 	assert.NoError(t, err)
 	sig, err := crypto.NewSignature(algorithm.SECP256K1)
 	assert.NoError(t, err)
-	sig.InitSign(distributed[dpos.DynastySize].PrivKey)
+	sig.InitSign(distributed[dpos.DefaultDynastySize].PrivKey)
 	assert.NoError(t, becomeTx.SignThis(sig))
 	assert.NoError(t, quitTx.SignThis(sig))
 
@@ -336,18 +406,21 @@ func TestQuitCandidacy(t *testing.T) {
 	assert.NoError(t, genesisState.AcceptTransaction(quitTx, genesisBlock.Timestamp()))
 	genesisState.Commit()
 
-	acc, err := genesisState.GetAccount(distributed[dpos.DynastySize].Addr)
+	acc, err := genesisState.GetAccount(distributed[dpos.DefaultDynastySize].Addr)
 	assert.NoError(t, err)
-	assert.Equal(t, acc.Balance(), util.NewUint128FromUint(uint64(1000000000)))
-	_, err = genesisState.GetCandidate(distributed[dpos.DynastySize].Addr)
+	assert.Equal(t, acc.Balance(), util.NewUint128FromUint(uint64(1000000000-10)))
+	_, err = genesisState.GetCandidate(distributed[dpos.DefaultDynastySize].Addr)
 	assert.Equal(t, core.ErrNotFound, err)
+	task, err := genesisState.GetUnbondingTask(distributed[dpos.DefaultDynastySize].Addr)
+	assert.NoError(t, err)
+	assert.Equal(t, genesisState.Timestamp()+genesisState.ChainParams().UnbondingPeriod(), task.Timestamp())
 }
 
 func TestVote(t *testing.T) {
 	genesisBlock, _, distributed := testutil.NewTestGenesisBlock(t)
 	becomeTx, err := core.NewTransaction(
 		testutil.ChainID,
-		distributed[dpos.DynastySize+1].Addr,
+		distributed[dpos.DefaultDynastySize+1].Addr,
 		common.Address{},
 		util.NewUint128FromUint(10), 1,
 		core.TxOperationBecomeCandidate, []byte{},
@@ -355,8 +428,8 @@ func TestVote(t *testing.T) {
 	assert.NoError(t, err)
 	voteTx, err := core.NewTransaction(
 		testutil.ChainID,
-		distributed[dpos.DynastySize].Addr,
-		distributed[dpos.DynastySize+1].Addr,
+		distributed[dpos.DefaultDynastySize].Addr,
+		distributed[dpos.DefaultDynastySize+1].Addr,
 		util.NewUint128FromUint(0), 1,
 		core.TxOperationVote, []byte{},
 	)
@@ -364,12 +437,12 @@ func TestVote(t *testing.T) {
 
 	votedSig, err := crypto.NewSignature(algorithm.SECP256K1)
 	assert.NoError(t, err)
-	votedSig.InitSign(distributed[dpos.DynastySize+1].PrivKey)
+	votedSig.InitSign(distributed[dpos.DefaultDynastySize+1].PrivKey)
 	assert.NoError(t, becomeTx.SignThis(votedSig))
 
 	voterSig, err := crypto.NewSignature(algorithm.SECP256K1)
 	assert.NoError(t, err)
-	voterSig.InitSign(distributed[dpos.DynastySize].PrivKey)
+	voterSig.InitSign(distributed[dpos.DefaultDynastySize].PrivKey)
 	assert.NoError(t, voteTx.SignThis(voterSig))
 
 	genesisState, err := genesisBlock.State().Clone()
@@ -382,9 +455,128 @@ func TestVote(t *testing.T) {
 	assert.NoError(t, genesisState.AcceptTransaction(voteTx, genesisBlock.Timestamp()))
 	genesisState.Commit()
 
-	voterAcc, err := genesisState.GetAccount(distributed[dpos.DynastySize].Addr)
+	voterAcc, err := genesisState.GetAccount(distributed[dpos.DefaultDynastySize].Addr)
 	assert.NoError(t, err)
-	assert.Equal(t, distributed[dpos.DynastySize+1].Addr.Bytes(), voterAcc.Voted())
+	assert.Equal(t, [][]byte{distributed[dpos.DefaultDynastySize+1].Addr.Bytes()}, voterAcc.Voted())
+}
+
+func TestMultiVote(t *testing.T) {
+	conf, dynasties, users := testutil.NewTestGenesisConf(t)
+	conf.ChainParams.MaxVotes = 2
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	genesis, err := core.NewGenesisBlock(conf, testutil.NewTestConsensus(t), stor)
+	require.NoError(t, err)
+
+	voter := users[len(users)-1]
+	first, second, third := dynasties[0].Addr, dynasties[1].Addr, dynasties[2].Addr
+	payload := core.NewVotePayload([][]byte{first.Bytes(), second.Bytes()})
+	payloadBuf, err := payload.ToBytes()
+	require.NoError(t, err)
+	voteTx, err := core.NewTransaction(testutil.ChainID, voter.Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationVote, payloadBuf)
+	require.NoError(t, err)
+	testutil.SignTx(t, voteTx, voter.PrivKey)
+
+	votesPowers := func(st *core.BlockState) []*util.Uint128 {
+		var powers []*util.Uint128
+		for _, candidate := range []common.Address{first, second} {
+			power, err := st.GetVotesPower(candidate)
+			require.NoError(t, err)
+			powers = append(powers, power)
+		}
+		return powers
+	}
+
+	st, err := genesis.State().Clone()
+	require.NoError(t, err)
+	st.BeginBatch()
+	require.NoError(t, st.Vest(voter.Addr, util.NewUint128FromUint(101)))
+	require.NoError(t, voteTx.ExecuteOnState(st))
+	assert.Equal(t, []*util.Uint128{util.NewUint128FromUint(51), util.NewUint128FromUint(50)}, votesPowers(st))
+
+	require.NoError(t, st.Vest(voter.Addr, util.NewUint128FromUint(10)))
+	assert.Equal(t, []*util.Uint128{util.NewUint128FromUint(56), util.NewUint128FromUint(55)}, votesPowers(st))
+	require.NoError(t, st.SubVesting(voter.Addr, util.NewUint128FromUint(21)))
+	assert.Equal(t, []*util.Uint128{util.NewUint128FromUint(45), util.NewUint128FromUint(45)}, votesPowers(st))
+
+	assert.Equal(t, core.ErrTooManyVotes, st.Vote(voter.Addr, []common.Address{first, second, third}))
+	assert.Equal(t, core.ErrVoteDuplicate, st.Vote(voter.Addr, []common.Address{first, first}))
+	assert.Equal(t, core.ErrVoteDuplicate, st.Vote(voter.Addr, []common.Address{first, second}))
+
+	unvoteTx, err := core.NewTransaction(testutil.ChainID, voter.Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationUnvote, nil)
+	require.NoError(t, err)
+	testutil.SignTx(t, unvoteTx, voter.PrivKey)
+	require.NoError(t, unvoteTx.ExecuteOnState(st))
+	assert.Equal(t, []*util.Uint128{util.Uint128Zero(), util.Uint128Zero()}, votesPowers(st))
+	assert.Equal(t, core.ErrNotVotedYet, unvoteTx.ExecuteOnState(st))
+	require.NoError(t, st.Commit())
+}
+
+func TestDelegate(t *testing.T) {
+	genesis, dynasties, users := testutil.NewTestGenesisBlock(t)
+	proxy, delegator, other := users[len(users)-1], users[len(users)-2], users[len(users)-3]
+	first, second := dynasties[0].Addr, dynasties[1].Addr
+
+	delegateTx, err := core.NewTransaction(testutil.ChainID, delegator.Addr, proxy.Addr,
+		util.Uint128Zero(), 1, core.TxOperationDelegate, nil)
+	require.NoError(t, err)
+	testutil.SignTx(t, delegateTx, delegator.PrivKey)
+	undelegateTx, err := core.NewTransaction(testutil.ChainID, delegator.Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationUndelegate, nil)
+	require.NoError(t, err)
+	testutil.SignTx(t, undelegateTx, delegator.PrivKey)
+
+	votesPower := func(st *core.BlockState, candidate common.Address) *util.Uint128 {
+		power, err := st.GetVotesPower(candidate)
+		require.NoError(t, err)
+		return power
+	}
+
+	st, err := genesis.State().Clone()
+	require.NoError(t, err)
+	st.BeginBatch()
+	require.NoError(t, st.Vest(proxy.Addr, util.NewUint128FromUint(100)))
+	require.NoError(t, st.Vest(delegator.Addr, util.NewUint128FromUint(50)))
+	require.NoError(t, st.Vote(proxy.Addr, []common.Address{first}))
+	require.NoError(t, st.Vote(delegator.Addr, []common.Address{second}))
+	assert.Equal(t, util.NewUint128FromUint(50), votesPower(st, second))
+
+	require.NoError(t, delegateTx.ExecuteOnState(st))
+	assert.Equal(t, util.NewUint128FromUint(150), votesPower(st, first))
+	assert.Equal(t, util.Uint128Zero(), votesPower(st, second))
+	assert.Equal(t, core.ErrAlreadyDelegated, delegateTx.ExecuteOnState(st))
+
+	unvoteTx, err := core.NewTransaction(testutil.ChainID, delegator.Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationUnvote, nil)
+	require.NoError(t, err)
+	testutil.SignTx(t, unvoteTx, delegator.PrivKey)
+	assert.Equal(t, core.ErrVotingPowerDelegated, unvoteTx.ExecuteOnState(st))
+	assert.Equal(t, util.NewUint128FromUint(150), votesPower(st, first))
+	assert.Equal(t, util.Uint128Zero(), votesPower(st, second))
+
+	require.NoError(t, st.Vest(delegator.Addr, util.NewUint128FromUint(10)))
+	assert.Equal(t, util.NewUint128FromUint(160), votesPower(st, first))
+	assert.Equal(t, core.ErrVotingPowerDelegated, st.Vote(delegator.Addr, []common.Address{first}))
+
+	assert.Equal(t, core.ErrInvalidProxy, st.Delegate(other.Addr, other.Addr))
+	assert.Equal(t, core.ErrProxyChainTooDeep, st.Delegate(proxy.Addr, other.Addr))
+	assert.Equal(t, core.ErrProxyChainTooDeep, st.Delegate(other.Addr, delegator.Addr))
+
+	require.NoError(t, st.Vote(proxy.Addr, []common.Address{second}))
+	assert.Equal(t, util.Uint128Zero(), votesPower(st, first))
+	assert.Equal(t, util.NewUint128FromUint(160), votesPower(st, second))
+
+	require.NoError(t, undelegateTx.ExecuteOnState(st))
+	assert.Equal(t, util.NewUint128FromUint(160), votesPower(st, second))
+	assert.Equal(t, core.ErrNotDelegated, undelegateTx.ExecuteOnState(st))
+	require.NoError(t, st.Commit())
+
+	acc, err := st.GetAccount(proxy.Addr)
+	require.NoError(t, err)
+	assert.Equal(t, util.Uint128Zero(), acc.ProxiedVesting())
+	assert.Equal(t, uint32(0), acc.Delegators())
 }
 
 func TestAddCertification(t *testing.T) {
@@ -595,3 +787,532 @@ func TestRevokeCertificationByInvalidAccount(t *testing.T) {
 	assert.NoError(t, st.AcceptTransaction(addCertTx, genesis.Timestamp()))
 	assert.Error(t, core.ErrInvalidCertificationRevoker, revokeCertTx.ExecuteOnState(st))
 }
+
+func newTestGenesisBlockWithRootIssuer(t *testing.T) (genesis *core.Block, rootIssuer *testutil.AddrKeyPair, users testutil.Distributed) {
+	conf, _, users := testutil.NewTestGenesisConf(t)
+	rootIssuer = users[0]
+	conf.Issuers = []*corepb.GenesisIssuer{
+		{
+			Address:   rootIssuer.Addr.Hex(),
+			Name:      "Root Issuer",
+			CertTypes: nil,
+		},
+	}
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	genesis, err = core.NewGenesisBlock(conf, testutil.NewTestConsensus(t), stor)
+	require.NoError(t, err)
+	return genesis, rootIssuer, users
+}
+
+func TestRegisterIssuer(t *testing.T) {
+	genesis, rootIssuer, users := newTestGenesisBlockWithRootIssuer(t)
+
+	issuer := users[1]
+	payload := core.NewRegisterIssuerPayload("Medical Board", []string{"medical_license"})
+	payloadBuf, err := payload.ToBytes()
+	assert.NoError(t, err)
+
+	registerTx, err := core.NewTransaction(testutil.ChainID, rootIssuer.Addr, issuer.Addr,
+		util.Uint128Zero(), 1, core.TxOperationRegisterIssuer, payloadBuf)
+	assert.NoError(t, err)
+	testutil.SignTx(t, registerTx, rootIssuer.PrivKey)
+
+	notRootTx, err := core.NewTransaction(testutil.ChainID, issuer.Addr, users[2].Addr,
+		util.Uint128Zero(), 1, core.TxOperationRegisterIssuer, payloadBuf)
+	assert.NoError(t, err)
+	testutil.SignTx(t, notRootTx, issuer.PrivKey)
+
+	st, err := genesis.State().Clone()
+	assert.NoError(t, err)
+
+	st.BeginBatch()
+	assert.NoError(t, registerTx.ExecuteOnState(st))
+	assert.NoError(t, st.AcceptTransaction(registerTx, genesis.Timestamp()))
+	assert.Equal(t, core.ErrNotRootIssuer, notRootTx.ExecuteOnState(st))
+	st.Commit()
+
+	pbIssuer, err := st.GetIssuer(issuer.Addr)
+	assert.NoError(t, err)
+	assert.Equal(t, "Medical Board", pbIssuer.Name)
+	assert.Equal(t, []string{"medical_license"}, pbIssuer.CertTypes)
+	assert.Equal(t, rootIssuer.Addr.Bytes(), pbIssuer.Registrar)
+	assert.Equal(t, genesis.Timestamp(), pbIssuer.RegistrationTime)
+	assert.False(t, pbIssuer.Root)
+
+	pbRootIssuer, err := st.GetIssuer(rootIssuer.Addr)
+	assert.NoError(t, err)
+	assert.True(t, pbRootIssuer.Root)
+}
+
+func TestDeregisterIssuer(t *testing.T) {
+	genesis, rootIssuer, users := newTestGenesisBlockWithRootIssuer(t)
+
+	issuer := users[1]
+	payload := core.NewRegisterIssuerPayload("Medical Board", nil)
+	payloadBuf, err := payload.ToBytes()
+	assert.NoError(t, err)
+
+	registerTx, err := core.NewTransaction(testutil.ChainID, rootIssuer.Addr, issuer.Addr,
+		util.Uint128Zero(), 1, core.TxOperationRegisterIssuer, payloadBuf)
+	assert.NoError(t, err)
+	testutil.SignTx(t, registerTx, rootIssuer.PrivKey)
+
+	deregisterRootTx, err := core.NewTransaction(testutil.ChainID, rootIssuer.Addr, rootIssuer.Addr,
+		util.Uint128Zero(), 2, core.TxOperationDeregisterIssuer, nil)
+	assert.NoError(t, err)
+	testutil.SignTx(t, deregisterRootTx, rootIssuer.PrivKey)
+
+	deregisterTx, err := core.NewTransaction(testutil.ChainID, rootIssuer.Addr, issuer.Addr,
+		util.Uint128Zero(), 2, core.TxOperationDeregisterIssuer, nil)
+	assert.NoError(t, err)
+	testutil.SignTx(t, deregisterTx, rootIssuer.PrivKey)
+
+	st, err := genesis.State().Clone()
+	assert.NoError(t, err)
+
+	st.BeginBatch()
+	assert.NoError(t, registerTx.ExecuteOnState(st))
+	assert.NoError(t, st.AcceptTransaction(registerTx, genesis.Timestamp()))
+	assert.Equal(t, core.ErrCannotDeregisterRootIssuer, deregisterRootTx.ExecuteOnState(st))
+	assert.NoError(t, deregisterTx.ExecuteOnState(st))
+	assert.NoError(t, st.AcceptTransaction(deregisterTx, genesis.Timestamp()))
+	st.Commit()
+
+	_, err = st.GetIssuer(issuer.Addr)
+	assert.Equal(t, core.ErrNotFound, err)
+}
+
+func TestAddCertificationByRegisteredIssuer(t *testing.T) {
+	genesis, rootIssuer, users := newTestGenesisBlockWithRootIssuer(t)
+
+	issuer := users[1]
+	certified := users[2]
+	registerPayload := core.NewRegisterIssuerPayload("Medical Board", []string{"medical_license"})
+	registerPayloadBuf, err := registerPayload.ToBytes()
+	assert.NoError(t, err)
+
+	registerTx, err := core.NewTransaction(testutil.ChainID, rootIssuer.Addr, issuer.Addr,
+		util.Uint128Zero(), 1, core.TxOperationRegisterIssuer, registerPayloadBuf)
+	assert.NoError(t, err)
+	testutil.SignTx(t, registerTx, rootIssuer.PrivKey)
+
+	newAddCertTx := func(from *testutil.AddrKeyPair, certType string, hash []byte) *core.Transaction {
+		payload := core.NewAddCertificationPayload(time.Now().Unix(), time.Now().Unix()+int64(100000), hash)
+		payload.CertificationType = certType
+		payloadBuf, err := payload.ToBytes()
+		assert.NoError(t, err)
+		tx, err := core.NewTransaction(testutil.ChainID, from.Addr, certified.Addr,
+			util.Uint128Zero(), 1, core.TxOperationAddCertification, payloadBuf)
+		assert.NoError(t, err)
+		testutil.SignTx(t, tx, from.PrivKey)
+		return tx
+	}
+
+	certHash := byteutils.Hex2Bytes("02e7b794e1de1851b52ab0b0b995cc87558963265a7b26630f26ea8bb9131a7e")
+	addCertTx := newAddCertTx(issuer, "medical_license", certHash)
+	notAllowedTx := newAddCertTx(issuer, "pharmacist_license", certHash)
+	notRegisteredTx := newAddCertTx(users[3], "medical_license", certHash)
+
+	st, err := genesis.State().Clone()
+	assert.NoError(t, err)
+
+	st.BeginBatch()
+	assert.NoError(t, registerTx.ExecuteOnState(st))
+	assert.NoError(t, st.AcceptTransaction(registerTx, genesis.Timestamp()))
+	assert.Equal(t, core.ErrCertTypeNotAllowed, notAllowedTx.ExecuteOnState(st))
+	assert.Equal(t, core.ErrIssuerNotRegistered, notRegisteredTx.ExecuteOnState(st))
+	assert.NoError(t, addCertTx.ExecuteOnState(st))
+	assert.NoError(t, st.AcceptTransaction(addCertTx, genesis.Timestamp()))
+	st.Commit()
+
+	cert, err := st.GetCertification(certHash)
+	assert.NoError(t, err)
+	assert.Equal(t, issuer.Addr.Bytes(), cert.Issuer)
+	assert.Equal(t, "medical_license", cert.CertificationType)
+}
+
+func TestMultisigTransfer(t *testing.T) {
+	genesis, _, users := testutil.NewTestGenesisBlock(t)
+
+	creator := users[0]
+	owners := []*testutil.AddrKeyPair{users[1], users[2], users[3]}
+	payload := core.NewCreateMultisigPayload(3, []*core.MultisigOwner{
+		{Address: owners[0].Addr.Bytes(), Weight: 2},
+		{Address: owners[1].Addr.Bytes(), Weight: 1},
+		{Address: owners[2].Addr.Bytes(), Weight: 1},
+	})
+	payloadBuf, err := payload.ToBytes()
+	assert.NoError(t, err)
+
+	createTx, err := core.NewTransaction(testutil.ChainID, creator.Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationCreateMultisig, payloadBuf)
+	assert.NoError(t, err)
+	testutil.SignTx(t, createTx, creator.PrivKey)
+
+	multisigAddr := core.NewMultisigAddress(creator.Addr, 1)
+	fundTx, err := core.NewTransaction(testutil.ChainID, creator.Addr, multisigAddr,
+		util.NewUint128FromUint(100), 2, core.TxOperationSend, []byte{})
+	assert.NoError(t, err)
+	testutil.SignTx(t, fundTx, creator.PrivKey)
+
+	newMultisigTx := func(signers ...*testutil.AddrKeyPair) *core.Transaction {
+		tx, err := core.NewTransaction(testutil.ChainID, multisigAddr, users[4].Addr,
+			util.NewUint128FromUint(10), 1, core.TxOperationSend, []byte{})
+		assert.NoError(t, err)
+		for _, signer := range signers {
+			sig, err := crypto.NewSignature(algorithm.SECP256K1)
+			assert.NoError(t, err)
+			sig.InitSign(signer.PrivKey)
+			assert.NoError(t, tx.SignByMultisigOwner(sig))
+		}
+		return tx
+	}
+
+	belowThresholdTx := newMultisigTx(owners[1], owners[2])
+	notOwnerTx := newMultisigTx(owners[0], users[5])
+	duplicatedTx := newMultisigTx(owners[1], owners[1], owners[2])
+	validTx := newMultisigTx(owners[0], owners[2])
+
+	assert.NoError(t, belowThresholdTx.VerifyIntegrity(testutil.ChainID))
+	assert.Equal(t, core.ErrDuplicatedMultisigSigner, duplicatedTx.VerifyIntegrity(testutil.ChainID))
+	assert.NoError(t, validTx.VerifyIntegrity(testutil.ChainID))
+
+	st, err := genesis.State().Clone()
+	assert.NoError(t, err)
+
+	st.BeginBatch()
+	assert.NoError(t, createTx.ExecuteOnState(st))
+	assert.NoError(t, st.AcceptTransaction(createTx, genesis.Timestamp()))
+	assert.NoError(t, fundTx.ExecuteOnState(st))
+	assert.NoError(t, st.AcceptTransaction(fundTx, genesis.Timestamp()))
+	assert.Equal(t, core.ErrMultisigThresholdNotMet, belowThresholdTx.ExecuteOnState(st))
+	assert.Equal(t, core.ErrMultisigSignerNotOwner, notOwnerTx.ExecuteOnState(st))
+	assert.NoError(t, validTx.ExecuteOnState(st))
+	assert.NoError(t, st.AcceptTransaction(validTx, genesis.Timestamp()))
+	st.Commit()
+
+	multisig, err := st.GetMultisig(multisigAddr)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), multisig.Threshold)
+	assert.Equal(t, 3, len(multisig.Owners))
+
+	acc, err := st.GetAccount(multisigAddr)
+	assert.NoError(t, err)
+	assert.Equal(t, util.NewUint128FromUint(90), acc.Balance())
+	assert.Equal(t, uint64(1), acc.Nonce())
+}
+
+func TestCreateMultisigInvalidThreshold(t *testing.T) {
+	genesis, _, users := testutil.NewTestGenesisBlock(t)
+
+	payload := core.NewCreateMultisigPayload(3, []*core.MultisigOwner{
+		{Address: users[1].Addr.Bytes(), Weight: 1},
+		{Address: users[2].Addr.Bytes(), Weight: 1},
+	})
+	payloadBuf, err := payload.ToBytes()
+	assert.NoError(t, err)
+
+	createTx, err := core.NewTransaction(testutil.ChainID, users[0].Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationCreateMultisig, payloadBuf)
+	assert.NoError(t, err)
+	testutil.SignTx(t, createTx, users[0].PrivKey)
+
+	st, err := genesis.State().Clone()
+	assert.NoError(t, err)
+
+	st.BeginBatch()
+	assert.Equal(t, core.ErrInvalidMultisigThreshold, createTx.ExecuteOnState(st))
+}
+
+func TestGovernanceProposal(t *testing.T) {
+	genesis, dynasties, _ := testutil.NewTestGenesisBlock(t)
+	proposer, approver, rejecter := dynasties[0], dynasties[1], dynasties[2]
+
+	newBlock, err := core.NewBlock(testutil.ChainID, proposer.Addr, genesis)
+	require.NoError(t, err)
+	newBlock.SetTimestamp(int64(1000))
+
+	vest := func(pair *testutil.AddrKeyPair, amount uint64) *core.Transaction {
+		tx, err := core.NewTransaction(testutil.ChainID, pair.Addr, common.Address{},
+			util.NewUint128FromUint(amount), 1, core.TxOperationVest, []byte{})
+		require.NoError(t, err)
+		tx.SetTimestamp(int64(1000))
+		testutil.SignTx(t, tx, pair.PrivKey)
+		return tx
+	}
+
+	payload := core.NewSubmitProposalPayload([]*core.ParamChange{
+		{Name: core.ChainParamWithdrawInterval, Value: "60"},
+	})
+	payloadBuf, err := payload.ToBytes()
+	require.NoError(t, err)
+	submitTx, err := core.NewTransaction(testutil.ChainID, proposer.Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationSubmitProposal, payloadBuf)
+	require.NoError(t, err)
+	// a backdated transaction cannot shorten voting period which starts at block time
+	submitTx.SetTimestamp(int64(1))
+	testutil.SignTx(t, submitTx, proposer.PrivKey)
+
+	voteProposal := func(pair *testutil.AddrKeyPair, approve bool) *core.Transaction {
+		payloadBuf, err := core.NewVoteProposalPayload(submitTx.Hash(), approve).ToBytes()
+		require.NoError(t, err)
+		tx, err := core.NewTransaction(testutil.ChainID, pair.Addr, common.Address{},
+			util.Uint128Zero(), 2, core.TxOperationVoteProposal, payloadBuf)
+		require.NoError(t, err)
+		tx.SetTimestamp(int64(2000))
+		testutil.SignTx(t, tx, pair.PrivKey)
+		return tx
+	}
+
+	txs := []*core.Transaction{
+		submitTx,
+		vest(approver, 300),
+		vest(rejecter, 200),
+		voteProposal(approver, true),
+		voteProposal(rejecter, false),
+	}
+
+	newBlock.BeginBatch()
+	for _, tx := range txs {
+		require.NoError(t, newBlock.ExecuteTransaction(tx))
+		require.NoError(t, newBlock.AcceptTransaction(tx))
+	}
+	require.NoError(t, newBlock.ExecuteReservedTasks())
+	newBlock.Commit()
+
+	proposal, err := newBlock.State().GetProposal(submitTx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, core.ProposalStatusVoting, proposal.Status)
+	assert.Equal(t, 2, len(proposal.Votes))
+	assert.Equal(t, int64(1000)+core.DefaultProposalPeriod, proposal.VotingEndTime)
+
+	newBlock.SetTimestamp(proposal.VotingEndTime)
+	lateVoteTx := voteProposal(proposer, true)
+	st, err := newBlock.State().Clone()
+	require.NoError(t, err)
+	st.BeginBatch()
+	assert.Equal(t, core.ErrProposalVotingClosed, lateVoteTx.ExecuteOnState(st))
+	st.RollBack()
+
+	newBlock.BeginBatch()
+	require.NoError(t, newBlock.ExecuteReservedTasks())
+	newBlock.Commit()
+
+	proposal, err = newBlock.State().GetProposal(submitTx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, core.ProposalStatusPassed, proposal.Status)
+	activationHeight := newBlock.State().Height() + core.DefaultProposalActivationDelay
+	assert.Equal(t, activationHeight, proposal.ActivationHeight)
+	params := newBlock.State().ChainParams()
+	assert.Equal(t, uint64(1), params.Version())
+
+	newBlock.SetHeight(activationHeight - 1)
+	newBlock.BeginBatch()
+	require.NoError(t, newBlock.ExecuteReservedTasks())
+	newBlock.Commit()
+	assert.Equal(t, uint64(1), newBlock.State().ChainParams().Version())
+
+	newBlock.SetHeight(activationHeight)
+	newBlock.BeginBatch()
+	require.NoError(t, newBlock.ExecuteReservedTasks())
+	newBlock.Commit()
+
+	proposal, err = newBlock.State().GetProposal(submitTx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, core.ProposalStatusApplied, proposal.Status)
+	params = newBlock.State().ChainParams()
+	assert.Equal(t, uint64(2), params.Version())
+	assert.Equal(t, int64(60), params.WithdrawInterval())
+}
+
+func TestGovernanceProposalQuorum(t *testing.T) {
+	genesis, dynasties, _ := testutil.NewTestGenesisBlock(t)
+	proposer, voter, bystander := dynasties[0], dynasties[1], dynasties[2]
+
+	newBlock, err := core.NewBlock(testutil.ChainID, proposer.Addr, genesis)
+	require.NoError(t, err)
+	newBlock.SetTimestamp(int64(1000))
+
+	vest := func(pair *testutil.AddrKeyPair, amount uint64) *core.Transaction {
+		tx, err := core.NewTransaction(testutil.ChainID, pair.Addr, common.Address{},
+			util.NewUint128FromUint(amount), 1, core.TxOperationVest, []byte{})
+		require.NoError(t, err)
+		testutil.SignTx(t, tx, pair.PrivKey)
+		return tx
+	}
+
+	payloadBuf, err := core.NewSubmitProposalPayload([]*core.ParamChange{
+		{Name: core.ChainParamWithdrawInterval, Value: "60"},
+	}).ToBytes()
+	require.NoError(t, err)
+	submitTx, err := core.NewTransaction(testutil.ChainID, proposer.Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationSubmitProposal, payloadBuf)
+	require.NoError(t, err)
+	testutil.SignTx(t, submitTx, proposer.PrivKey)
+
+	payloadBuf, err = core.NewVoteProposalPayload(submitTx.Hash(), true).ToBytes()
+	require.NoError(t, err)
+	voteTx, err := core.NewTransaction(testutil.ChainID, voter.Addr, common.Address{},
+		util.Uint128Zero(), 2, core.TxOperationVoteProposal, payloadBuf)
+	require.NoError(t, err)
+	testutil.SignTx(t, voteTx, voter.PrivKey)
+
+	newBlock.BeginBatch()
+	for _, tx := range []*core.Transaction{submitTx, vest(voter, 100), vest(bystander, 1000), voteTx} {
+		require.NoError(t, newBlock.ExecuteTransaction(tx))
+		require.NoError(t, newBlock.AcceptTransaction(tx))
+	}
+	newBlock.Commit()
+
+	total, err := newBlock.State().TotalVesting()
+	require.NoError(t, err)
+	require.True(t, total.Cmp(util.NewUint128FromUint(1100)) >= 0)
+
+	proposal, err := newBlock.State().GetProposal(submitTx.Hash())
+	require.NoError(t, err)
+	newBlock.SetTimestamp(proposal.VotingEndTime)
+	newBlock.BeginBatch()
+	require.NoError(t, newBlock.ExecuteReservedTasks())
+	newBlock.Commit()
+
+	proposal, err = newBlock.State().GetProposal(submitTx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, core.ProposalStatusRejected, proposal.Status)
+	assert.Equal(t, uint64(1), newBlock.State().ChainParams().Version())
+}
+
+func TestGovernanceProposalValidators(t *testing.T) {
+	genesis, dynasties, users := testutil.NewTestGenesisBlock(t)
+	holder := users[len(users)-1]
+	changes := []*corepb.ParamChange{
+		{Name: core.ChainParamValidators, Value: dynasties[0].Addr.Hex()},
+	}
+
+	st, err := genesis.State().Clone()
+	require.NoError(t, err)
+	st.BeginBatch()
+	require.NoError(t, st.Vest(holder.Addr, util.NewUint128FromUint(1000)))
+
+	tally := func(approvers int) string {
+		hash := make([]byte, 32)
+		hash[0] = byte(approvers)
+		require.NoError(t, st.SubmitProposal(hash, holder.Addr, changes, st.Timestamp()))
+		require.NoError(t, st.VoteProposal(hash, holder.Addr, true, st.Timestamp()))
+		for _, d := range dynasties[:approvers] {
+			require.NoError(t, st.VoteProposal(hash, d.Addr, true, st.Timestamp()))
+		}
+		require.NoError(t, st.TallyProposal(hash))
+		proposal, err := st.GetProposal(hash)
+		require.NoError(t, err)
+		return proposal.Status
+	}
+
+	twoThirds := len(dynasties) * 2 / 3
+	assert.Equal(t, core.ProposalStatusRejected, tally(twoThirds))
+	assert.Equal(t, core.ProposalStatusPassed, tally(twoThirds+1))
+	st.RollBack()
+}
+
+func TestGovernanceProposalValidatorsWithoutVesting(t *testing.T) {
+	conf, dynasties, users := testutil.NewTestGenesisConf(t)
+	conf.ChainParams.Validators = conf.Consensus.Dpos.Dynasty
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	genesis, err := core.NewGenesisBlock(conf, testutil.NewTestConsensus(t), stor)
+	require.NoError(t, err)
+	changes := []*corepb.ParamChange{
+		{Name: core.ChainParamValidators, Value: dynasties[0].Addr.Hex()},
+	}
+
+	st, err := genesis.State().Clone()
+	require.NoError(t, err)
+	totalVesting, err := st.TotalVesting()
+	require.NoError(t, err)
+	require.Equal(t, util.Uint128Zero(), totalVesting)
+	st.BeginBatch()
+
+	tally := func(approvers int) string {
+		hash := make([]byte, 32)
+		hash[0] = byte(approvers)
+		require.NoError(t, st.SubmitProposal(hash, dynasties[0].Addr, changes, st.Timestamp()))
+		for _, d := range dynasties[:approvers] {
+			require.NoError(t, st.VoteProposal(hash, d.Addr, true, st.Timestamp()))
+		}
+		require.NoError(t, st.TallyProposal(hash))
+		proposal, err := st.GetProposal(hash)
+		require.NoError(t, err)
+		return proposal.Status
+	}
+
+	twoThirds := len(dynasties) * 2 / 3
+	assert.Equal(t, core.ProposalStatusRejected, tally(twoThirds))
+	assert.Equal(t, core.ProposalStatusPassed, tally(twoThirds+1))
+
+	hash := make([]byte, 32)
+	require.NoError(t, st.SubmitProposal(hash, dynasties[0].Addr, changes, st.Timestamp()))
+	assert.Equal(t, core.ErrNoVotingPower, st.VoteProposal(hash, users[len(users)-1].Addr, true, st.Timestamp()))
+
+	mixed := append(changes, &corepb.ParamChange{Name: core.ChainParamMaxVotes, Value: "3"})
+	hash[0] = 0xff
+	assert.Equal(t, core.ErrValidatorsProposalNotAlone, st.SubmitProposal(hash, dynasties[0].Addr, mixed, st.Timestamp()))
+	st.RollBack()
+}
+
+func TestSubmitProposalUnknownParam(t *testing.T) {
+	genesis, dynasties, _ := testutil.NewTestGenesisBlock(t)
+
+	payload := core.NewSubmitProposalPayload([]*core.ParamChange{
+		{Name: "block_interval", Value: "1"},
+	})
+	payloadBuf, err := payload.ToBytes()
+	require.NoError(t, err)
+	submitTx, err := core.NewTransaction(testutil.ChainID, dynasties[0].Addr, common.Address{},
+		util.Uint128Zero(), 1, core.TxOperationSubmitProposal, payloadBuf)
+	require.NoError(t, err)
+	testutil.SignTx(t, submitTx, dynasties[0].PrivKey)
+
+	st, err := genesis.State().Clone()
+	require.NoError(t, err)
+	st.BeginBatch()
+	assert.Equal(t, core.ErrUnknownChainParam, submitTx.ExecuteOnState(st))
+	st.RollBack()
+}
+
+func TestTransactionTimestampByClock(t *testing.T) {
+	manual := clock.NewManual(time.Unix(1500000000, 0))
+	core.SetClock(manual)
+	defer core.SetClock(clock.System)
+
+	from, to := testutil.NewAddrKeyPair(t), testutil.NewAddrKeyPair(t)
+	tx, err := core.NewTransaction(testutil.ChainID, from.Addr, to.Addr, util.NewUint128(), 1, core.TxPayloadBinaryType, []byte{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1500000000), tx.Timestamp())
+
+	manual.Advance(time.Minute)
+	tx, err = core.NewTransaction(testutil.ChainID, from.Addr, to.Addr, util.NewUint128(), 2, core.TxPayloadBinaryType, []byte{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1500000060), tx.Timestamp())
+}
+
+func TestPreVerifyTransactions(t *testing.T) {
+	var txs core.Transactions
+	for i := 0; i < 8; i++ {
+		txs = append(txs, testutil.NewRandomSignedTransaction(t))
+	}
+
+	// A copy of the first tx signed by another key has the same hash.
+	msg, err := txs[0].ToProto()
+	require.NoError(t, err)
+	forged := new(core.Transaction)
+	require.NoError(t, forged.FromProto(msg))
+	testutil.SignTx(t, forged, testutil.NewPrivateKey(t))
+	require.Equal(t, txs[0].Hash(), forged.Hash())
+
+	core.PreVerifyTransactions(append(txs, forged))
+	for _, tx := range txs {
+		assert.NoError(t, tx.VerifyIntegrity(testutil.ChainID))
+	}
+	assert.Equal(t, core.ErrInvalidTransactionSigner, forged.VerifyIntegrity(testutil.ChainID))
+	assert.NoError(t, txs[0].VerifyIntegrity(testutil.ChainID))
+}
@@ -33,6 +33,39 @@ const (
 	TxOperationVote                = "vote"
 	TxOperationAddCertification    = "add_certification"
 	TxOperationRevokeCertification = "revoke_certification"
+	TxOperationRegisterIssuer      = "register_issuer"
+	TxOperationDeregisterIssuer    = "deregister_issuer"
+	TxOperationCreateMultisig      = "create_multisig"
+	TxOperationScheduleTransfer    = "schedule_transfer"
+	TxOperationCancelReservedTask  = "cancel_reserved_task"
+	TxOperationSubmitProposal      = "submit_proposal"
+	TxOperationVoteProposal        = "vote_proposal"
+	TxOperationUpdateCandidate     = "update_candidate"
+	TxOperationReportDoubleSign    = "report_double_sign"
+	TxOperationUnvote              = "unvote"
+	TxOperationDelegate            = "delegate"
+	TxOperationUndelegate          = "undelegate"
+)
+
+// Forks of protocol upgrades after the initial protocol.
+const (
+	ForkIssuerRegistry     = "issuer_registry"
+	ForkMultisig           = "multisig"
+	ForkScheduledTransfer  = "scheduled_transfer"
+	ForkGovernance         = "governance"
+	ForkCandidateMeta      = "candidate_meta"
+	ForkDoubleSignSlashing = "double_sign_slashing"
+	ForkMultiVote          = "multi_vote"
+	ForkDelegation         = "delegation"
+	ForkExtendedHeader     = "extended_header"
+)
+
+// Versions of block header.
+const (
+	// BlockHeaderV0 is the initial block header. Its hash does not cover states added after the initial protocol.
+	BlockHeaderV0 uint32 = 0
+	// BlockHeaderV1 is the block header from ForkExtendedHeader. Its hash covers every state root, chain parameters and supply.
+	BlockHeaderV1 uint32 = 1
 )
 
 // Transaction payload type.
@@ -52,15 +85,68 @@ const (
 	MessageTypeResponseBlock = "respblock"
 )
 
+// Finality's message types.
+const (
+	MessageTypeFinalityVote = "finvote"
+)
+
 // type of ReservedTask
 const (
-	RtWithdrawType = "withdraw"
+	RtWithdrawType         = "withdraw"
+	RtTransferType         = "transfer"
+	RtUnbondCollateralType = "unbond_collateral"
+	RtTallyProposalType    = "tally_proposal"
+	RtApplyProposalType    = "apply_proposal"
+)
+
+// default values of chain parameters
+const (
+	DefaultRtWithdrawNum      = uint32(3)
+	DefaultRtWithdrawInterval = int64(3000)
+	DefaultUsageWindow        = int64(604800)
+	DefaultProposalPeriod     = int64(604800)
+	DefaultMinCollateral      = "1"
+	DefaultUnbondingPeriod    = int64(604800)
+	DefaultMaxVotes           = uint32(1)
+	DefaultBlockReward        = "0"
+
+	DefaultProposalQuorum          = uint32(40)
+	DefaultProposalActivationDelay = uint64(5760)
+)
+
+// maximum lengths of candidate metadata
+const (
+	MaxCandidateNameLength   = 64
+	MaxCandidateURLLength    = 256
+	MaxCandidatePeerIDLength = 128
 )
 
-// consts for reserved task-related values
+// names of chain parameters which can be changed by governance proposals
 const (
-	RtWithdrawNum      = 3
-	RtWithdrawInterval = int64(3000)
+	ChainParamWithdrawNum      = "withdraw_num"
+	ChainParamWithdrawInterval = "withdraw_interval"
+	ChainParamUsageWindow      = "usage_window"
+	ChainParamProposalPeriod   = "proposal_period"
+	ChainParamMinCollateral    = "min_collateral"
+	ChainParamUnbondingPeriod  = "unbonding_period"
+	ChainParamMaxMissedSlots   = "max_missed_slots"
+	ChainParamMaxVotes         = "max_votes"
+	ChainParamBlockReward      = "block_reward"
+	ChainParamValidators       = "validators"
+	ChainParamForkPrefix       = "fork:"
+
+	ChainParamProposalQuorum          = "proposal_quorum"
+	ChainParamProposalActivationDelay = "proposal_activation_delay"
+	ChainParamDynastySize             = "dynasty_size"
+)
+
+// status of governance proposal
+const (
+	ProposalStatusVoting   = "voting"
+	ProposalStatusPassed   = "passed"
+	ProposalStatusRejected = "rejected"
+	ProposalStatusApplied  = "applied"
+	ProposalStatusFailed   = "failed"
 )
 
 // Error types of core package.
@@ -102,7 +188,15 @@ var (
 	ErrInvalidBlockRecordsRoot          = errors.New("invalid records state root hash")
 	ErrInvalidBlockCandidacyRoot        = errors.New("invalid candidacy state root hash")
 	ErrInvalidBlockCertificationRoot    = errors.New("invalid certification state root hash")
+	ErrInvalidBlockIssuerRoot           = errors.New("invalid issuer state root hash")
+	ErrInvalidBlockMultisigRoot         = errors.New("invalid multisig state root hash")
 	ErrInvalidBlockReservationQueueHash = errors.New("invalid reservation queue hash")
+	ErrInvalidBlockChainParamsHash      = errors.New("invalid chain parameters hash")
+	ErrInvalidBlockGovernanceRoot       = errors.New("invalid governance root hash")
+	ErrInvalidBlockEvidenceRoot         = errors.New("invalid evidence root hash")
+	ErrInvalidBlockSupply               = errors.New("invalid block supply")
+	ErrInvalidBlockHeaderVersion        = errors.New("invalid block header version")
+	ErrInvalidBlockVotesRoot            = errors.New("invalid votes root hash")
 	ErrInvalidBlockConsensusRoot        = errors.New("invalid block consensus root hash")
 	ErrTooOldTransaction                = errors.New("transaction timestamp is too old")
 	ErrInvalidTxPayload                 = errors.New("cannot unmarshal tx payload")
@@ -112,6 +206,11 @@ var (
 	ErrCertIssuedAlreadyAdded           = errors.New("hash of issued cert already added")
 	ErrCertAlreadyRevoked               = errors.New("cert to revoke has already been revoked")
 	ErrInvalidCertificationRevoker      = errors.New("only issuer of the cert can revoke it")
+	ErrIssuerAlreadyRegistered          = errors.New("issuer is already registered")
+	ErrIssuerNotRegistered              = errors.New("issuer is not registered")
+	ErrNotRootIssuer                    = errors.New("only root issuer can register or deregister issuers")
+	ErrCannotDeregisterRootIssuer       = errors.New("root issuer cannot be deregistered")
+	ErrCertTypeNotAllowed               = errors.New("issuer is not allowed to issue the certification type")
 	ErrTxIsNotFromRecordOwner           = errors.New("adding record reader should be done by record owner")
 	ErrCannotConvertResevedTask         = errors.New("proto message cannot be converted into ResevedTask")
 	ErrCannotConvertResevedTasks        = errors.New("proto message cannot be converted into ResevedTasks")
@@ -125,11 +224,57 @@ var (
 	ErrNotVotedYet                      = errors.New("account has not voted for anyone")
 	ErrCandidateNotFound                = errors.New("candidate not found")
 	ErrVotesPowerGetsMinus              = errors.New("cannot subtract a bigger value from votes power")
-	ErrVotesCacheAlreadyBatching        = errors.New("votes cache is already in batch mode")
-	ErrCannotConstructVotesCacheInBatch = errors.New("votes cache cannot be constructed in batch mode")
 	ErrVoteDuplicate                    = errors.New("cannot vote already voted account")
+	ErrTooManyVotes                     = errors.New("number of voted candidates exceeds the limit")
+	ErrInvalidProxy                     = errors.New("cannot delegate voting power to oneself")
+	ErrAlreadyDelegated                 = errors.New("account has already delegated voting power to the proxy")
+	ErrNotDelegated                     = errors.New("account has not delegated voting power")
+	ErrProxyChainTooDeep                = errors.New("voting power cannot be delegated more than one level")
+	ErrVotingPowerDelegated             = errors.New("account has delegated voting power to a proxy")
 	ErrDynastyExpired                   = errors.New("dynasty in the consensus state has been expired")
 	ErrPayerSignatureNotExist           = errors.New("payer signature does not exist in the tx")
+	ErrMultisigAlreadyExist             = errors.New("multisig account already exists")
+	ErrNotMultisigAccount               = errors.New("account is not a multisig account")
+	ErrInvalidMultisigThreshold         = errors.New("multisig threshold should be positive and not exceed total weight")
+	ErrInvalidMultisigOwner             = errors.New("multisig owners should be unique and have positive weights")
+	ErrDuplicatedMultisigSigner         = errors.New("multisig signers are duplicated")
+	ErrMultisigSignerNotOwner           = errors.New("multisig signer is not an owner of the account")
+	ErrMultisigThresholdNotMet          = errors.New("total weight of multisig signers is less than threshold")
+	ErrInvalidReservedTaskPayload       = errors.New("payload of reserved task is invalid")
+	ErrReservedTaskNotFound             = errors.New("reserved task not found")
+	ErrNotReservedTaskOwner             = errors.New("only the owner can cancel the reserved task")
+	ErrReservedTaskNotCancellable       = errors.New("reserved task cannot be cancelled")
+	ErrInvalidScheduledTime             = errors.New("scheduled time should be later than tx timestamp")
+	ErrCertAlreadyExpired               = errors.New("certification is already expired")
+	ErrCannotConvertChainParams         = errors.New("proto message cannot be converted into ChainParams")
+	ErrInvalidChainParamsHash           = errors.New("hash of chain parameters invalid")
+	ErrInvalidChainParams               = errors.New("chain parameters are invalid")
+	ErrUnknownChainParam                = errors.New("unknown chain parameter")
+	ErrEmptyProposal                    = errors.New("proposal has no parameter change")
+	ErrProposalNotFound                 = errors.New("proposal not found")
+	ErrProposalAlreadyExist             = errors.New("proposal already exists")
+	ErrProposalVotingClosed             = errors.New("voting on the proposal is closed")
+	ErrNoVotingPower                    = errors.New("account has no vesting to vote with")
+	ErrProposalNotPassed                = errors.New("proposal has not passed")
+	ErrValidatorsProposalNotAlone       = errors.New("validators change must be proposed without other changes")
+	ErrDuplicatedFork                   = errors.New("fork is scheduled more than once")
+	ErrForkHeightPassed                 = errors.New("fork height is not after the current height")
+	ErrForkAlreadyActive                = errors.New("fork is already active")
+	ErrTxTypeNotActivated               = errors.New("transaction type is not activated at this height")
+	ErrCollateralNotEnough              = errors.New("collateral is less than minimum collateral")
+	ErrInvalidCandidateMeta             = errors.New("candidate metadata is too long")
+	ErrNoCandidate                      = errors.New("there is no candidate to be elected")
+	ErrCollateralUnbonding              = errors.New("collateral of previous candidacy is not released yet")
+	ErrCannotConvertEvidence            = errors.New("cannot convert evidence")
+	ErrInvalidEvidence                  = errors.New("evidence does not prove double signing")
+	ErrEvidenceTooOld                   = errors.New("evidence is older than unbonding period")
+	ErrEvidenceAlreadySubmitted         = errors.New("evidence is already submitted")
+	ErrCannotConvertFinalityVote        = errors.New("proto message cannot be converted into FinalityVote")
+	ErrCannotConvertFinalityCertificate = errors.New("proto message cannot be converted into FinalityCertificate")
+	ErrInvalidFinalityVote              = errors.New("finality vote does not match the certified block")
+	ErrFinalityVoterNotMember           = errors.New("finality voter is not a member of the dynasty")
+	ErrFinalityVotesNotEnough           = errors.New("finality votes are less than two thirds of the dynasty")
+	ErrFinalityCertificateNotFound      = errors.New("finality certificate not found")
 )
 
 // ConsensusState is an interface for a consensus state
@@ -142,11 +287,20 @@ type ConsensusState interface {
 	Proposer() common.Address
 	Timestamp() int64
 	DynastySize() int
+	Liveness() ([]*ProposerLiveness, error)
+	PrevLiveness() ([]*ProposerLiveness, error)
 
 	GetNextStateAfterGenesis(timestamp int64) (ConsensusState, error)
 	GetNextStateAfter(ellapsed int64) (ConsensusState, error)
 }
 
+// ProposerLiveness is the number of blocks produced and missed by a proposer in a dynasty
+type ProposerLiveness struct {
+	Address  common.Address
+	Produced uint64
+	Missed   uint64
+}
+
 // HashableBlock is an interface that can get its own or parent's hash.
 type HashableBlock interface {
 	Hash() []byte
@@ -0,0 +1,201 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package keystore
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/hash"
+	"github.com/medibloc/go-medibloc/crypto/rand"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Parameters of scrypt used to encrypt key files.
+const (
+	StandardScryptN = 1 << 18
+	StandardScryptP = 1
+	LightScryptN    = 1 << 12
+	LightScryptP    = 6
+
+	scryptR     = 8
+	scryptDKLen = 32
+
+	keyFileVersion = 3
+	keyFileCipher  = "aes-128-ctr"
+	keyFileKDF     = "scrypt"
+)
+
+// Error types of key files.
+var (
+	ErrDecrypt                = errors.New("could not decrypt key with given passphrase")
+	ErrUnsupportedKeyFile     = errors.New("unsupported key file")
+	ErrKeyFileAddressMismatch = errors.New("address of key file does not match its key")
+)
+
+type keyFileJSON struct {
+	Address   string     `json:"address"`
+	Algorithm uint32     `json:"algorithm"`
+	Crypto    cryptoJSON `json:"crypto"`
+	Version   int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string           `json:"cipher"`
+	CipherText   string           `json:"ciphertext"`
+	CipherParams cipherParamsJSON `json:"cipherparams"`
+	KDF          string           `json:"kdf"`
+	KDFParams    kdfParamsJSON    `json:"kdfparams"`
+	MAC          string           `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+type kdfParamsJSON struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	Salt  string `json:"salt"`
+}
+
+// EncryptKey encrypts a private key with the passphrase into json of a key file.
+func EncryptKey(key signature.PrivateKey, passphrase string, scryptN, scryptP int) ([]byte, error) {
+	addr, err := common.PublicKeyToAddress(key.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	keyBytes, err := key.Encoded()
+	if err != nil {
+		return nil, err
+	}
+
+	salt := rand.GetEntropyCSPRNG(32)
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptDKLen)
+	if err != nil {
+		return nil, err
+	}
+	iv := rand.GetEntropyCSPRNG(16)
+	cipherText, err := crypto.AESCTRXOR(derivedKey[:16], keyBytes, iv)
+	if err != nil {
+		return nil, err
+	}
+	mac := hash.Sha3256(derivedKey[16:32], cipherText)
+
+	return json.Marshal(&keyFileJSON{
+		Address:   addr.Hex(),
+		Algorithm: uint32(key.Algorithm()),
+		Crypto: cryptoJSON{
+			Cipher:       keyFileCipher,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: cipherParamsJSON{IV: hex.EncodeToString(iv)},
+			KDF:          keyFileKDF,
+			KDFParams: kdfParamsJSON{
+				DKLen: scryptDKLen,
+				N:     scryptN,
+				R:     scryptR,
+				P:     scryptP,
+				Salt:  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(mac),
+		},
+		Version: keyFileVersion,
+	})
+}
+
+// DecryptKey decrypts json of a key file with the passphrase.
+func DecryptKey(keyJSON []byte, passphrase string) (signature.PrivateKey, error) {
+	kf := new(keyFileJSON)
+	if err := json.Unmarshal(keyJSON, kf); err != nil {
+		return nil, err
+	}
+	c := kf.Crypto
+	if kf.Version != keyFileVersion || c.Cipher != keyFileCipher || c.KDF != keyFileKDF {
+		return nil, ErrUnsupportedKeyFile
+	}
+
+	salt, err := hex.DecodeString(c.KDFParams.Salt)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := hex.DecodeString(c.CipherParams.IV)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := hex.DecodeString(c.CipherText)
+	if err != nil {
+		return nil, err
+	}
+	mac, err := hex.DecodeString(c.MAC)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, c.KDFParams.N, c.KDFParams.R, c.KDFParams.P, c.KDFParams.DKLen)
+	if err != nil {
+		return nil, err
+	}
+	if len(derivedKey) < 32 || !byteutils.Equal(hash.Sha3256(derivedKey[16:32], cipherText), mac) {
+		return nil, ErrDecrypt
+	}
+	keyBytes, err := crypto.AESCTRXOR(derivedKey[:16], cipherText, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := crypto.GenerateKey(algorithm.Algorithm(kf.Algorithm))
+	if err != nil {
+		return nil, err
+	}
+	if err := key.Decode(keyBytes); err != nil {
+		return nil, err
+	}
+	addr, err := common.PublicKeyToAddress(key.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	if !addr.Equals(common.HexToAddress(kf.Address)) {
+		return nil, ErrKeyFileAddressMismatch
+	}
+	return key, nil
+}
+
+// StoreKeyFile encrypts the key and writes it to the file.
+func StoreKeyFile(path string, key signature.PrivateKey, passphrase string, scryptN, scryptP int) error {
+	keyJSON, err := EncryptKey(key, passphrase, scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, keyJSON, 0600)
+}
+
+// LoadKeyFile reads the key file and decrypts it with the passphrase.
+func LoadKeyFile(path string, passphrase string) (signature.PrivateKey, error) {
+	keyJSON, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return DecryptKey(keyJSON, passphrase)
+}
@@ -16,6 +16,9 @@
 package keystore_test
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/medibloc/go-medibloc/crypto"
@@ -52,3 +55,34 @@ func TestKeyStore(t *testing.T) {
 		t.Errorf("HasAccount(%x) should've returned true after Delete", a)
 	}
 }
+
+func TestKeyFile(t *testing.T) {
+	key, err := crypto.GenerateKey(algorithm.SECP256K1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := ioutil.TempFile(os.TempDir(), "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	path := f.Name()
+	defer os.Remove(path)
+	if err := keystore.StoreKeyFile(path, key, "passphrase", veryLightScryptN, veryLightScryptP); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := keystore.LoadKeyFile(path, "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := key.Encoded()
+	got, _ := loaded.Encoded()
+	if !bytes.Equal(want, got) {
+		t.Errorf("loaded key %x, want %x", got, want)
+	}
+
+	if _, err := keystore.LoadKeyFile(path, "wrong"); err != keystore.ErrDecrypt {
+		t.Errorf("LoadKeyFile with wrong passphrase returned %v, want %v", err, keystore.ErrDecrypt)
+	}
+}
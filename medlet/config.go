@@ -97,6 +97,15 @@ func DefaultConfig() *medletpb.Config {
 			HttpModule:       nil,
 			ConnectionLimits: 0,
 		},
+		Sponsor: &medletpb.SponsorConfig{
+			Enabled:           false,
+			Address:           "",
+			AllowedOperations: nil,
+			QuotaPerUser:      0,
+			QuotaPeriod:       0,
+			Keyfile:           "",
+			PassphraseFile:    "",
+		},
 		Stats: &medletpb.StatsConfig{
 			EnableMetrics:   false,
 			ReportingModule: nil,
@@ -16,7 +16,7 @@
 package medlet
 
 import (
-	"github.com/medibloc/go-medibloc/consensus/dpos"
+	"github.com/medibloc/go-medibloc/consensus"
 	"github.com/medibloc/go-medibloc/core"
 	"github.com/medibloc/go-medibloc/core/pb"
 	"github.com/medibloc/go-medibloc/medlet/pb"
@@ -41,7 +41,7 @@ type Medlet struct {
 	storage            storage.Storage
 	blockManager       *core.BlockManager
 	transactionManager *core.TransactionManager
-	consensus          *dpos.Dpos
+	consensus          consensus.Engine
 	eventEmitter       *core.EventEmitter
 }
 
@@ -84,11 +84,12 @@ func New(cfg *medletpb.Config) (*Medlet, error) {
 
 	tm := core.NewTransactionManager(cfg)
 
-	consensus, err := dpos.New(cfg)
+	engine, err := consensus.New(cfg, genesis)
 	if err != nil {
 		logging.Console().WithFields(logrus.Fields{
-			"err": err,
-		}).Fatal("Failed to create dpos consensus.")
+			"engine": cfg.Chain.Consensus,
+			"err":    err,
+		}).Fatal("Failed to create consensus.")
 		return nil, err
 	}
 
@@ -100,7 +101,7 @@ func New(cfg *medletpb.Config) (*Medlet, error) {
 		storage:            stor,
 		blockManager:       bm,
 		transactionManager: tm,
-		consensus:          consensus,
+		consensus:          engine,
 	}, nil
 }
 
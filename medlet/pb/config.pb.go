@@ -13,6 +13,7 @@ It has these top-level messages:
 	NetworkConfig
 	ChainConfig
 	RPCConfig
+	SponsorConfig
 	AppConfig
 	PprofConfig
 	MiscConfig
@@ -55,7 +56,7 @@ func (x StatsConfig_ReportingModule) String() string {
 	return proto.EnumName(StatsConfig_ReportingModule_name, int32(x))
 }
 func (StatsConfig_ReportingModule) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorConfig, []int{8, 0}
+	return fileDescriptorConfig, []int{9, 0}
 }
 
 // Med global configurations.
@@ -68,6 +69,8 @@ type Config struct {
 	Chain *ChainConfig `protobuf:"bytes,3,opt,name=chain" json:"chain,omitempty"`
 	// RPC config.
 	Rpc *RPCConfig `protobuf:"bytes,4,opt,name=rpc" json:"rpc,omitempty"`
+	// Sponsor config.
+	Sponsor *SponsorConfig `protobuf:"bytes,5,opt,name=sponsor" json:"sponsor,omitempty"`
 	// Stats config.
 	Stats *StatsConfig `protobuf:"bytes,100,opt,name=stats" json:"stats,omitempty"`
 	// Misc config.
@@ -111,6 +114,13 @@ func (m *Config) GetRpc() *RPCConfig {
 	return nil
 }
 
+func (m *Config) GetSponsor() *SponsorConfig {
+	if m != nil {
+		return m.Sponsor
+	}
+	return nil
+}
+
 func (m *Config) GetStats() *StatsConfig {
 	if m != nil {
 		return m.Stats
@@ -244,6 +254,19 @@ type ChainConfig struct {
 	// TODO account manager
 	// Miner private key.
 	Privkey string `protobuf:"bytes,29,opt,name=privkey,proto3" json:"privkey,omitempty"`
+	// Consensus engine. ["dpos", "dev", "poa"] (default: "dpos")
+	Consensus string `protobuf:"bytes,30,opt,name=consensus,proto3" json:"consensus,omitempty"`
+	// Interval of blocks minted by dev engine, unit is ms. If 0, a block is minted as soon as transactions arrive.
+	DevBlockInterval int64 `protobuf:"varint,31,opt,name=dev_block_interval,json=devBlockInterval,proto3" json:"dev_block_interval,omitempty"`
+	// gRPC address of a remote signer holding the miner key. If set, privkey is not used to sign blocks.
+	RemoteSigner string `protobuf:"bytes,32,opt,name=remote_signer,json=remoteSigner,proto3" json:"remote_signer,omitempty"`
+	// Run as a hot standby of a miner with the same key. Minting starts only after the miner has missed
+	// this many of its slots in a row. If 0, the node is not a standby.
+	StandbyMissedSlots uint32 `protobuf:"varint,33,opt,name=standby_missed_slots,json=standbyMissedSlots,proto3" json:"standby_missed_slots,omitempty"`
+	// File containing the secret shared with the remote signer to authenticate requests. Required with remote_signer.
+	RemoteSignerSecretFile string `protobuf:"bytes,34,opt,name=remote_signer_secret_file,json=remoteSignerSecretFile,proto3" json:"remote_signer_secret_file,omitempty"`
+	// File of the TLS certificate which the remote signer must present, or of the CA issuing it. Required with remote_signer.
+	RemoteSignerCertFile string `protobuf:"bytes,35,opt,name=remote_signer_cert_file,json=remoteSignerCertFile,proto3" json:"remote_signer_cert_file,omitempty"`
 }
 
 func (m *ChainConfig) Reset()                    { *m = ChainConfig{} }
@@ -335,6 +358,48 @@ func (m *ChainConfig) GetPrivkey() string {
 	return ""
 }
 
+func (m *ChainConfig) GetConsensus() string {
+	if m != nil {
+		return m.Consensus
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetDevBlockInterval() int64 {
+	if m != nil {
+		return m.DevBlockInterval
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetRemoteSigner() string {
+	if m != nil {
+		return m.RemoteSigner
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetStandbyMissedSlots() uint32 {
+	if m != nil {
+		return m.StandbyMissedSlots
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetRemoteSignerSecretFile() string {
+	if m != nil {
+		return m.RemoteSignerSecretFile
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetRemoteSignerCertFile() string {
+	if m != nil {
+		return m.RemoteSignerCertFile
+	}
+	return ""
+}
+
 type RPCConfig struct {
 	// RPC listen addresses.
 	RpcListen []string `protobuf:"bytes,1,rep,name=rpc_listen,json=rpcListen" json:"rpc_listen,omitempty"`
@@ -379,6 +444,78 @@ func (m *RPCConfig) GetConnectionLimits() int32 {
 	return 0
 }
 
+type SponsorConfig struct {
+	// Sign transactions requested for sponsorship as payer.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Sponsor(payer) address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Allowed transaction operations. If empty, no operation is sponsored.
+	AllowedOperations []string `protobuf:"bytes,4,rep,name=allowed_operations,json=allowedOperations" json:"allowed_operations,omitempty"`
+	// Maximum number of sponsored transactions per sender address in a quota period. If 0, unlimited.
+	// Usage is kept in memory only, so it is reset when the node restarts.
+	QuotaPerUser uint32 `protobuf:"varint,5,opt,name=quota_per_user,json=quotaPerUser,proto3" json:"quota_per_user,omitempty"`
+	// Quota period, unit is s.
+	QuotaPeriod int64 `protobuf:"varint,6,opt,name=quota_period,json=quotaPeriod,proto3" json:"quota_period,omitempty"`
+	// Encrypted key file of the sponsor.
+	Keyfile string `protobuf:"bytes,7,opt,name=keyfile,proto3" json:"keyfile,omitempty"`
+	// File containing the passphrase of the key file.
+	PassphraseFile string `protobuf:"bytes,8,opt,name=passphrase_file,json=passphraseFile,proto3" json:"passphrase_file,omitempty"`
+}
+
+func (m *SponsorConfig) Reset()                    { *m = SponsorConfig{} }
+func (m *SponsorConfig) String() string            { return proto.CompactTextString(m) }
+func (*SponsorConfig) ProtoMessage()               {}
+func (*SponsorConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{5} }
+
+func (m *SponsorConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *SponsorConfig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SponsorConfig) GetAllowedOperations() []string {
+	if m != nil {
+		return m.AllowedOperations
+	}
+	return nil
+}
+
+func (m *SponsorConfig) GetQuotaPerUser() uint32 {
+	if m != nil {
+		return m.QuotaPerUser
+	}
+	return 0
+}
+
+func (m *SponsorConfig) GetQuotaPeriod() int64 {
+	if m != nil {
+		return m.QuotaPeriod
+	}
+	return 0
+}
+
+func (m *SponsorConfig) GetKeyfile() string {
+	if m != nil {
+		return m.Keyfile
+	}
+	return ""
+}
+
+func (m *SponsorConfig) GetPassphraseFile() string {
+	if m != nil {
+		return m.PassphraseFile
+	}
+	return ""
+}
+
 type AppConfig struct {
 	// log level
 	LogLevel string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
@@ -395,7 +532,7 @@ type AppConfig struct {
 func (m *AppConfig) Reset()                    { *m = AppConfig{} }
 func (m *AppConfig) String() string            { return proto.CompactTextString(m) }
 func (*AppConfig) ProtoMessage()               {}
-func (*AppConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{5} }
+func (*AppConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{6} }
 
 func (m *AppConfig) GetLogLevel() string {
 	if m != nil {
@@ -444,7 +581,7 @@ type PprofConfig struct {
 func (m *PprofConfig) Reset()                    { *m = PprofConfig{} }
 func (m *PprofConfig) String() string            { return proto.CompactTextString(m) }
 func (*PprofConfig) ProtoMessage()               {}
-func (*PprofConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{6} }
+func (*PprofConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{7} }
 
 func (m *PprofConfig) GetHttpListen() string {
 	if m != nil {
@@ -475,7 +612,7 @@ type MiscConfig struct {
 func (m *MiscConfig) Reset()                    { *m = MiscConfig{} }
 func (m *MiscConfig) String() string            { return proto.CompactTextString(m) }
 func (*MiscConfig) ProtoMessage()               {}
-func (*MiscConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{7} }
+func (*MiscConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{8} }
 
 func (m *MiscConfig) GetDefaultKeystoreFileCiper() string {
 	if m != nil {
@@ -496,7 +633,7 @@ type StatsConfig struct {
 func (m *StatsConfig) Reset()                    { *m = StatsConfig{} }
 func (m *StatsConfig) String() string            { return proto.CompactTextString(m) }
 func (*StatsConfig) ProtoMessage()               {}
-func (*StatsConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{8} }
+func (*StatsConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{9} }
 
 func (m *StatsConfig) GetEnableMetrics() bool {
 	if m != nil {
@@ -542,7 +679,7 @@ type InfluxdbConfig struct {
 func (m *InfluxdbConfig) Reset()                    { *m = InfluxdbConfig{} }
 func (m *InfluxdbConfig) String() string            { return proto.CompactTextString(m) }
 func (*InfluxdbConfig) ProtoMessage()               {}
-func (*InfluxdbConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{9} }
+func (*InfluxdbConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{10} }
 
 func (m *InfluxdbConfig) GetHost() string {
 	if m != nil {
@@ -597,7 +734,7 @@ type SyncConfig struct {
 func (m *SyncConfig) Reset()                    { *m = SyncConfig{} }
 func (m *SyncConfig) String() string            { return proto.CompactTextString(m) }
 func (*SyncConfig) ProtoMessage()               {}
-func (*SyncConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{10} }
+func (*SyncConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{11} }
 
 func (m *SyncConfig) GetSeedingMinChunkSize() uint64 {
 	if m != nil {
@@ -647,6 +784,7 @@ func init() {
 	proto.RegisterType((*NetworkConfig)(nil), "medletpb.NetworkConfig")
 	proto.RegisterType((*ChainConfig)(nil), "medletpb.ChainConfig")
 	proto.RegisterType((*RPCConfig)(nil), "medletpb.RPCConfig")
+	proto.RegisterType((*SponsorConfig)(nil), "medletpb.SponsorConfig")
 	proto.RegisterType((*AppConfig)(nil), "medletpb.AppConfig")
 	proto.RegisterType((*PprofConfig)(nil), "medletpb.PprofConfig")
 	proto.RegisterType((*MiscConfig)(nil), "medletpb.MiscConfig")
@@ -659,74 +797,90 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 1095 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x96, 0xcf, 0x6e, 0x1b, 0xb7,
-	0x13, 0xc7, 0x7f, 0x92, 0x65, 0x5b, 0x3b, 0xb2, 0x1d, 0x87, 0x4e, 0x9c, 0x75, 0xfe, 0xff, 0x16,
-	0x48, 0xe1, 0x22, 0x80, 0x81, 0x3a, 0xbd, 0xf4, 0x50, 0x14, 0xa9, 0x80, 0x16, 0x46, 0xec, 0xc2,
-	0xd8, 0xe4, 0xbe, 0xa0, 0x76, 0xe9, 0x15, 0xa1, 0x15, 0x49, 0x90, 0x94, 0x63, 0xe7, 0xd4, 0xd7,
-	0xe8, 0xb9, 0xe8, 0x73, 0xf4, 0xda, 0x17, 0x29, 0xd0, 0xc7, 0x28, 0x66, 0x96, 0xfb, 0x47, 0x42,
-	0x6f, 0xe4, 0x77, 0x3e, 0x33, 0xc3, 0x1d, 0x0e, 0x47, 0x82, 0xbd, 0x5c, 0xab, 0x1b, 0x59, 0x9e,
-	0x19, 0xab, 0xbd, 0x66, 0xe3, 0xa5, 0x28, 0x2a, 0xe1, 0xcd, 0x2c, 0xf9, 0x67, 0x08, 0x3b, 0x53,
-	0x32, 0xb1, 0x33, 0xd8, 0x29, 0x2b, 0x3d, 0xe3, 0x55, 0x3c, 0x78, 0x3d, 0x38, 0x9d, 0x9c, 0x1f,
-	0x9f, 0x35, 0xd4, 0xd9, 0xcf, 0xa4, 0xd7, 0x5c, 0x1a, 0x28, 0xf6, 0x0d, 0xec, 0x2a, 0xe1, 0x3f,
-	0x6b, 0xbb, 0x88, 0x87, 0xe4, 0xf0, 0xa4, 0x73, 0xf8, 0xa5, 0x36, 0x04, 0x8f, 0x86, 0x63, 0x6f,
-	0x61, 0x3b, 0x9f, 0x73, 0xa9, 0xe2, 0x2d, 0x72, 0x78, 0xdc, 0x39, 0x4c, 0x51, 0x0e, 0x78, 0xcd,
-	0xb0, 0x37, 0xb0, 0x65, 0x4d, 0x1e, 0x8f, 0x08, 0x3d, 0xea, 0xd0, 0xf4, 0x7a, 0x1a, 0x40, 0xb4,
-	0x63, 0x4c, 0xe7, 0xb9, 0x77, 0x71, 0xb1, 0x19, 0xf3, 0x23, 0xca, 0x4d, 0x4c, 0x62, 0xd8, 0x29,
-	0x8c, 0x96, 0xd2, 0xe5, 0xb1, 0x20, 0xf6, 0x51, 0xc7, 0x5e, 0x49, 0x97, 0x07, 0x94, 0x08, 0xcc,
-	0xce, 0x8d, 0x89, 0x6f, 0x36, 0xb3, 0xbf, 0x37, 0xa6, 0xc9, 0xce, 0x8d, 0x61, 0x5f, 0xc3, 0xc8,
-	0xdd, 0xab, 0x3c, 0xfe, 0x6b, 0xb0, 0x19, 0xf1, 0xe3, 0xbd, 0x6a, 0x23, 0x22, 0x92, 0x4c, 0x61,
-	0xaf, 0x5f, 0x47, 0x76, 0x02, 0x63, 0xfa, 0xd0, 0x4c, 0x16, 0x54, 0xf1, 0xfd, 0x74, 0x97, 0xf6,
-	0x17, 0x05, 0x8b, 0x61, 0xb7, 0xe0, 0x9e, 0x17, 0xd2, 0xc6, 0x93, 0xd7, 0x83, 0xd3, 0x28, 0x6d,
-	0xb6, 0xc9, 0x9f, 0x03, 0xd8, 0x5f, 0x2b, 0x2e, 0x63, 0x30, 0x72, 0x42, 0x60, 0x88, 0xad, 0xd3,
-	0x28, 0xa5, 0x35, 0x3b, 0x86, 0x9d, 0x4a, 0x3a, 0x2f, 0x54, 0x3c, 0x24, 0x35, 0xec, 0xd8, 0x2b,
-	0x98, 0x18, 0x2b, 0x6f, 0xb9, 0x17, 0xd9, 0x42, 0xdc, 0xd3, 0x2d, 0x44, 0x29, 0x04, 0xe9, 0x83,
-	0xb8, 0x67, 0x2f, 0x00, 0xc2, 0x5d, 0xe1, 0xa9, 0x46, 0x74, 0xaa, 0x28, 0x28, 0x17, 0x05, 0xfb,
-	0x11, 0x5e, 0x5a, 0xbd, 0xf2, 0x22, 0xf3, 0x7c, 0x56, 0x89, 0x0c, 0x3f, 0x2b, 0xab, 0xb4, 0x36,
-	0x99, 0x54, 0x5e, 0xd8, 0x5b, 0x5e, 0xc5, 0xdb, 0xe4, 0xf2, 0x94, 0xa8, 0x4f, 0x08, 0x61, 0x19,
-	0x2e, 0xb5, 0x36, 0x17, 0x81, 0x48, 0xfe, 0xd8, 0x82, 0x49, 0xef, 0xb6, 0xf1, 0x5b, 0x4b, 0xa1,
-	0x84, 0x93, 0x8e, 0xda, 0x28, 0x4a, 0x9b, 0x2d, 0x7e, 0xc5, 0x42, 0xdc, 0x63, 0x11, 0xf6, 0xc8,
-	0x10, 0x76, 0x78, 0x48, 0xe7, 0xb9, 0xf5, 0xd9, 0x52, 0x2a, 0x11, 0x3f, 0x7a, 0x3d, 0x38, 0x1d,
-	0xa7, 0x11, 0x29, 0x57, 0x52, 0x09, 0xf6, 0x14, 0xc6, 0xb9, 0x96, 0x6a, 0xc6, 0x9d, 0x88, 0x1f,
-	0x93, 0x63, 0xbb, 0x67, 0x8f, 0x60, 0x1b, 0x9d, 0x6c, 0x7c, 0x4c, 0x86, 0x7a, 0xc3, 0x5e, 0x02,
-	0x18, 0xee, 0x9c, 0x99, 0x5b, 0xf4, 0x79, 0x12, 0xaa, 0xd2, 0x2a, 0xec, 0x2d, 0x3c, 0x74, 0xb2,
-	0x54, 0xdc, 0xaf, 0xac, 0xc8, 0x72, 0x69, 0xe6, 0xc2, 0xba, 0x38, 0xa6, 0xca, 0x1e, 0xb6, 0x86,
-	0x69, 0xad, 0xb3, 0x53, 0x38, 0x9c, 0x55, 0x3a, 0x5f, 0x64, 0x39, 0xcf, 0xe7, 0x22, 0x73, 0xf2,
-	0x8b, 0x88, 0x4f, 0xa8, 0x2a, 0x07, 0xa4, 0x4f, 0x51, 0xfe, 0x28, 0xbf, 0x08, 0xf6, 0x15, 0x3c,
-	0xf0, 0x5c, 0x56, 0x7d, 0xf0, 0x29, 0x81, 0xfb, 0x28, 0xaf, 0x71, 0x75, 0x44, 0xa3, 0x75, 0x55,
-	0x73, 0xcf, 0x6a, 0x8e, 0xe4, 0x6b, 0xad, 0x2b, 0xe2, 0xce, 0xe1, 0xb1, 0xb7, 0x5c, 0x39, 0x9e,
-	0x7b, 0xa9, 0x55, 0x8f, 0x7e, 0x4e, 0xf4, 0x51, 0xcf, 0xd8, 0xfa, 0xc4, 0xb0, 0x8b, 0xd7, 0x8f,
-	0xdd, 0xf0, 0xa2, 0xae, 0x7e, 0xd8, 0x26, 0xbf, 0x0d, 0x20, 0x6a, 0x9f, 0x1a, 0xd6, 0xdc, 0x9a,
-	0x3c, 0x0b, 0x5d, 0x55, 0xf7, 0x5a, 0x64, 0x4d, 0x7e, 0xd9, 0x36, 0xd6, 0xdc, 0x7b, 0x93, 0xad,
-	0x75, 0x1d, 0xa0, 0xb4, 0x01, 0x2c, 0x75, 0xb1, 0xaa, 0x44, 0xbc, 0xd5, 0x01, 0x57, 0xa4, 0x60,
-	0x8d, 0x73, 0xad, 0x94, 0xa8, 0xcf, 0x5e, 0xc9, 0xa5, 0xf4, 0x8e, 0x1a, 0x70, 0x3b, 0x3d, 0xec,
-	0x0c, 0x97, 0xa4, 0x27, 0xbf, 0x0f, 0x20, 0x6a, 0x1f, 0x22, 0x7b, 0x06, 0x51, 0xa5, 0xcb, 0xac,
-	0x12, 0xb7, 0xa2, 0x9e, 0x5d, 0x51, 0x3a, 0xae, 0x74, 0x79, 0x89, 0x7b, 0x7c, 0x65, 0x68, 0xbc,
-	0x91, 0x95, 0x68, 0xfa, 0xab, 0xd2, 0xe5, 0x4f, 0xb2, 0x12, 0xec, 0x09, 0xe0, 0x32, 0xe3, 0xa5,
-	0xa0, 0x97, 0xb0, 0x9f, 0xee, 0x54, 0xba, 0x7c, 0x5f, 0xe2, 0x59, 0xb6, 0x8d, 0xb1, 0xfa, 0x26,
-	0xcc, 0x9e, 0xde, 0x48, 0xb9, 0x46, 0xb9, 0x19, 0x29, 0xc4, 0x60, 0x05, 0x6f, 0x85, 0x75, 0x52,
-	0x2b, 0x9a, 0x40, 0x51, 0xda, 0x6c, 0x13, 0x05, 0x93, 0x1e, 0xbf, 0x59, 0xa3, 0xfa, 0xa0, 0xfd,
-	0x1a, 0xbd, 0x04, 0xc8, 0xcd, 0x0a, 0x3d, 0xba, 0xc3, 0xf6, 0x14, 0xb4, 0x2f, 0xc5, 0xb2, 0xb1,
-	0x87, 0xc7, 0xdb, 0x29, 0xc9, 0x07, 0x80, 0x6e, 0x8c, 0xb1, 0xef, 0xe1, 0x59, 0x21, 0x6e, 0xf8,
-	0xaa, 0xf2, 0xf8, 0xd6, 0x9d, 0xd7, 0x56, 0x50, 0x15, 0xb0, 0x81, 0x85, 0x0d, 0xe9, 0xe3, 0x80,
-	0x7c, 0x08, 0x04, 0xd6, 0x65, 0x8a, 0xf6, 0xe4, 0xd7, 0x21, 0x4c, 0x7a, 0x03, 0x94, 0xbd, 0x81,
-	0x03, 0xa1, 0xe8, 0xd5, 0x2f, 0x85, 0xb7, 0x32, 0x77, 0x14, 0x61, 0x9c, 0xee, 0xd7, 0xea, 0x55,
-	0x2d, 0xb2, 0x6b, 0x38, 0xb4, 0xc2, 0x68, 0xeb, 0xa5, 0x2a, 0x9b, 0xcb, 0xc6, 0x6e, 0x38, 0x38,
-	0x7f, 0xf3, 0x9f, 0x83, 0xf9, 0x2c, 0x6d, 0xe8, 0xba, 0x0f, 0xd2, 0x07, 0x76, 0x5d, 0x60, 0xdf,
-	0xc2, 0x58, 0xaa, 0x9b, 0x6a, 0x75, 0x57, 0xcc, 0x68, 0x18, 0x4e, 0xce, 0xe3, 0x2e, 0xd2, 0x45,
-	0xb0, 0x84, 0x2b, 0x69, 0x49, 0xf6, 0x7f, 0xd8, 0x0b, 0xe7, 0xcc, 0x3c, 0x2f, 0x5d, 0xbc, 0x47,
-	0x0d, 0x37, 0x09, 0xda, 0x27, 0x5e, 0xba, 0xe4, 0x15, 0x3c, 0xd8, 0x48, 0xce, 0xf6, 0x60, 0xdc,
-	0x44, 0x3c, 0xfc, 0x5f, 0x72, 0x07, 0x07, 0xeb, 0xf1, 0x71, 0xd6, 0xce, 0xb5, 0xf3, 0xa1, 0x78,
-	0xb4, 0x46, 0x0d, 0x83, 0xd0, 0x7d, 0xed, 0xa7, 0xb4, 0x66, 0x07, 0x30, 0x2c, 0x66, 0xe1, 0x86,
-	0x86, 0xc5, 0x0c, 0x99, 0x95, 0x13, 0x96, 0xfa, 0x29, 0x4a, 0x69, 0x8d, 0x63, 0x0a, 0x47, 0xcc,
-	0x67, 0x6d, 0x0b, 0x9a, 0x9a, 0x51, 0xda, 0xee, 0x93, 0xbf, 0x87, 0x00, 0xdd, 0xef, 0x07, 0x7b,
-	0x07, 0xc7, 0x38, 0xd6, 0xa9, 0xa4, 0x52, 0x65, 0xf9, 0x7c, 0xa5, 0x16, 0xf5, 0xcb, 0xc6, 0x83,
-	0x8c, 0xd2, 0xa3, 0x60, 0xbd, 0x92, 0x6a, 0x8a, 0x36, 0x7a, 0xd9, 0x7d, 0x27, 0x7e, 0xd7, 0x77,
-	0x1a, 0xae, 0x3b, 0xf1, 0xbb, 0xce, 0xe9, 0x07, 0x78, 0xbe, 0xe6, 0xa4, 0x55, 0xbe, 0xb2, 0x56,
-	0x28, 0x9f, 0x19, 0x81, 0x43, 0xaf, 0x7e, 0x27, 0x27, 0x3d, 0xd7, 0x96, 0xb8, 0x46, 0x80, 0x9d,
-	0xc1, 0x51, 0xa1, 0x3f, 0xab, 0x4a, 0xf3, 0xa2, 0x9f, 0x72, 0x44, 0x29, 0x1f, 0x36, 0xa6, 0x2e,
-	0xe1, 0x7b, 0x78, 0xd1, 0xf2, 0x1b, 0x19, 0x3d, 0x77, 0x0b, 0xd7, 0xfc, 0xa0, 0x34, 0xd0, 0x5a,
-	0xca, 0x4f, 0x48, 0xb0, 0xef, 0xe0, 0x64, 0x23, 0x65, 0x6f, 0xa0, 0xee, 0x50, 0xe2, 0xe3, 0xb5,
-	0xc4, 0xed, 0x64, 0x9d, 0xed, 0xd0, 0xdf, 0xa1, 0x77, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf0,
-	0x0c, 0x69, 0xd5, 0x1e, 0x09, 0x00, 0x00,
+	// 1359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x56, 0x4d, 0x73, 0x1b, 0x45,
+	0x13, 0x7e, 0x25, 0xcb, 0xb6, 0xd4, 0x92, 0x6c, 0x67, 0xec, 0xd8, 0xeb, 0x7c, 0x38, 0x8e, 0xf2,
+	0xe6, 0x7d, 0x4d, 0x05, 0x5c, 0xe0, 0xc0, 0x81, 0x03, 0x45, 0x25, 0xaa, 0x82, 0x32, 0xb1, 0xc1,
+	0xb5, 0x0e, 0xe7, 0xad, 0xd1, 0xee, 0x78, 0x3d, 0xe5, 0xd5, 0xcc, 0x32, 0x33, 0xb2, 0xad, 0x9c,
+	0x38, 0xf0, 0x27, 0x28, 0x8e, 0xfc, 0x0b, 0x2e, 0x5c, 0xf9, 0x23, 0xfc, 0x0f, 0xaa, 0x7b, 0x67,
+	0x3f, 0xa4, 0xe2, 0xa6, 0x7e, 0x9e, 0xa7, 0xa7, 0x7b, 0xbb, 0x7b, 0xa6, 0x05, 0x83, 0x58, 0xab,
+	0x2b, 0x99, 0x1e, 0xe7, 0x46, 0x3b, 0xcd, 0xba, 0x53, 0x91, 0x64, 0xc2, 0xe5, 0x93, 0xd1, 0x6f,
+	0x2b, 0xb0, 0x36, 0x26, 0x8a, 0x1d, 0xc3, 0x5a, 0x9a, 0xe9, 0x09, 0xcf, 0x82, 0xd6, 0x61, 0xeb,
+	0xa8, 0x7f, 0xb2, 0x7b, 0x5c, 0xaa, 0x8e, 0xbf, 0x25, 0xbc, 0xd0, 0x85, 0x5e, 0xc5, 0x3e, 0x83,
+	0x75, 0x25, 0xdc, 0x9d, 0x36, 0x37, 0x41, 0x9b, 0x1c, 0xf6, 0x6a, 0x87, 0xef, 0x0b, 0xc2, 0x7b,
+	0x94, 0x3a, 0xf6, 0x0a, 0x56, 0xe3, 0x6b, 0x2e, 0x55, 0xb0, 0x42, 0x0e, 0x0f, 0x6b, 0x87, 0x31,
+	0xc2, 0x5e, 0x5e, 0x68, 0xd8, 0x4b, 0x58, 0x31, 0x79, 0x1c, 0x74, 0x48, 0xba, 0x5d, 0x4b, 0xc3,
+	0x8b, 0xb1, 0x17, 0x22, 0x8f, 0x69, 0xd8, 0x5c, 0x2b, 0xab, 0x4d, 0xb0, 0xba, 0x9c, 0xc6, 0x65,
+	0x41, 0x94, 0x69, 0x78, 0x1d, 0xa6, 0x61, 0x1d, 0x77, 0x36, 0x48, 0x96, 0xd3, 0xb8, 0x44, 0xb8,
+	0x4c, 0x83, 0x34, 0xec, 0x08, 0x3a, 0x53, 0x69, 0xe3, 0x40, 0x90, 0x76, 0xa7, 0xd6, 0x9e, 0x4b,
+	0x1b, 0x7b, 0x29, 0x29, 0x30, 0x61, 0x9e, 0xe7, 0xc1, 0xd5, 0x72, 0xc2, 0x6f, 0xf2, 0xbc, 0x4c,
+	0x98, 0xe7, 0x39, 0xfb, 0x08, 0x3a, 0x76, 0xae, 0xe2, 0xe0, 0xaf, 0xd6, 0xf2, 0x89, 0x97, 0x73,
+	0x55, 0x9d, 0x88, 0x92, 0xd1, 0x18, 0x06, 0xcd, 0xd2, 0xb3, 0x7d, 0xe8, 0x52, 0x6d, 0x22, 0x99,
+	0x50, 0x93, 0x86, 0xe1, 0x3a, 0xd9, 0xa7, 0x09, 0x0b, 0x60, 0x3d, 0xe1, 0x8e, 0x27, 0xd2, 0x04,
+	0xfd, 0xc3, 0xd6, 0x51, 0x2f, 0x2c, 0xcd, 0xd1, 0x9f, 0x2d, 0x18, 0x2e, 0xf4, 0x83, 0x31, 0xe8,
+	0x58, 0x21, 0xf0, 0x88, 0x95, 0xa3, 0x5e, 0x48, 0xbf, 0xd9, 0x2e, 0xac, 0x65, 0xd2, 0x3a, 0xa1,
+	0x82, 0x36, 0xa1, 0xde, 0x62, 0xcf, 0xa0, 0x9f, 0x1b, 0x79, 0xcb, 0x9d, 0x88, 0x6e, 0xc4, 0x9c,
+	0x1a, 0xd7, 0x0b, 0xc1, 0x43, 0xef, 0xc4, 0x9c, 0x3d, 0x05, 0xf0, 0xed, 0xc5, 0xac, 0x3a, 0x94,
+	0x55, 0xcf, 0x23, 0xa7, 0x09, 0x7b, 0x0b, 0x07, 0x46, 0xcf, 0x9c, 0x88, 0x1c, 0x9f, 0x64, 0x22,
+	0xc2, 0xcf, 0x8a, 0x32, 0xad, 0xf3, 0x48, 0x2a, 0x27, 0xcc, 0x2d, 0xcf, 0xa8, 0x6b, 0xc3, 0xf0,
+	0x11, 0xa9, 0xde, 0xa3, 0x08, 0xcb, 0x70, 0xa6, 0x75, 0x7e, 0xea, 0x15, 0xa3, 0x3f, 0x56, 0xa1,
+	0xdf, 0x18, 0x10, 0xfc, 0xd6, 0x54, 0x28, 0x61, 0xa5, 0xa5, 0xc9, 0xeb, 0x85, 0xa5, 0x89, 0x5f,
+	0x71, 0x23, 0xe6, 0x58, 0x84, 0x01, 0x11, 0xde, 0xc2, 0x24, 0xad, 0xe3, 0xc6, 0x45, 0x53, 0xa9,
+	0x44, 0xb0, 0x73, 0xd8, 0x3a, 0xea, 0x86, 0x3d, 0x42, 0xce, 0xa5, 0x12, 0xec, 0x11, 0x74, 0x63,
+	0x2d, 0xd5, 0x84, 0x5b, 0x11, 0x3c, 0x24, 0xc7, 0xca, 0x66, 0x3b, 0xb0, 0x8a, 0x4e, 0x26, 0xd8,
+	0x25, 0xa2, 0x30, 0xd8, 0x01, 0x40, 0xce, 0xad, 0xcd, 0xaf, 0x0d, 0xfa, 0xec, 0xf9, 0xaa, 0x54,
+	0x08, 0x7b, 0x05, 0x0f, 0xac, 0x4c, 0x15, 0x77, 0x33, 0x23, 0xa2, 0x58, 0xe6, 0xd7, 0xc2, 0xd8,
+	0x20, 0xa0, 0xca, 0x6e, 0x55, 0xc4, 0xb8, 0xc0, 0xd9, 0x11, 0x6c, 0x4d, 0x32, 0x1d, 0xdf, 0x44,
+	0x31, 0x8f, 0xaf, 0x45, 0x64, 0xe5, 0x07, 0x11, 0xec, 0x53, 0x55, 0x36, 0x08, 0x1f, 0x23, 0x7c,
+	0x29, 0x3f, 0x08, 0xf6, 0x3f, 0xd8, 0x74, 0x5c, 0x66, 0x4d, 0xe1, 0x23, 0x12, 0x0e, 0x11, 0x5e,
+	0xd0, 0x15, 0x27, 0xe6, 0x5a, 0x67, 0x85, 0xee, 0x71, 0xa1, 0x23, 0xf8, 0x42, 0xeb, 0x8c, 0x74,
+	0x27, 0xf0, 0xd0, 0x19, 0xae, 0x2c, 0x8f, 0x9d, 0xd4, 0xaa, 0xa1, 0x7e, 0x42, 0xea, 0xed, 0x06,
+	0x59, 0xf9, 0x04, 0xb0, 0x8e, 0xed, 0xc7, 0x69, 0x78, 0x5a, 0x54, 0xdf, 0x9b, 0xec, 0x09, 0xf4,
+	0x62, 0xad, 0xac, 0x50, 0x76, 0x66, 0x83, 0x03, 0xe2, 0x6a, 0x80, 0x7d, 0x0c, 0x2c, 0x11, 0xb7,
+	0x51, 0x91, 0x57, 0xd5, 0xfd, 0x67, 0x87, 0xad, 0xa3, 0x95, 0x70, 0x2b, 0x11, 0xb7, 0x6f, 0x91,
+	0x28, 0x7b, 0xce, 0x5e, 0xc0, 0xd0, 0x88, 0xa9, 0x76, 0xf8, 0x95, 0x29, 0x96, 0xff, 0x90, 0xce,
+	0x1b, 0x14, 0xe0, 0x25, 0x61, 0xec, 0x53, 0xd8, 0xb1, 0x8e, 0xab, 0x64, 0x32, 0x8f, 0xa6, 0xd2,
+	0x5a, 0x91, 0x44, 0x36, 0xd3, 0xce, 0x06, 0xcf, 0x29, 0x7b, 0xe6, 0xb9, 0x73, 0xa2, 0x2e, 0x91,
+	0x61, 0x5f, 0xc2, 0xfe, 0xc2, 0xb1, 0x91, 0x15, 0xb1, 0x11, 0x2e, 0xba, 0x92, 0x99, 0x08, 0x46,
+	0x14, 0x62, 0xb7, 0x19, 0xe2, 0x92, 0xe8, 0x6f, 0x64, 0x26, 0xd8, 0x17, 0xb0, 0xb7, 0xe8, 0x1a,
+	0x0b, 0xe3, 0x1d, 0x5f, 0x90, 0xe3, 0x4e, 0xd3, 0x71, 0x2c, 0x0c, 0xb9, 0x8d, 0x7e, 0x6d, 0x41,
+	0xaf, 0x7a, 0xb2, 0x70, 0x10, 0x4d, 0x1e, 0x47, 0xfe, 0xaa, 0x15, 0x17, 0xb0, 0x67, 0xf2, 0xf8,
+	0xac, 0xba, 0x6d, 0xd7, 0xce, 0xe5, 0xd1, 0xc2, 0x55, 0x04, 0x84, 0x96, 0x04, 0x53, 0x9d, 0xcc,
+	0x32, 0x11, 0xac, 0xd4, 0x82, 0x73, 0x42, 0x70, 0xf0, 0x62, 0xad, 0x94, 0x28, 0x1a, 0x9a, 0xc9,
+	0xa9, 0x74, 0x96, 0x6e, 0xe5, 0x6a, 0xb8, 0x55, 0x13, 0x67, 0x84, 0x8f, 0x7e, 0x69, 0xc3, 0x70,
+	0xe1, 0x8d, 0xc4, 0xe6, 0x0a, 0x85, 0x97, 0xb0, 0x78, 0x60, 0xba, 0x61, 0x69, 0x22, 0xc3, 0x93,
+	0xc4, 0x08, 0x5b, 0x5d, 0x3a, 0x6f, 0xb2, 0x4f, 0x80, 0xf1, 0x2c, 0xd3, 0x77, 0x22, 0x89, 0x74,
+	0x2e, 0x0c, 0xc7, 0x00, 0x18, 0x13, 0x53, 0x7b, 0xe0, 0x99, 0x1f, 0x2a, 0x82, 0xfd, 0x17, 0x36,
+	0x7e, 0x9a, 0x69, 0xc7, 0xa3, 0x5c, 0x98, 0x68, 0x66, 0x85, 0xf1, 0x2f, 0xc0, 0x80, 0xd0, 0x0b,
+	0x61, 0x7e, 0xb4, 0xc2, 0xb0, 0xe7, 0x30, 0xa8, 0x54, 0x52, 0x27, 0xc1, 0x1a, 0xcd, 0x49, 0xbf,
+	0xd4, 0x48, 0x4d, 0x19, 0xdd, 0x88, 0x39, 0x35, 0x60, 0xbd, 0xc8, 0xc8, 0x9b, 0xec, 0xff, 0xb0,
+	0x59, 0xdf, 0xc5, 0xa2, 0x45, 0x5d, 0x52, 0x6c, 0xd4, 0x30, 0x36, 0xe7, 0xbb, 0x4e, 0x77, 0x65,
+	0xab, 0x33, 0xfa, 0xbd, 0x05, 0xbd, 0xea, 0x91, 0x66, 0x8f, 0xa1, 0x97, 0xe9, 0x34, 0xca, 0xc4,
+	0xad, 0x28, 0x56, 0x61, 0x2f, 0xec, 0x66, 0x3a, 0x3d, 0x43, 0x1b, 0x5f, 0x60, 0x24, 0xe9, 0x48,
+	0x5f, 0x86, 0x4c, 0xa7, 0x34, 0x1f, 0x7b, 0x80, 0x3f, 0x23, 0x9e, 0x0a, 0x7a, 0x25, 0x87, 0xe1,
+	0x5a, 0xa6, 0xd3, 0x37, 0x29, 0xb6, 0x64, 0x35, 0xcf, 0x8d, 0xbe, 0x0a, 0x3a, 0xcb, 0xeb, 0xe6,
+	0x02, 0xe1, 0x72, 0xdd, 0x90, 0x06, 0x3f, 0xea, 0x56, 0x18, 0x2b, 0xb5, 0xa2, 0xed, 0xd4, 0x0b,
+	0x4b, 0x73, 0xa4, 0xa0, 0xdf, 0xd0, 0x2f, 0x8f, 0x4a, 0x91, 0x68, 0x73, 0x54, 0x0e, 0x00, 0xe2,
+	0x7c, 0x86, 0x1e, 0x75, 0xb2, 0x0d, 0x04, 0xf9, 0xa9, 0x98, 0x96, 0xbc, 0x7f, 0xd8, 0x6b, 0x64,
+	0xf4, 0x0e, 0xa0, 0x5e, 0x71, 0xec, 0x2b, 0x78, 0x9c, 0x88, 0x2b, 0x3e, 0xcb, 0x1c, 0xee, 0x01,
+	0xeb, 0xb4, 0x29, 0x0a, 0x8b, 0x8f, 0x9b, 0x30, 0x3e, 0x7c, 0xe0, 0x25, 0xef, 0xbc, 0x02, 0xeb,
+	0x32, 0x46, 0x7e, 0xf4, 0x73, 0x1b, 0xfa, 0x8d, 0xe5, 0xca, 0x5e, 0xc2, 0x46, 0x31, 0x58, 0xd1,
+	0x54, 0x38, 0x23, 0x63, 0xeb, 0xc7, 0x6d, 0x58, 0xa0, 0xe7, 0x05, 0xc8, 0x2e, 0x60, 0xcb, 0x88,
+	0x5c, 0x1b, 0x27, 0x55, 0x5a, 0xce, 0x3c, 0x5e, 0x8a, 0x8d, 0x93, 0x97, 0xff, 0xba, 0xb4, 0x8f,
+	0xc3, 0x52, 0x5d, 0x5c, 0x87, 0x70, 0xd3, 0x2c, 0x02, 0xec, 0x73, 0xe8, 0x4a, 0x75, 0x95, 0xcd,
+	0xee, 0x93, 0x09, 0x2d, 0xca, 0xfe, 0x49, 0x50, 0x9f, 0x74, 0xea, 0x19, 0xdf, 0x92, 0x4a, 0x89,
+	0xd3, 0xe8, 0xf3, 0x8c, 0x1c, 0x4f, 0x6d, 0x30, 0xa0, 0xe1, 0xee, 0x7b, 0xec, 0x3d, 0x4f, 0xed,
+	0xe8, 0x19, 0x6c, 0x2e, 0x05, 0x67, 0x03, 0xe8, 0x96, 0x27, 0x6e, 0xfd, 0x67, 0x74, 0x0f, 0x1b,
+	0x8b, 0xe7, 0xe3, 0x1e, 0xbe, 0xd6, 0xd6, 0xf9, 0xe2, 0xd1, 0x6f, 0xc4, 0xf0, 0x10, 0xea, 0xd7,
+	0x30, 0xa4, 0xdf, 0x6c, 0x03, 0xda, 0xc9, 0xc4, 0x77, 0xa8, 0x9d, 0x4c, 0x50, 0x43, 0xf7, 0xa6,
+	0x53, 0xf8, 0xe1, 0x6f, 0x5c, 0x61, 0x38, 0xdb, 0x77, 0xda, 0x24, 0x74, 0x9f, 0x7a, 0x61, 0x65,
+	0x8f, 0xfe, 0x6e, 0x03, 0xd4, 0xff, 0x2d, 0xd8, 0x6b, 0xd8, 0xc5, 0x95, 0x4f, 0x25, 0x95, 0x2a,
+	0x8a, 0xaf, 0x67, 0xea, 0xa6, 0x78, 0xf5, 0x31, 0x91, 0x4e, 0xb8, 0xed, 0xd9, 0x73, 0xa9, 0xc6,
+	0xc8, 0xd1, 0xab, 0xdf, 0x74, 0xe2, 0xf7, 0x4d, 0xa7, 0xf6, 0xa2, 0x13, 0xbf, 0xaf, 0x9d, 0xbe,
+	0x86, 0x27, 0x0b, 0x4e, 0x5a, 0xc5, 0x33, 0x63, 0x84, 0x72, 0x51, 0x2e, 0x70, 0x21, 0x16, 0xf7,
+	0x64, 0xbf, 0xe1, 0x5a, 0x29, 0x2e, 0x50, 0xc0, 0x8e, 0x61, 0x3b, 0xd1, 0x77, 0x2a, 0xd3, 0x3c,
+	0x69, 0x86, 0xec, 0x50, 0xc8, 0x07, 0x25, 0x55, 0x07, 0x7c, 0x03, 0x4f, 0x2b, 0xfd, 0x52, 0x44,
+	0xc7, 0xed, 0x8d, 0x2d, 0xff, 0x6c, 0x94, 0xa2, 0x85, 0x90, 0xef, 0x51, 0x81, 0x1b, 0x62, 0x29,
+	0x64, 0x63, 0xd9, 0xae, 0x51, 0xe0, 0xdd, 0x85, 0xc0, 0xd5, 0xd6, 0x9d, 0xac, 0xd1, 0xbf, 0xeb,
+	0xd7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x70, 0x61, 0xe7, 0x6d, 0x0b, 0x00, 0x00,
 }
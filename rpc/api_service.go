@@ -38,6 +38,10 @@ func corePbTx2rpcPbTx(pbTx *corepb.Transaction) (*rpcpb.TransactionResponse, err
 	if err != nil {
 		return nil, err
 	}
+	var multiSign []string
+	for _, sign := range pbTx.MultiSign {
+		multiSign = append(multiSign, byteutils.Bytes2Hex(sign))
+	}
 	return &rpcpb.TransactionResponse{
 		Hash:      byteutils.Bytes2Hex(pbTx.Hash),
 		From:      byteutils.Bytes2Hex(pbTx.From),
@@ -53,6 +57,7 @@ func corePbTx2rpcPbTx(pbTx *corepb.Transaction) (*rpcpb.TransactionResponse, err
 		Alg:       pbTx.Alg,
 		Sign:      byteutils.Bytes2Hex(pbTx.Sign),
 		PayerSign: byteutils.Bytes2Hex(pbTx.PayerSign),
+		MultiSign: multiSign,
 	}, nil
 }
 
@@ -66,6 +71,15 @@ func corePbBlock2rpcPbBlock(pbBlock *corepb.Block) (*rpcpb.BlockResponse, error)
 		rpcPbTxs = append(rpcPbTxs, rpcPbTx)
 	}
 
+	supply := util.Uint128Zero()
+	if len(pbBlock.Header.Supply) > 0 {
+		var err error
+		supply, err = util.NewUint128FromFixedSizeByteSlice(pbBlock.Header.Supply)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &rpcpb.BlockResponse{
 		Hash:          byteutils.Bytes2Hex(pbBlock.Header.Hash),
 		ParentHash:    byteutils.Bytes2Hex(pbBlock.Header.ParentHash),
@@ -81,13 +95,24 @@ func corePbBlock2rpcPbBlock(pbBlock *corepb.Block) (*rpcpb.BlockResponse, error)
 		ConsensusRoot: byteutils.Bytes2Hex(pbBlock.Header.ConsensusRoot),
 		Transactions:  rpcPbTxs,
 		Height:        pbBlock.Height,
+		Supply:        supply.String(),
 	}, nil
 }
 
 func generatePayloadBuf(txData *rpcpb.TransactionData) ([]byte, error) {
 	var addRecord *core.AddRecordPayload
 	var addCertification *core.AddCertificationPayload
+	var becomeCandidate *core.BecomeCandidatePayload
+	var updateCandidate *core.UpdateCandidatePayload
 	var revokeCertification *core.RevokeCertificationPayload
+	var registerIssuer *core.RegisterIssuerPayload
+	var createMultisig *core.CreateMultisigPayload
+	var scheduleTransfer *core.ScheduleTransferPayload
+	var cancelReservedTask *core.CancelReservedTaskPayload
+	var submitProposal *core.SubmitProposalPayload
+	var voteProposal *core.VoteProposalPayload
+	var reportDoubleSign *core.ReportDoubleSignPayload
+	var vote *core.VotePayload
 
 	switch txData.Type {
 	case core.TxOperationSend:
@@ -103,10 +128,51 @@ func generatePayloadBuf(txData *rpcpb.TransactionData) ([]byte, error) {
 	case core.TxOperationWithdrawVesting:
 	case core.TxPayloadBinaryType:
 		return nil, nil
+	case core.TxOperationBecomeCandidate:
+		if txData.Payload == "" {
+			return nil, nil
+		}
+		json.Unmarshal([]byte(txData.Payload), &becomeCandidate)
+		payload := core.NewBecomeCandidatePayload(becomeCandidate.Name, becomeCandidate.URL, becomeCandidate.PeerID)
+		payloadBuf, err := payload.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		return payloadBuf, nil
+	case core.TxOperationUpdateCandidate:
+		json.Unmarshal([]byte(txData.Payload), &updateCandidate)
+		payload := core.NewUpdateCandidatePayload(updateCandidate.Name, updateCandidate.URL, updateCandidate.PeerID)
+		payloadBuf, err := payload.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		return payloadBuf, nil
+	case core.TxOperationReportDoubleSign:
+		json.Unmarshal([]byte(txData.Payload), &reportDoubleSign)
+		payload := core.NewReportDoubleSignPayload(reportDoubleSign.Evidence)
+		payloadBuf, err := payload.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		return payloadBuf, nil
+	case core.TxOperationVote:
+		if txData.Payload == "" {
+			return nil, nil
+		}
+		json.Unmarshal([]byte(txData.Payload), &vote)
+		payload := core.NewVotePayload(vote.Candidates)
+		payloadBuf, err := payload.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		return payloadBuf, nil
+	case core.TxOperationUnvote, core.TxOperationDelegate, core.TxOperationUndelegate:
+		return nil, nil
 	case core.TxOperationAddCertification:
 		json.Unmarshal([]byte(txData.Payload), &addCertification)
 		payload := core.NewAddCertificationPayload(addCertification.IssueTime,
 			addCertification.ExpirationTime, addCertification.CertificateHash)
+		payload.CertificationType = addCertification.CertificationType
 		payloadBuf, err := payload.ToBytes()
 		if err != nil {
 			return nil, err
@@ -120,21 +186,96 @@ func generatePayloadBuf(txData *rpcpb.TransactionData) ([]byte, error) {
 			return nil, err
 		}
 		return payloadBuf, nil
+	case core.TxOperationRegisterIssuer:
+		json.Unmarshal([]byte(txData.Payload), &registerIssuer)
+		payload := core.NewRegisterIssuerPayload(registerIssuer.Name, registerIssuer.CertTypes)
+		payloadBuf, err := payload.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		return payloadBuf, nil
+	case core.TxOperationDeregisterIssuer:
+		return nil, nil
+	case core.TxOperationCreateMultisig:
+		json.Unmarshal([]byte(txData.Payload), &createMultisig)
+		payload := core.NewCreateMultisigPayload(createMultisig.Threshold, createMultisig.Owners)
+		payloadBuf, err := payload.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		return payloadBuf, nil
+	case core.TxOperationScheduleTransfer:
+		json.Unmarshal([]byte(txData.Payload), &scheduleTransfer)
+		payload := core.NewScheduleTransferPayload(scheduleTransfer.ExecutionTime)
+		payloadBuf, err := payload.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		return payloadBuf, nil
+	case core.TxOperationCancelReservedTask:
+		json.Unmarshal([]byte(txData.Payload), &cancelReservedTask)
+		payload := core.NewCancelReservedTaskPayload(cancelReservedTask.TaskHash)
+		payloadBuf, err := payload.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		return payloadBuf, nil
+	case core.TxOperationSubmitProposal:
+		json.Unmarshal([]byte(txData.Payload), &submitProposal)
+		payload := core.NewSubmitProposalPayload(submitProposal.Changes)
+		payloadBuf, err := payload.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		return payloadBuf, nil
+	case core.TxOperationVoteProposal:
+		json.Unmarshal([]byte(txData.Payload), &voteProposal)
+		payload := core.NewVoteProposalPayload(voteProposal.ProposalHash, voteProposal.Approve)
+		payloadBuf, err := payload.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		return payloadBuf, nil
 	}
 	return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidDataType)
 }
 
 // APIService is blockchain api rpc service.
 type APIService struct {
-	bm *core.BlockManager
-	tm *core.TransactionManager
+	bm      *core.BlockManager
+	tm      *core.TransactionManager
+	sponsor *Sponsor
 }
 
-func newAPIService(bm *core.BlockManager, tm *core.TransactionManager) *APIService {
+func newAPIService(bm *core.BlockManager, tm *core.TransactionManager, sponsor *Sponsor) *APIService {
 	return &APIService{
-		bm: bm,
-		tm: tm,
+		bm:      bm,
+		tm:      tm,
+		sponsor: sponsor,
+	}
+}
+
+// GetIssuer returns issuer of certifications registered in the issuer registry
+func (s *APIService) GetIssuer(ctx context.Context, req *rpcpb.GetIssuerRequest) (*rpcpb.GetIssuerResponse, error) {
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgIssuerNotFound)
+	}
+	issuer, err := tailBlock.State().GetIssuer(common.HexToAddress(req.Address))
+	if err != nil {
+		if err == trie.ErrNotFound {
+			return nil, status.Error(codes.NotFound, ErrMsgIssuerNotFound)
+		}
+		return nil, status.Error(codes.Internal, ErrMsgGetIssuerFailed)
 	}
+	return &rpcpb.GetIssuerResponse{
+		Address:          byteutils.Bytes2Hex(issuer.Address),
+		Name:             issuer.Name,
+		CertTypes:        issuer.CertTypes,
+		Root:             issuer.Root,
+		Registrar:        byteutils.Bytes2Hex(issuer.Registrar),
+		RegistrationTime: issuer.RegistrationTime,
+	}, nil
 }
 
 // GetMedState return mednet state
@@ -221,6 +362,222 @@ func (s *APIService) GetBlock(ctx context.Context, req *rpcpb.GetBlockRequest) (
 	return nil, status.Error(codes.Internal, ErrMsgConvertBlockFailed)
 }
 
+// GetMultisig returns threshold and owners of multisig account
+func (s *APIService) GetMultisig(ctx context.Context, req *rpcpb.GetMultisigRequest) (*rpcpb.GetMultisigResponse, error) {
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgMultisigNotFound)
+	}
+	multisig, err := tailBlock.State().GetMultisig(common.HexToAddress(req.Address))
+	if err != nil {
+		if err == trie.ErrNotFound {
+			return nil, status.Error(codes.NotFound, ErrMsgMultisigNotFound)
+		}
+		return nil, status.Error(codes.Internal, ErrMsgGetMultisigFailed)
+	}
+	var owners []*rpcpb.MultisigOwner
+	for _, owner := range multisig.Owners {
+		owners = append(owners, &rpcpb.MultisigOwner{
+			Address: byteutils.Bytes2Hex(owner.Address),
+			Weight:  owner.Weight,
+		})
+	}
+	return &rpcpb.GetMultisigResponse{
+		Address:   byteutils.Bytes2Hex(multisig.Address),
+		Threshold: multisig.Threshold,
+		Owners:    owners,
+	}, nil
+}
+
+// GetCandidate returns a candidate with its metadata
+func (s *APIService) GetCandidate(ctx context.Context, req *rpcpb.GetCandidateRequest) (*rpcpb.GetCandidateResponse, error) {
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgCandidateNotFound)
+	}
+	candidate, err := tailBlock.State().GetCandidate(common.HexToAddress(req.Address))
+	if err != nil {
+		if err == trie.ErrNotFound {
+			return nil, status.Error(codes.NotFound, ErrMsgCandidateNotFound)
+		}
+		return nil, status.Error(codes.Internal, ErrMsgGetCandidateFailed)
+	}
+	collateral, err := util.NewUint128FromFixedSizeByteSlice(candidate.Collateral)
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgGetCandidateFailed)
+	}
+	return &rpcpb.GetCandidateResponse{
+		Address:    byteutils.Bytes2Hex(candidate.Address),
+		Collateral: collateral.String(),
+		Name:       candidate.Name,
+		Url:        candidate.Url,
+		PeerId:     candidate.PeerId,
+	}, nil
+}
+
+// GetEvidences returns evidences of double signing detected by the node
+func (s *APIService) GetEvidences(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GetEvidencesResponse, error) {
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgBlockNotFound)
+	}
+	var evidences []*rpcpb.Evidence
+	for _, e := range s.bm.PendingEvidences() {
+		offender, err := e.Verify(tailBlock.ChainID())
+		if err != nil {
+			return nil, status.Error(codes.Internal, ErrMsgGetEvidencesFailed)
+		}
+		evidenceBytes, err := e.ToBytes()
+		if err != nil {
+			return nil, status.Error(codes.Internal, ErrMsgGetEvidencesFailed)
+		}
+		submitted, err := tailBlock.State().HasEvidence(e.Hash())
+		if err != nil {
+			return nil, status.Error(codes.Internal, ErrMsgGetEvidencesFailed)
+		}
+		evidences = append(evidences, &rpcpb.Evidence{
+			Hash:      byteutils.Bytes2Hex(e.Hash()),
+			Offender:  offender.Hex(),
+			Timestamp: e.Timestamp(),
+			Evidence:  byteutils.Bytes2Hex(evidenceBytes),
+			Submitted: submitted,
+		})
+	}
+	return &rpcpb.GetEvidencesResponse{
+		Evidences: evidences,
+	}, nil
+}
+
+// GetFinalityCertificate returns the finality certificate of a block
+func (s *APIService) GetFinalityCertificate(ctx context.Context, req *rpcpb.GetFinalityCertificateRequest) (*rpcpb.GetFinalityCertificateResponse, error) {
+	cert, err := s.bm.FinalityCertificate(byteutils.Hex2Bytes(req.Hash))
+	if err == core.ErrFinalityCertificateNotFound {
+		return nil, status.Error(codes.NotFound, ErrMsgFinalityNotFound)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgGetFinalityFailed)
+	}
+	res := &rpcpb.GetFinalityCertificateResponse{
+		BlockHash: byteutils.Bytes2Hex(cert.BlockHash()),
+		Height:    cert.Height(),
+		ChainId:   s.bm.ChainID(),
+	}
+	for _, v := range cert.Votes() {
+		voter, err := v.RecoverSigner()
+		if err != nil {
+			return nil, status.Error(codes.Internal, ErrMsgGetFinalityFailed)
+		}
+		res.Votes = append(res.Votes, &rpcpb.FinalityVote{
+			Voter:     voter.Hex(),
+			Alg:       uint32(v.Alg()),
+			Signature: byteutils.Bytes2Hex(v.Signature()),
+		})
+	}
+	return res, nil
+}
+
+// GetLiveness returns the number of produced and missed blocks of each proposer
+func (s *APIService) GetLiveness(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GetLivenessResponse, error) {
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgBlockNotFound)
+	}
+	current, err := tailBlock.State().Liveness()
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgGetLivenessFailed)
+	}
+	previous, err := tailBlock.State().PrevLiveness()
+	if err != nil {
+		return nil, status.Error(codes.Internal, ErrMsgGetLivenessFailed)
+	}
+	return &rpcpb.GetLivenessResponse{
+		Current:  livenessToRPC(current),
+		Previous: livenessToRPC(previous),
+	}, nil
+}
+
+func livenessToRPC(liveness []*core.ProposerLiveness) []*rpcpb.ProposerLiveness {
+	var rpcLiveness []*rpcpb.ProposerLiveness
+	for _, l := range liveness {
+		rpcLiveness = append(rpcLiveness, &rpcpb.ProposerLiveness{
+			Address:  l.Address.Hex(),
+			Produced: l.Produced,
+			Missed:   l.Missed,
+		})
+	}
+	return rpcLiveness
+}
+
+// GetProposal returns a governance proposal
+func (s *APIService) GetProposal(ctx context.Context, req *rpcpb.GetProposalRequest) (*rpcpb.GetProposalResponse, error) {
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgProposalNotFound)
+	}
+	proposal, err := tailBlock.State().GetProposal(byteutils.Hex2Bytes(req.Hash))
+	if err != nil {
+		if err == trie.ErrNotFound {
+			return nil, status.Error(codes.NotFound, ErrMsgProposalNotFound)
+		}
+		return nil, status.Error(codes.Internal, ErrMsgGetProposalFailed)
+	}
+	var changes []*rpcpb.ParamChange
+	for _, change := range proposal.Changes {
+		changes = append(changes, &rpcpb.ParamChange{
+			Name:  change.Name,
+			Value: change.Value,
+		})
+	}
+	var votes []*rpcpb.ProposalVote
+	for _, vote := range proposal.Votes {
+		votes = append(votes, &rpcpb.ProposalVote{
+			Voter:   byteutils.Bytes2Hex(vote.Voter),
+			Approve: vote.Approve,
+		})
+	}
+	return &rpcpb.GetProposalResponse{
+		Hash:          byteutils.Bytes2Hex(proposal.Hash),
+		Proposer:      byteutils.Bytes2Hex(proposal.Proposer),
+		Changes:       changes,
+		SubmitTime:    proposal.SubmitTime,
+		VotingEndTime: proposal.VotingEndTime,
+		Status:        proposal.Status,
+		Votes:         votes,
+	}, nil
+}
+
+// GetReservedTasks returns reserved tasks in reservation queue
+func (s *APIService) GetReservedTasks(ctx context.Context, req *rpcpb.GetReservedTasksRequest) (*rpcpb.GetReservedTasksResponse, error) {
+	tailBlock := s.bm.TailBlock()
+	if tailBlock == nil {
+		return nil, status.Error(codes.NotFound, ErrMsgBlockNotFound)
+	}
+	var tasks []*rpcpb.ReservedTask
+	for _, t := range tailBlock.State().GetReservedTasks() {
+		if req.Address != "" && t.From() != common.HexToAddress(req.Address) {
+			continue
+		}
+		hash, err := t.Hash()
+		if err != nil {
+			return nil, status.Error(codes.Internal, ErrMsgGetReservedTasksFailed)
+		}
+		payload, err := t.Payload().Serialize()
+		if err != nil {
+			return nil, status.Error(codes.Internal, ErrMsgGetReservedTasksFailed)
+		}
+		tasks = append(tasks, &rpcpb.ReservedTask{
+			Hash:      byteutils.Bytes2Hex(hash),
+			Type:      t.TaskType(),
+			From:      t.From().Hex(),
+			Payload:   byteutils.Bytes2Hex(payload),
+			Timestamp: t.Timestamp(),
+		})
+	}
+	return &rpcpb.GetReservedTasksResponse{
+		Tasks: tasks,
+	}, nil
+}
+
 // GetTransaction returns transaction
 func (s *APIService) GetTransaction(ctx context.Context, req *rpcpb.GetTransactionRequest) (*rpcpb.TransactionResponse, error) {
 	tailBlock := s.bm.TailBlock()
@@ -247,8 +604,12 @@ func (s *APIService) GetTransaction(ctx context.Context, req *rpcpb.GetTransacti
 	return res, nil
 }
 
-// SendTransaction sends transaction
-func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.SendTransactionRequest) (*rpcpb.SendTransactionResponse, error) {
+// TransactionFromRequest builds a transaction from the fields of the request.
+func TransactionFromRequest(req *rpcpb.SendTransactionRequest) (*core.Transaction, error) {
+	return rpcPbTx2coreTx(req)
+}
+
+func rpcPbTx2coreTx(req *rpcpb.SendTransactionRequest) (*core.Transaction, error) {
 	value, err := util.NewUint128FromString(req.Value)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidTxValue)
@@ -257,6 +618,10 @@ func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.SendTransac
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidTxDataPayload)
 	}
+	var multiSign [][]byte
+	for _, sign := range req.MultiSign {
+		multiSign = append(multiSign, byteutils.Hex2Bytes(sign))
+	}
 	tx, err := core.BuildTransaction(
 		req.ChainId,
 		common.HexToAddress(req.From),
@@ -271,10 +636,20 @@ func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.SendTransac
 		byteutils.Hex2Bytes(req.Hash),
 		req.Alg,
 		byteutils.Hex2Bytes(req.Sign),
-		byteutils.Hex2Bytes(req.PayerSign))
+		byteutils.Hex2Bytes(req.PayerSign),
+		multiSign)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, ErrMsgBuildTransactionFail)
 	}
+	return tx, nil
+}
+
+// SendTransaction sends transaction
+func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.SendTransactionRequest) (*rpcpb.SendTransactionResponse, error) {
+	tx, err := rpcPbTx2coreTx(req)
+	if err != nil {
+		return nil, err
+	}
 	if err = s.tm.Push(tx); err != nil {
 		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidTransaction)
 	}
@@ -282,3 +657,31 @@ func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.SendTransac
 		Hash: byteutils.Bytes2Hex(tx.Hash()),
 	}, nil
 }
+
+// SponsorTransaction signs user's transaction as payer and sends it
+func (s *APIService) SponsorTransaction(ctx context.Context, req *rpcpb.SendTransactionRequest) (*rpcpb.SendTransactionResponse, error) {
+	if s.sponsor == nil {
+		return nil, status.Error(codes.Unavailable, ErrMsgSponsorDisabled)
+	}
+	tx, err := rpcPbTx2coreTx(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.VerifyIntegrity(s.bm.TailBlock().ChainID()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidTransaction)
+	}
+	var pushErr error
+	err = s.sponsor.SponsorTransaction(tx, func(tx *core.Transaction) error {
+		pushErr = s.tm.Push(tx)
+		return pushErr
+	})
+	if pushErr != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrMsgInvalidTransaction)
+	}
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, ErrMsgSponsorRejected)
+	}
+	return &rpcpb.SendTransactionResponse{
+		Hash: byteutils.Bytes2Hex(tx.Hash()),
+	}, nil
+}
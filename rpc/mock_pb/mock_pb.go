@@ -72,6 +72,96 @@ func (mr *MockApiServiceClientMockRecorder) GetBlock(ctx, in interface{}, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockApiServiceClient)(nil).GetBlock), varargs...)
 }
 
+// GetCandidate mocks base method
+func (m *MockApiServiceClient) GetCandidate(ctx context.Context, in *pb.GetCandidateRequest, opts ...grpc.CallOption) (*pb.GetCandidateResponse, error) {
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCandidate", varargs...)
+	ret0, _ := ret[0].(*pb.GetCandidateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCandidate indicates an expected call of GetCandidate
+func (mr *MockApiServiceClientMockRecorder) GetCandidate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidate", reflect.TypeOf((*MockApiServiceClient)(nil).GetCandidate), varargs...)
+}
+
+// GetEvidences mocks base method
+func (m *MockApiServiceClient) GetEvidences(ctx context.Context, in *pb.NonParamsRequest, opts ...grpc.CallOption) (*pb.GetEvidencesResponse, error) {
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEvidences", varargs...)
+	ret0, _ := ret[0].(*pb.GetEvidencesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvidences indicates an expected call of GetEvidences
+func (mr *MockApiServiceClientMockRecorder) GetEvidences(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvidences", reflect.TypeOf((*MockApiServiceClient)(nil).GetEvidences), varargs...)
+}
+
+// GetFinalityCertificate mocks base method
+func (m *MockApiServiceClient) GetFinalityCertificate(ctx context.Context, in *pb.GetFinalityCertificateRequest, opts ...grpc.CallOption) (*pb.GetFinalityCertificateResponse, error) {
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFinalityCertificate", varargs...)
+	ret0, _ := ret[0].(*pb.GetFinalityCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFinalityCertificate indicates an expected call of GetFinalityCertificate
+func (mr *MockApiServiceClientMockRecorder) GetFinalityCertificate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFinalityCertificate", reflect.TypeOf((*MockApiServiceClient)(nil).GetFinalityCertificate), varargs...)
+}
+
+// GetIssuer mocks base method
+func (m *MockApiServiceClient) GetIssuer(ctx context.Context, in *pb.GetIssuerRequest, opts ...grpc.CallOption) (*pb.GetIssuerResponse, error) {
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIssuer", varargs...)
+	ret0, _ := ret[0].(*pb.GetIssuerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssuer indicates an expected call of GetIssuer
+func (mr *MockApiServiceClientMockRecorder) GetIssuer(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssuer", reflect.TypeOf((*MockApiServiceClient)(nil).GetIssuer), varargs...)
+}
+
+// GetLiveness mocks base method
+func (m *MockApiServiceClient) GetLiveness(ctx context.Context, in *pb.NonParamsRequest, opts ...grpc.CallOption) (*pb.GetLivenessResponse, error) {
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLiveness", varargs...)
+	ret0, _ := ret[0].(*pb.GetLivenessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLiveness indicates an expected call of GetLiveness
+func (mr *MockApiServiceClientMockRecorder) GetLiveness(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLiveness", reflect.TypeOf((*MockApiServiceClient)(nil).GetLiveness), varargs...)
+}
+
 // GetMedState mocks base method
 func (m *MockApiServiceClient) GetMedState(ctx context.Context, in *pb.NonParamsRequest, opts ...grpc.CallOption) (*pb.GetMedStateResponse, error) {
 	varargs := []interface{}{ctx, in}
@@ -90,6 +180,60 @@ func (mr *MockApiServiceClientMockRecorder) GetMedState(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedState", reflect.TypeOf((*MockApiServiceClient)(nil).GetMedState), varargs...)
 }
 
+// GetMultisig mocks base method
+func (m *MockApiServiceClient) GetMultisig(ctx context.Context, in *pb.GetMultisigRequest, opts ...grpc.CallOption) (*pb.GetMultisigResponse, error) {
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMultisig", varargs...)
+	ret0, _ := ret[0].(*pb.GetMultisigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisig indicates an expected call of GetMultisig
+func (mr *MockApiServiceClientMockRecorder) GetMultisig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisig", reflect.TypeOf((*MockApiServiceClient)(nil).GetMultisig), varargs...)
+}
+
+// GetProposal mocks base method
+func (m *MockApiServiceClient) GetProposal(ctx context.Context, in *pb.GetProposalRequest, opts ...grpc.CallOption) (*pb.GetProposalResponse, error) {
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProposal", varargs...)
+	ret0, _ := ret[0].(*pb.GetProposalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal
+func (mr *MockApiServiceClientMockRecorder) GetProposal(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockApiServiceClient)(nil).GetProposal), varargs...)
+}
+
+// GetReservedTasks mocks base method
+func (m *MockApiServiceClient) GetReservedTasks(ctx context.Context, in *pb.GetReservedTasksRequest, opts ...grpc.CallOption) (*pb.GetReservedTasksResponse, error) {
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReservedTasks", varargs...)
+	ret0, _ := ret[0].(*pb.GetReservedTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservedTasks indicates an expected call of GetReservedTasks
+func (mr *MockApiServiceClientMockRecorder) GetReservedTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservedTasks", reflect.TypeOf((*MockApiServiceClient)(nil).GetReservedTasks), varargs...)
+}
+
 // GetTransaction mocks base method
 func (m *MockApiServiceClient) GetTransaction(ctx context.Context, in *pb.GetTransactionRequest, opts ...grpc.CallOption) (*pb.TransactionResponse, error) {
 	varargs := []interface{}{ctx, in}
@@ -126,6 +270,24 @@ func (mr *MockApiServiceClientMockRecorder) SendTransaction(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransaction", reflect.TypeOf((*MockApiServiceClient)(nil).SendTransaction), varargs...)
 }
 
+// SponsorTransaction mocks base method
+func (m *MockApiServiceClient) SponsorTransaction(ctx context.Context, in *pb.SendTransactionRequest, opts ...grpc.CallOption) (*pb.SendTransactionResponse, error) {
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SponsorTransaction", varargs...)
+	ret0, _ := ret[0].(*pb.SendTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SponsorTransaction indicates an expected call of SponsorTransaction
+func (mr *MockApiServiceClientMockRecorder) SponsorTransaction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SponsorTransaction", reflect.TypeOf((*MockApiServiceClient)(nil).SponsorTransaction), varargs...)
+}
+
 // MockApiServiceServer is a mock of ApiServiceServer interface
 type MockApiServiceServer struct {
 	ctrl     *gomock.Controller
@@ -175,6 +337,71 @@ func (mr *MockApiServiceServerMockRecorder) GetBlock(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlock), arg0, arg1)
 }
 
+// GetCandidate mocks base method
+func (m *MockApiServiceServer) GetCandidate(arg0 context.Context, arg1 *pb.GetCandidateRequest) (*pb.GetCandidateResponse, error) {
+	ret := m.ctrl.Call(m, "GetCandidate", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetCandidateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCandidate indicates an expected call of GetCandidate
+func (mr *MockApiServiceServerMockRecorder) GetCandidate(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidate", reflect.TypeOf((*MockApiServiceServer)(nil).GetCandidate), arg0, arg1)
+}
+
+// GetEvidences mocks base method
+func (m *MockApiServiceServer) GetEvidences(arg0 context.Context, arg1 *pb.NonParamsRequest) (*pb.GetEvidencesResponse, error) {
+	ret := m.ctrl.Call(m, "GetEvidences", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetEvidencesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvidences indicates an expected call of GetEvidences
+func (mr *MockApiServiceServerMockRecorder) GetEvidences(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvidences", reflect.TypeOf((*MockApiServiceServer)(nil).GetEvidences), arg0, arg1)
+}
+
+// GetFinalityCertificate mocks base method
+func (m *MockApiServiceServer) GetFinalityCertificate(arg0 context.Context, arg1 *pb.GetFinalityCertificateRequest) (*pb.GetFinalityCertificateResponse, error) {
+	ret := m.ctrl.Call(m, "GetFinalityCertificate", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetFinalityCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFinalityCertificate indicates an expected call of GetFinalityCertificate
+func (mr *MockApiServiceServerMockRecorder) GetFinalityCertificate(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFinalityCertificate", reflect.TypeOf((*MockApiServiceServer)(nil).GetFinalityCertificate), arg0, arg1)
+}
+
+// GetIssuer mocks base method
+func (m *MockApiServiceServer) GetIssuer(arg0 context.Context, arg1 *pb.GetIssuerRequest) (*pb.GetIssuerResponse, error) {
+	ret := m.ctrl.Call(m, "GetIssuer", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetIssuerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssuer indicates an expected call of GetIssuer
+func (mr *MockApiServiceServerMockRecorder) GetIssuer(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssuer", reflect.TypeOf((*MockApiServiceServer)(nil).GetIssuer), arg0, arg1)
+}
+
+// GetLiveness mocks base method
+func (m *MockApiServiceServer) GetLiveness(arg0 context.Context, arg1 *pb.NonParamsRequest) (*pb.GetLivenessResponse, error) {
+	ret := m.ctrl.Call(m, "GetLiveness", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetLivenessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLiveness indicates an expected call of GetLiveness
+func (mr *MockApiServiceServerMockRecorder) GetLiveness(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLiveness", reflect.TypeOf((*MockApiServiceServer)(nil).GetLiveness), arg0, arg1)
+}
+
 // GetMedState mocks base method
 func (m *MockApiServiceServer) GetMedState(arg0 context.Context, arg1 *pb.NonParamsRequest) (*pb.GetMedStateResponse, error) {
 	ret := m.ctrl.Call(m, "GetMedState", arg0, arg1)
@@ -188,6 +415,45 @@ func (mr *MockApiServiceServerMockRecorder) GetMedState(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedState", reflect.TypeOf((*MockApiServiceServer)(nil).GetMedState), arg0, arg1)
 }
 
+// GetMultisig mocks base method
+func (m *MockApiServiceServer) GetMultisig(arg0 context.Context, arg1 *pb.GetMultisigRequest) (*pb.GetMultisigResponse, error) {
+	ret := m.ctrl.Call(m, "GetMultisig", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetMultisigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisig indicates an expected call of GetMultisig
+func (mr *MockApiServiceServerMockRecorder) GetMultisig(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisig", reflect.TypeOf((*MockApiServiceServer)(nil).GetMultisig), arg0, arg1)
+}
+
+// GetProposal mocks base method
+func (m *MockApiServiceServer) GetProposal(arg0 context.Context, arg1 *pb.GetProposalRequest) (*pb.GetProposalResponse, error) {
+	ret := m.ctrl.Call(m, "GetProposal", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetProposalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal
+func (mr *MockApiServiceServerMockRecorder) GetProposal(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockApiServiceServer)(nil).GetProposal), arg0, arg1)
+}
+
+// GetReservedTasks mocks base method
+func (m *MockApiServiceServer) GetReservedTasks(arg0 context.Context, arg1 *pb.GetReservedTasksRequest) (*pb.GetReservedTasksResponse, error) {
+	ret := m.ctrl.Call(m, "GetReservedTasks", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetReservedTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservedTasks indicates an expected call of GetReservedTasks
+func (mr *MockApiServiceServerMockRecorder) GetReservedTasks(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservedTasks", reflect.TypeOf((*MockApiServiceServer)(nil).GetReservedTasks), arg0, arg1)
+}
+
 // GetTransaction mocks base method
 func (m *MockApiServiceServer) GetTransaction(arg0 context.Context, arg1 *pb.GetTransactionRequest) (*pb.TransactionResponse, error) {
 	ret := m.ctrl.Call(m, "GetTransaction", arg0, arg1)
@@ -213,3 +479,16 @@ func (m *MockApiServiceServer) SendTransaction(arg0 context.Context, arg1 *pb.Se
 func (mr *MockApiServiceServerMockRecorder) SendTransaction(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransaction", reflect.TypeOf((*MockApiServiceServer)(nil).SendTransaction), arg0, arg1)
 }
+
+// SponsorTransaction mocks base method
+func (m *MockApiServiceServer) SponsorTransaction(arg0 context.Context, arg1 *pb.SendTransactionRequest) (*pb.SendTransactionResponse, error) {
+	ret := m.ctrl.Call(m, "SponsorTransaction", arg0, arg1)
+	ret0, _ := ret[0].(*pb.SendTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SponsorTransaction indicates an expected call of SponsorTransaction
+func (mr *MockApiServiceServerMockRecorder) SponsorTransaction(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SponsorTransaction", reflect.TypeOf((*MockApiServiceServer)(nil).SponsorTransaction), arg0, arg1)
+}
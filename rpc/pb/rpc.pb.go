@@ -12,8 +12,29 @@ It has these top-level messages:
 	GetAccountStateResponse
 	GetBlockRequest
 	BlockResponse
+	GetIssuerRequest
+	GetIssuerResponse
 	NonParamsRequest
 	GetMedStateResponse
+	GetMultisigRequest
+	GetMultisigResponse
+	MultisigOwner
+	GetCandidateRequest
+	GetCandidateResponse
+	GetEvidencesResponse
+	Evidence
+	GetFinalityCertificateRequest
+	GetFinalityCertificateResponse
+	FinalityVote
+	GetLivenessResponse
+	ProposerLiveness
+	GetProposalRequest
+	GetProposalResponse
+	ParamChange
+	ProposalVote
+	GetReservedTasksRequest
+	GetReservedTasksResponse
+	ReservedTask
 	GetTransactionRequest
 	SendTransactionRequest
 	SendTransactionResponse
@@ -148,6 +169,8 @@ type BlockResponse struct {
 	Transactions []*TransactionResponse `protobuf:"bytes,13,rep,name=transactions" json:"transactions,omitempty"`
 	// Block height
 	Height uint64 `protobuf:"varint,14,opt,name=height,proto3" json:"height,omitempty"`
+	// Total supply after block execution
+	Supply string `protobuf:"bytes,15,opt,name=supply,proto3" json:"supply,omitempty"`
 }
 
 func (m *BlockResponse) Reset()                    { *m = BlockResponse{} }
@@ -253,67 +276,759 @@ func (m *BlockResponse) GetHeight() uint64 {
 	return 0
 }
 
+func (m *BlockResponse) GetSupply() string {
+	if m != nil {
+		return m.Supply
+	}
+	return ""
+}
+
+type GetIssuerRequest struct {
+	// Hex string of the issuer address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GetIssuerRequest) Reset()                    { *m = GetIssuerRequest{} }
+func (m *GetIssuerRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetIssuerRequest) ProtoMessage()               {}
+func (*GetIssuerRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{4} }
+
+func (m *GetIssuerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GetIssuerResponse struct {
+	// Hex string of the issuer address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Issuer name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Certification types the issuer is allowed to issue. Empty means any type.
+	CertTypes []string `protobuf:"bytes,3,rep,name=cert_types,json=certTypes" json:"cert_types,omitempty"`
+	// Whether the issuer is a root issuer.
+	Root bool `protobuf:"varint,4,opt,name=root,proto3" json:"root,omitempty"`
+	// Hex string of the root issuer who registered this issuer.
+	Registrar string `protobuf:"bytes,5,opt,name=registrar,proto3" json:"registrar,omitempty"`
+	// Registration timestamp.
+	RegistrationTime int64 `protobuf:"varint,6,opt,name=registration_time,json=registrationTime,proto3" json:"registration_time,omitempty"`
+}
+
+func (m *GetIssuerResponse) Reset()                    { *m = GetIssuerResponse{} }
+func (m *GetIssuerResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetIssuerResponse) ProtoMessage()               {}
+func (*GetIssuerResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{5} }
+
+func (m *GetIssuerResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetIssuerResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetIssuerResponse) GetCertTypes() []string {
+	if m != nil {
+		return m.CertTypes
+	}
+	return nil
+}
+
+func (m *GetIssuerResponse) GetRoot() bool {
+	if m != nil {
+		return m.Root
+	}
+	return false
+}
+
+func (m *GetIssuerResponse) GetRegistrar() string {
+	if m != nil {
+		return m.Registrar
+	}
+	return ""
+}
+
+func (m *GetIssuerResponse) GetRegistrationTime() int64 {
+	if m != nil {
+		return m.RegistrationTime
+	}
+	return 0
+}
+
 type NonParamsRequest struct {
 }
 
-func (m *NonParamsRequest) Reset()                    { *m = NonParamsRequest{} }
-func (m *NonParamsRequest) String() string            { return proto.CompactTextString(m) }
-func (*NonParamsRequest) ProtoMessage()               {}
-func (*NonParamsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{4} }
+func (m *NonParamsRequest) Reset()                    { *m = NonParamsRequest{} }
+func (m *NonParamsRequest) String() string            { return proto.CompactTextString(m) }
+func (*NonParamsRequest) ProtoMessage()               {}
+func (*NonParamsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{6} }
+
+type GetMedStateResponse struct {
+	// Block chain id
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Current tail block hash
+	Tail string `protobuf:"bytes,2,opt,name=tail,proto3" json:"tail,omitempty"`
+	// Current tail block height
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// The current med protocol version.
+	ProtocolVersion string `protobuf:"bytes,7,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// Med version
+	Version string `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *GetMedStateResponse) Reset()                    { *m = GetMedStateResponse{} }
+func (m *GetMedStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetMedStateResponse) ProtoMessage()               {}
+func (*GetMedStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{7} }
+
+func (m *GetMedStateResponse) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *GetMedStateResponse) GetTail() string {
+	if m != nil {
+		return m.Tail
+	}
+	return ""
+}
+
+func (m *GetMedStateResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetMedStateResponse) GetProtocolVersion() string {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return ""
+}
+
+func (m *GetMedStateResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type GetMultisigRequest struct {
+	// Hex string of the multisig account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GetMultisigRequest) Reset()                    { *m = GetMultisigRequest{} }
+func (m *GetMultisigRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMultisigRequest) ProtoMessage()               {}
+func (*GetMultisigRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{8} }
+
+func (m *GetMultisigRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GetMultisigResponse struct {
+	// Hex string of the multisig account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Total weight of signers required to send a transaction.
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Owners of the multisig account.
+	Owners []*MultisigOwner `protobuf:"bytes,3,rep,name=owners" json:"owners,omitempty"`
+}
+
+func (m *GetMultisigResponse) Reset()                    { *m = GetMultisigResponse{} }
+func (m *GetMultisigResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetMultisigResponse) ProtoMessage()               {}
+func (*GetMultisigResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{9} }
+
+func (m *GetMultisigResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetMultisigResponse) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *GetMultisigResponse) GetOwners() []*MultisigOwner {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+type MultisigOwner struct {
+	// Hex string of the owner address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Weight of the owner's signature.
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *MultisigOwner) Reset()                    { *m = MultisigOwner{} }
+func (m *MultisigOwner) String() string            { return proto.CompactTextString(m) }
+func (*MultisigOwner) ProtoMessage()               {}
+func (*MultisigOwner) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{10} }
+
+func (m *MultisigOwner) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MultisigOwner) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type GetCandidateRequest struct {
+	// Hex string of the candidate address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GetCandidateRequest) Reset()                    { *m = GetCandidateRequest{} }
+func (m *GetCandidateRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCandidateRequest) ProtoMessage()               {}
+func (*GetCandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{11} }
+
+func (m *GetCandidateRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GetCandidateResponse struct {
+	// Hex string of the candidate address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Collateral of the candidate.
+	Collateral string `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	// Name of the candidate.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// URL of the candidate.
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// Peer ID of the candidate node.
+	PeerId string `protobuf:"bytes,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+}
+
+func (m *GetCandidateResponse) Reset()                    { *m = GetCandidateResponse{} }
+func (m *GetCandidateResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetCandidateResponse) ProtoMessage()               {}
+func (*GetCandidateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{12} }
+
+func (m *GetCandidateResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetCandidateResponse) GetCollateral() string {
+	if m != nil {
+		return m.Collateral
+	}
+	return ""
+}
+
+func (m *GetCandidateResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetCandidateResponse) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *GetCandidateResponse) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+type GetEvidencesResponse struct {
+	// Evidences of double signing detected by the node.
+	Evidences []*Evidence `protobuf:"bytes,1,rep,name=evidences" json:"evidences,omitempty"`
+}
+
+func (m *GetEvidencesResponse) Reset()                    { *m = GetEvidencesResponse{} }
+func (m *GetEvidencesResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetEvidencesResponse) ProtoMessage()               {}
+func (*GetEvidencesResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{13} }
+
+func (m *GetEvidencesResponse) GetEvidences() []*Evidence {
+	if m != nil {
+		return m.Evidences
+	}
+	return nil
+}
+
+type Evidence struct {
+	// Hex string of the evidence hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of the double signing proposer address.
+	Offender string `protobuf:"bytes,2,opt,name=offender,proto3" json:"offender,omitempty"`
+	// Timestamp of the double signed slot.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Hex string of the marshalled evidence to be reported.
+	Evidence string `protobuf:"bytes,4,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// Whether the evidence is already reported on chain.
+	Submitted bool `protobuf:"varint,5,opt,name=submitted,proto3" json:"submitted,omitempty"`
+}
+
+func (m *Evidence) Reset()                    { *m = Evidence{} }
+func (m *Evidence) String() string            { return proto.CompactTextString(m) }
+func (*Evidence) ProtoMessage()               {}
+func (*Evidence) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{14} }
+
+func (m *Evidence) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Evidence) GetOffender() string {
+	if m != nil {
+		return m.Offender
+	}
+	return ""
+}
+
+func (m *Evidence) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Evidence) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
+	}
+	return ""
+}
+
+func (m *Evidence) GetSubmitted() bool {
+	if m != nil {
+		return m.Submitted
+	}
+	return false
+}
+
+type GetFinalityCertificateRequest struct {
+	// Hex string of the block hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GetFinalityCertificateRequest) Reset()         { *m = GetFinalityCertificateRequest{} }
+func (m *GetFinalityCertificateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFinalityCertificateRequest) ProtoMessage()    {}
+func (*GetFinalityCertificateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{15}
+}
+
+func (m *GetFinalityCertificateRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type GetFinalityCertificateResponse struct {
+	// Hex string of the finalized block hash.
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Height of the finalized block.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Chain id signed in the votes.
+	ChainId uint32 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Signed confirmations of dynasty members.
+	Votes []*FinalityVote `protobuf:"bytes,4,rep,name=votes" json:"votes,omitempty"`
+}
+
+func (m *GetFinalityCertificateResponse) Reset()         { *m = GetFinalityCertificateResponse{} }
+func (m *GetFinalityCertificateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFinalityCertificateResponse) ProtoMessage()    {}
+func (*GetFinalityCertificateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{16}
+}
+
+func (m *GetFinalityCertificateResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetFinalityCertificateResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetFinalityCertificateResponse) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *GetFinalityCertificateResponse) GetVotes() []*FinalityVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+type FinalityVote struct {
+	// Hex string of the voter address.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// Signature algorithm of the vote.
+	Alg uint32 `protobuf:"varint,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	// Hex string of the signature.
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *FinalityVote) Reset()                    { *m = FinalityVote{} }
+func (m *FinalityVote) String() string            { return proto.CompactTextString(m) }
+func (*FinalityVote) ProtoMessage()               {}
+func (*FinalityVote) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{17} }
+
+func (m *FinalityVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *FinalityVote) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *FinalityVote) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type GetLivenessResponse struct {
+	// Liveness of proposers in current dynasty.
+	Current []*ProposerLiveness `protobuf:"bytes,1,rep,name=current" json:"current,omitempty"`
+	// Liveness of proposers in previous dynasty.
+	Previous []*ProposerLiveness `protobuf:"bytes,2,rep,name=previous" json:"previous,omitempty"`
+}
+
+func (m *GetLivenessResponse) Reset()                    { *m = GetLivenessResponse{} }
+func (m *GetLivenessResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetLivenessResponse) ProtoMessage()               {}
+func (*GetLivenessResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{18} }
+
+func (m *GetLivenessResponse) GetCurrent() []*ProposerLiveness {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *GetLivenessResponse) GetPrevious() []*ProposerLiveness {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
+type ProposerLiveness struct {
+	// Hex string of the proposer address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Number of blocks produced by the proposer.
+	Produced uint64 `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`
+	// Number of slots missed by the proposer.
+	Missed uint64 `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (m *ProposerLiveness) Reset()                    { *m = ProposerLiveness{} }
+func (m *ProposerLiveness) String() string            { return proto.CompactTextString(m) }
+func (*ProposerLiveness) ProtoMessage()               {}
+func (*ProposerLiveness) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{19} }
+
+func (m *ProposerLiveness) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ProposerLiveness) GetProduced() uint64 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
+}
+
+func (m *ProposerLiveness) GetMissed() uint64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+type GetProposalRequest struct {
+	// Hex string of the proposal hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GetProposalRequest) Reset()                    { *m = GetProposalRequest{} }
+func (m *GetProposalRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetProposalRequest) ProtoMessage()               {}
+func (*GetProposalRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{20} }
+
+func (m *GetProposalRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type GetProposalResponse struct {
+	// Hex string of the proposal hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of the proposer address.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// Chain parameter changes proposed.
+	Changes []*ParamChange `protobuf:"bytes,3,rep,name=changes" json:"changes,omitempty"`
+	// Timestamp when the proposal was submitted.
+	SubmitTime int64 `protobuf:"varint,4,opt,name=submit_time,json=submitTime,proto3" json:"submit_time,omitempty"`
+	// Timestamp when voting on the proposal ends.
+	VotingEndTime int64 `protobuf:"varint,5,opt,name=voting_end_time,json=votingEndTime,proto3" json:"voting_end_time,omitempty"`
+	// Status of the proposal. One of voting, passed and rejected.
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// Votes on the proposal.
+	Votes []*ProposalVote `protobuf:"bytes,7,rep,name=votes" json:"votes,omitempty"`
+}
+
+func (m *GetProposalResponse) Reset()                    { *m = GetProposalResponse{} }
+func (m *GetProposalResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetProposalResponse) ProtoMessage()               {}
+func (*GetProposalResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{21} }
+
+func (m *GetProposalResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetProposalResponse) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *GetProposalResponse) GetChanges() []*ParamChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *GetProposalResponse) GetSubmitTime() int64 {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return 0
+}
+
+func (m *GetProposalResponse) GetVotingEndTime() int64 {
+	if m != nil {
+		return m.VotingEndTime
+	}
+	return 0
+}
+
+func (m *GetProposalResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetProposalResponse) GetVotes() []*ProposalVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+type ParamChange struct {
+	// Name of the chain parameter.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// New value of the chain parameter.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ParamChange) Reset()                    { *m = ParamChange{} }
+func (m *ParamChange) String() string            { return proto.CompactTextString(m) }
+func (*ParamChange) ProtoMessage()               {}
+func (*ParamChange) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{22} }
+
+func (m *ParamChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ParamChange) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type ProposalVote struct {
+	// Hex string of the voter address.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// Whether the voter approves the proposal.
+	Approve bool `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+}
+
+func (m *ProposalVote) Reset()                    { *m = ProposalVote{} }
+func (m *ProposalVote) String() string            { return proto.CompactTextString(m) }
+func (*ProposalVote) ProtoMessage()               {}
+func (*ProposalVote) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{23} }
+
+func (m *ProposalVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *ProposalVote) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+type GetReservedTasksRequest struct {
+	// Hex string of the task owner address. Empty means all tasks.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GetReservedTasksRequest) Reset()                    { *m = GetReservedTasksRequest{} }
+func (m *GetReservedTasksRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetReservedTasksRequest) ProtoMessage()               {}
+func (*GetReservedTasksRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{24} }
 
-type GetMedStateResponse struct {
-	// Block chain id
-	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Current tail block hash
-	Tail string `protobuf:"bytes,2,opt,name=tail,proto3" json:"tail,omitempty"`
-	// Current tail block height
-	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	// The current med protocol version.
-	ProtocolVersion string `protobuf:"bytes,7,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
-	// Med version
-	Version string `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+func (m *GetReservedTasksRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *GetMedStateResponse) Reset()                    { *m = GetMedStateResponse{} }
-func (m *GetMedStateResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetMedStateResponse) ProtoMessage()               {}
-func (*GetMedStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{5} }
+type GetReservedTasksResponse struct {
+	// Reserved tasks ordered by timestamp.
+	Tasks []*ReservedTask `protobuf:"bytes,1,rep,name=tasks" json:"tasks,omitempty"`
+}
 
-func (m *GetMedStateResponse) GetChainId() uint32 {
+func (m *GetReservedTasksResponse) Reset()                    { *m = GetReservedTasksResponse{} }
+func (m *GetReservedTasksResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetReservedTasksResponse) ProtoMessage()               {}
+func (*GetReservedTasksResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{25} }
+
+func (m *GetReservedTasksResponse) GetTasks() []*ReservedTask {
 	if m != nil {
-		return m.ChainId
+		return m.Tasks
 	}
-	return 0
+	return nil
 }
 
-func (m *GetMedStateResponse) GetTail() string {
+type ReservedTask struct {
+	// Hex string of the task hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Reserved task type.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Hex string of the task owner address.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Hex string of the task payload.
+	Payload string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Timestamp when the task is executed.
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *ReservedTask) Reset()                    { *m = ReservedTask{} }
+func (m *ReservedTask) String() string            { return proto.CompactTextString(m) }
+func (*ReservedTask) ProtoMessage()               {}
+func (*ReservedTask) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{26} }
+
+func (m *ReservedTask) GetHash() string {
 	if m != nil {
-		return m.Tail
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *GetMedStateResponse) GetHeight() uint64 {
+func (m *ReservedTask) GetType() string {
 	if m != nil {
-		return m.Height
+		return m.Type
 	}
-	return 0
+	return ""
 }
 
-func (m *GetMedStateResponse) GetProtocolVersion() string {
+func (m *ReservedTask) GetFrom() string {
 	if m != nil {
-		return m.ProtocolVersion
+		return m.From
 	}
 	return ""
 }
 
-func (m *GetMedStateResponse) GetVersion() string {
+func (m *ReservedTask) GetPayload() string {
 	if m != nil {
-		return m.Version
+		return m.Payload
 	}
 	return ""
 }
 
+func (m *ReservedTask) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 type GetTransactionRequest struct {
 	// Transaction hash
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -322,7 +1037,7 @@ type GetTransactionRequest struct {
 func (m *GetTransactionRequest) Reset()                    { *m = GetTransactionRequest{} }
 func (m *GetTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTransactionRequest) ProtoMessage()               {}
-func (*GetTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{6} }
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{27} }
 
 func (m *GetTransactionRequest) GetHash() string {
 	if m != nil {
@@ -354,12 +1069,14 @@ type SendTransactionRequest struct {
 	Sign string `protobuf:"bytes,10,opt,name=sign,proto3" json:"sign,omitempty"`
 	// Transaction payer's sign.
 	PayerSign string `protobuf:"bytes,11,opt,name=payer_sign,json=payerSign,proto3" json:"payer_sign,omitempty"`
+	// Partial signs of multisig owners.
+	MultiSign []string `protobuf:"bytes,12,rep,name=multi_sign,json=multiSign" json:"multi_sign,omitempty"`
 }
 
 func (m *SendTransactionRequest) Reset()                    { *m = SendTransactionRequest{} }
 func (m *SendTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendTransactionRequest) ProtoMessage()               {}
-func (*SendTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{7} }
+func (*SendTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{28} }
 
 func (m *SendTransactionRequest) GetHash() string {
 	if m != nil {
@@ -438,6 +1155,13 @@ func (m *SendTransactionRequest) GetPayerSign() string {
 	return ""
 }
 
+func (m *SendTransactionRequest) GetMultiSign() []string {
+	if m != nil {
+		return m.MultiSign
+	}
+	return nil
+}
+
 type SendTransactionResponse struct {
 	// Hex string of transaction hash.
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -446,7 +1170,7 @@ type SendTransactionResponse struct {
 func (m *SendTransactionResponse) Reset()                    { *m = SendTransactionResponse{} }
 func (m *SendTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendTransactionResponse) ProtoMessage()               {}
-func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{8} }
+func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{29} }
 
 func (m *SendTransactionResponse) GetHash() string {
 	if m != nil {
@@ -465,7 +1189,7 @@ type TransactionData struct {
 func (m *TransactionData) Reset()                    { *m = TransactionData{} }
 func (m *TransactionData) String() string            { return proto.CompactTextString(m) }
 func (*TransactionData) ProtoMessage()               {}
-func (*TransactionData) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{9} }
+func (*TransactionData) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{30} }
 
 func (m *TransactionData) GetType() string {
 	if m != nil {
@@ -504,12 +1228,14 @@ type TransactionResponse struct {
 	Sign string `protobuf:"bytes,10,opt,name=sign,proto3" json:"sign,omitempty"`
 	// Transaction payer's sign.
 	PayerSign string `protobuf:"bytes,11,opt,name=payer_sign,json=payerSign,proto3" json:"payer_sign,omitempty"`
+	// Partial signs of multisig owners.
+	MultiSign []string `protobuf:"bytes,12,rep,name=multi_sign,json=multiSign" json:"multi_sign,omitempty"`
 }
 
 func (m *TransactionResponse) Reset()                    { *m = TransactionResponse{} }
 func (m *TransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*TransactionResponse) ProtoMessage()               {}
-func (*TransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{10} }
+func (*TransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{31} }
 
 func (m *TransactionResponse) GetHash() string {
 	if m != nil {
@@ -588,13 +1314,41 @@ func (m *TransactionResponse) GetPayerSign() string {
 	return ""
 }
 
+func (m *TransactionResponse) GetMultiSign() []string {
+	if m != nil {
+		return m.MultiSign
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
 	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
 	proto.RegisterType((*GetBlockRequest)(nil), "rpcpb.GetBlockRequest")
 	proto.RegisterType((*BlockResponse)(nil), "rpcpb.BlockResponse")
+	proto.RegisterType((*GetIssuerRequest)(nil), "rpcpb.GetIssuerRequest")
+	proto.RegisterType((*GetIssuerResponse)(nil), "rpcpb.GetIssuerResponse")
 	proto.RegisterType((*NonParamsRequest)(nil), "rpcpb.NonParamsRequest")
 	proto.RegisterType((*GetMedStateResponse)(nil), "rpcpb.GetMedStateResponse")
+	proto.RegisterType((*GetMultisigRequest)(nil), "rpcpb.GetMultisigRequest")
+	proto.RegisterType((*GetMultisigResponse)(nil), "rpcpb.GetMultisigResponse")
+	proto.RegisterType((*MultisigOwner)(nil), "rpcpb.MultisigOwner")
+	proto.RegisterType((*GetCandidateRequest)(nil), "rpcpb.GetCandidateRequest")
+	proto.RegisterType((*GetCandidateResponse)(nil), "rpcpb.GetCandidateResponse")
+	proto.RegisterType((*GetEvidencesResponse)(nil), "rpcpb.GetEvidencesResponse")
+	proto.RegisterType((*Evidence)(nil), "rpcpb.Evidence")
+	proto.RegisterType((*GetFinalityCertificateRequest)(nil), "rpcpb.GetFinalityCertificateRequest")
+	proto.RegisterType((*GetFinalityCertificateResponse)(nil), "rpcpb.GetFinalityCertificateResponse")
+	proto.RegisterType((*FinalityVote)(nil), "rpcpb.FinalityVote")
+	proto.RegisterType((*GetLivenessResponse)(nil), "rpcpb.GetLivenessResponse")
+	proto.RegisterType((*ProposerLiveness)(nil), "rpcpb.ProposerLiveness")
+	proto.RegisterType((*GetProposalRequest)(nil), "rpcpb.GetProposalRequest")
+	proto.RegisterType((*GetProposalResponse)(nil), "rpcpb.GetProposalResponse")
+	proto.RegisterType((*ParamChange)(nil), "rpcpb.ParamChange")
+	proto.RegisterType((*ProposalVote)(nil), "rpcpb.ProposalVote")
+	proto.RegisterType((*GetReservedTasksRequest)(nil), "rpcpb.GetReservedTasksRequest")
+	proto.RegisterType((*GetReservedTasksResponse)(nil), "rpcpb.GetReservedTasksResponse")
+	proto.RegisterType((*ReservedTask)(nil), "rpcpb.ReservedTask")
 	proto.RegisterType((*GetTransactionRequest)(nil), "rpcpb.GetTransactionRequest")
 	proto.RegisterType((*SendTransactionRequest)(nil), "rpcpb.SendTransactionRequest")
 	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
@@ -615,9 +1369,18 @@ const _ = grpc.SupportPackageIsVersion4
 type ApiServiceClient interface {
 	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
 	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	GetCandidate(ctx context.Context, in *GetCandidateRequest, opts ...grpc.CallOption) (*GetCandidateResponse, error)
+	GetEvidences(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetEvidencesResponse, error)
+	GetFinalityCertificate(ctx context.Context, in *GetFinalityCertificateRequest, opts ...grpc.CallOption) (*GetFinalityCertificateResponse, error)
+	GetIssuer(ctx context.Context, in *GetIssuerRequest, opts ...grpc.CallOption) (*GetIssuerResponse, error)
+	GetLiveness(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetLivenessResponse, error)
 	GetMedState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetMedStateResponse, error)
+	GetMultisig(ctx context.Context, in *GetMultisigRequest, opts ...grpc.CallOption) (*GetMultisigResponse, error)
+	GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*GetProposalResponse, error)
+	GetReservedTasks(ctx context.Context, in *GetReservedTasksRequest, opts ...grpc.CallOption) (*GetReservedTasksResponse, error)
 	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	SendTransaction(ctx context.Context, in *SendTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	SponsorTransaction(ctx context.Context, in *SendTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
 }
 
 type apiServiceClient struct {
@@ -646,6 +1409,51 @@ func (c *apiServiceClient) GetBlock(ctx context.Context, in *GetBlockRequest, op
 	return out, nil
 }
 
+func (c *apiServiceClient) GetCandidate(ctx context.Context, in *GetCandidateRequest, opts ...grpc.CallOption) (*GetCandidateResponse, error) {
+	out := new(GetCandidateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetCandidate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEvidences(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetEvidencesResponse, error) {
+	out := new(GetEvidencesResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEvidences", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetFinalityCertificate(ctx context.Context, in *GetFinalityCertificateRequest, opts ...grpc.CallOption) (*GetFinalityCertificateResponse, error) {
+	out := new(GetFinalityCertificateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetFinalityCertificate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetIssuer(ctx context.Context, in *GetIssuerRequest, opts ...grpc.CallOption) (*GetIssuerResponse, error) {
+	out := new(GetIssuerResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetIssuer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetLiveness(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetLivenessResponse, error) {
+	out := new(GetLivenessResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetLiveness", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetMedState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetMedStateResponse, error) {
 	out := new(GetMedStateResponse)
 	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetMedState", in, out, c.cc, opts...)
@@ -655,6 +1463,33 @@ func (c *apiServiceClient) GetMedState(ctx context.Context, in *NonParamsRequest
 	return out, nil
 }
 
+func (c *apiServiceClient) GetMultisig(ctx context.Context, in *GetMultisigRequest, opts ...grpc.CallOption) (*GetMultisigResponse, error) {
+	out := new(GetMultisigResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetMultisig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*GetProposalResponse, error) {
+	out := new(GetProposalResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetProposal", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetReservedTasks(ctx context.Context, in *GetReservedTasksRequest, opts ...grpc.CallOption) (*GetReservedTasksResponse, error) {
+	out := new(GetReservedTasksResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetReservedTasks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
 	out := new(TransactionResponse)
 	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransaction", in, out, c.cc, opts...)
@@ -673,14 +1508,32 @@ func (c *apiServiceClient) SendTransaction(ctx context.Context, in *SendTransact
 	return out, nil
 }
 
+func (c *apiServiceClient) SponsorTransaction(ctx context.Context, in *SendTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SponsorTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ApiService service
 
 type ApiServiceServer interface {
 	GetAccountState(context.Context, *GetAccountStateRequest) (*GetAccountStateResponse, error)
 	GetBlock(context.Context, *GetBlockRequest) (*BlockResponse, error)
+	GetCandidate(context.Context, *GetCandidateRequest) (*GetCandidateResponse, error)
+	GetEvidences(context.Context, *NonParamsRequest) (*GetEvidencesResponse, error)
+	GetFinalityCertificate(context.Context, *GetFinalityCertificateRequest) (*GetFinalityCertificateResponse, error)
+	GetIssuer(context.Context, *GetIssuerRequest) (*GetIssuerResponse, error)
+	GetLiveness(context.Context, *NonParamsRequest) (*GetLivenessResponse, error)
 	GetMedState(context.Context, *NonParamsRequest) (*GetMedStateResponse, error)
+	GetMultisig(context.Context, *GetMultisigRequest) (*GetMultisigResponse, error)
+	GetProposal(context.Context, *GetProposalRequest) (*GetProposalResponse, error)
+	GetReservedTasks(context.Context, *GetReservedTasksRequest) (*GetReservedTasksResponse, error)
 	GetTransaction(context.Context, *GetTransactionRequest) (*TransactionResponse, error)
 	SendTransaction(context.Context, *SendTransactionRequest) (*SendTransactionResponse, error)
+	SponsorTransaction(context.Context, *SendTransactionRequest) (*SendTransactionResponse, error)
 }
 
 func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
@@ -723,6 +1576,96 @@ func _ApiService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetCandidate(ctx, req.(*GetCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetEvidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetEvidences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetEvidences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetEvidences(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetFinalityCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFinalityCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetFinalityCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetFinalityCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetFinalityCertificate(ctx, req.(*GetFinalityCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetIssuer(ctx, req.(*GetIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetLiveness(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetMedState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NonParamsRequest)
 	if err := dec(in); err != nil {
@@ -741,6 +1684,60 @@ func _ApiService_GetMedState_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetMultisig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMultisigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetMultisig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetMultisig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetMultisig(ctx, req.(*GetMultisigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetProposal(ctx, req.(*GetProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetReservedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReservedTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetReservedTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetReservedTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetReservedTasks(ctx, req.(*GetReservedTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionRequest)
 	if err := dec(in); err != nil {
@@ -777,6 +1774,24 @@ func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_SponsorTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SponsorTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/SponsorTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SponsorTransaction(ctx, req.(*SendTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApiService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.ApiService",
 	HandlerType: (*ApiServiceServer)(nil),
@@ -789,10 +1804,42 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlock",
 			Handler:    _ApiService_GetBlock_Handler,
 		},
+		{
+			MethodName: "GetCandidate",
+			Handler:    _ApiService_GetCandidate_Handler,
+		},
+		{
+			MethodName: "GetEvidences",
+			Handler:    _ApiService_GetEvidences_Handler,
+		},
+		{
+			MethodName: "GetFinalityCertificate",
+			Handler:    _ApiService_GetFinalityCertificate_Handler,
+		},
+		{
+			MethodName: "GetIssuer",
+			Handler:    _ApiService_GetIssuer_Handler,
+		},
+		{
+			MethodName: "GetLiveness",
+			Handler:    _ApiService_GetLiveness_Handler,
+		},
 		{
 			MethodName: "GetMedState",
 			Handler:    _ApiService_GetMedState_Handler,
 		},
+		{
+			MethodName: "GetMultisig",
+			Handler:    _ApiService_GetMultisig_Handler,
+		},
+		{
+			MethodName: "GetProposal",
+			Handler:    _ApiService_GetProposal_Handler,
+		},
+		{
+			MethodName: "GetReservedTasks",
+			Handler:    _ApiService_GetReservedTasks_Handler,
+		},
 		{
 			MethodName: "GetTransaction",
 			Handler:    _ApiService_GetTransaction_Handler,
@@ -801,6 +1848,10 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendTransaction",
 			Handler:    _ApiService_SendTransaction_Handler,
 		},
+		{
+			MethodName: "SponsorTransaction",
+			Handler:    _ApiService_SponsorTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
@@ -809,57 +1860,114 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("rpc.proto", fileDescriptorRpc) }
 
 var fileDescriptorRpc = []byte{
-	// 819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xdd, 0x8e, 0xeb, 0x34,
-	0x10, 0x56, 0x7f, 0x76, 0xb7, 0x99, 0x6e, 0xdb, 0x5d, 0xef, 0x9e, 0x36, 0x27, 0x6c, 0xa1, 0x44,
-	0x3a, 0x52, 0x39, 0x88, 0xad, 0x58, 0xee, 0xb8, 0x00, 0x1d, 0x84, 0x54, 0x7e, 0x04, 0x42, 0x29,
-	0xe2, 0xee, 0xa8, 0x72, 0x1d, 0xd3, 0x46, 0xa4, 0x76, 0x88, 0xdd, 0xea, 0xec, 0x2d, 0xaf, 0xc0,
-	0x13, 0x20, 0x24, 0x9e, 0x81, 0xf7, 0xe0, 0x15, 0x78, 0x0c, 0x2e, 0x90, 0xc7, 0x4e, 0x9b, 0xfe,
-	0xa1, 0xbd, 0xe7, 0xce, 0x33, 0xf3, 0xe5, 0x9b, 0x89, 0xe7, 0x9b, 0x31, 0x78, 0x79, 0xc6, 0xee,
-	0xb3, 0x5c, 0x6a, 0x49, 0xce, 0xf2, 0x8c, 0x65, 0xb3, 0xe0, 0x6e, 0x2e, 0xe5, 0x3c, 0xe5, 0x23,
-	0x9a, 0x25, 0x23, 0x2a, 0x84, 0xd4, 0x54, 0x27, 0x52, 0x28, 0x0b, 0x0a, 0xbf, 0x82, 0xee, 0x98,
-	0xeb, 0x57, 0x8c, 0xc9, 0x95, 0xd0, 0x13, 0x4d, 0x35, 0x8f, 0xf8, 0xcf, 0x2b, 0xae, 0x34, 0xf1,
-	0xe1, 0x82, 0xc6, 0x71, 0xce, 0x95, 0xf2, 0x2b, 0x83, 0xca, 0xd0, 0x8b, 0x0a, 0x93, 0x74, 0xe1,
-	0x7c, 0xc1, 0x93, 0xf9, 0x42, 0xfb, 0x55, 0x0c, 0x38, 0x2b, 0x7c, 0x0d, 0xbd, 0x03, 0x2e, 0x95,
-	0x49, 0xa1, 0xb8, 0x21, 0x9b, 0xd1, 0x94, 0x0a, 0xc6, 0x0b, 0x32, 0x67, 0x92, 0x5b, 0x38, 0x13,
-	0xd2, 0xf8, 0x0d, 0x57, 0x3d, 0xb2, 0x06, 0x21, 0x50, 0xd7, 0x8f, 0x19, 0xf7, 0x6b, 0x83, 0xca,
-	0xb0, 0x15, 0xe1, 0x39, 0x7c, 0x01, 0x9d, 0x31, 0xd7, 0x9f, 0xa5, 0x92, 0xfd, 0x54, 0xd4, 0x48,
-	0xa0, 0xbe, 0xa0, 0x6a, 0xe1, 0x38, 0xf1, 0x1c, 0xfe, 0x59, 0x83, 0x96, 0x03, 0xb9, 0xe4, 0x47,
-	0x50, 0xe4, 0x1d, 0x68, 0x66, 0x34, 0xe7, 0x42, 0x4f, 0x31, 0x64, 0x7f, 0x04, 0xac, 0xeb, 0x0b,
-	0x03, 0x08, 0xa0, 0xc1, 0x64, 0x22, 0x66, 0x54, 0xd9, 0x2a, 0xbc, 0x68, 0x63, 0x93, 0x3b, 0xf0,
-	0x74, 0xb2, 0xe4, 0x4a, 0xd3, 0x65, 0xe6, 0xd7, 0x07, 0x95, 0x61, 0x2d, 0xda, 0x3a, 0xc8, 0x73,
-	0x68, 0xb0, 0x05, 0x4d, 0xc4, 0x34, 0x89, 0xfd, 0x33, 0xac, 0xff, 0x02, 0xed, 0x2f, 0x63, 0x72,
-	0x05, 0x35, 0x9a, 0xce, 0xfd, 0x73, 0xf4, 0x9a, 0xa3, 0xa9, 0x4d, 0x25, 0x73, 0xe1, 0x5f, 0xd8,
-	0xda, 0xcc, 0x99, 0xbc, 0x05, 0x1e, 0x65, 0x4c, 0x4d, 0x73, 0x29, 0xb5, 0xdf, 0xb0, 0xb9, 0x8d,
-	0x23, 0x92, 0x52, 0x1b, 0x76, 0xfd, 0xc6, 0xc5, 0x3c, 0x7b, 0x95, 0xfa, 0x8d, 0x0d, 0xf5, 0x01,
-	0x56, 0x8a, 0xce, 0xb9, 0x0d, 0x02, 0x06, 0x3d, 0xf4, 0x60, 0xf8, 0x5d, 0xb8, 0xcc, 0x39, 0x93,
-	0x79, 0xec, 0xbe, 0x6e, 0x22, 0xa0, 0xe9, 0x7c, 0x08, 0x79, 0x01, 0x6d, 0x66, 0xae, 0x4c, 0xa8,
-	0x95, 0x03, 0x5d, 0x22, 0xa8, 0xb5, 0xf1, 0x22, 0xec, 0x13, 0xb8, 0xd4, 0x39, 0x15, 0x8a, 0x32,
-	0x94, 0x92, 0xdf, 0x1a, 0xd4, 0x86, 0xcd, 0x87, 0xe0, 0x1e, 0x05, 0x77, 0xff, 0xfd, 0x36, 0x54,
-	0xb4, 0x20, 0xda, 0xc1, 0x97, 0x04, 0xd4, 0xc6, 0xa6, 0x17, 0x02, 0x22, 0x70, 0xf5, 0xad, 0x14,
-	0xdf, 0xd1, 0x9c, 0x2e, 0x95, 0x6b, 0x71, 0xf8, 0x5b, 0x05, 0x6e, 0xc6, 0x5c, 0x7f, 0xc3, 0xe3,
-	0x5d, 0x45, 0x95, 0x6f, 0xb9, 0xb2, 0x7b, 0xcb, 0x46, 0x3c, 0x34, 0x49, 0x5d, 0x53, 0xf1, 0x5c,
-	0x4a, 0x59, 0x2b, 0xa7, 0x24, 0xef, 0xc1, 0x15, 0x0e, 0x02, 0x93, 0xe9, 0x74, 0xcd, 0x73, 0x95,
-	0xc8, 0xa2, 0x17, 0x9d, 0xc2, 0xff, 0x83, 0x75, 0x1b, 0x0d, 0x17, 0x08, 0xdb, 0x94, 0xc2, 0x0c,
-	0xdf, 0x87, 0x67, 0x63, 0xae, 0x77, 0xfe, 0xfb, 0xb4, 0x3e, 0xff, 0xa8, 0x42, 0x77, 0xc2, 0x45,
-	0xfc, 0x34, 0xb8, 0xf1, 0xfd, 0x98, 0xcb, 0x65, 0xf1, 0x33, 0xe6, 0x4c, 0xda, 0x50, 0xd5, 0xd2,
-	0xa9, 0xb2, 0xaa, 0xa5, 0x99, 0xa1, 0x35, 0x4d, 0x57, 0x1c, 0xb5, 0xe8, 0x45, 0xd6, 0xd8, 0x55,
-	0xe9, 0xd9, 0xbe, 0x4a, 0x5f, 0x42, 0x3d, 0xa6, 0x9a, 0xa2, 0x16, 0x9b, 0x0f, 0xdd, 0xc3, 0xde,
-	0x7d, 0x4e, 0x35, 0x8d, 0x10, 0xb3, 0x9d, 0xd1, 0x8b, 0xf2, 0x8c, 0x96, 0x3b, 0xd0, 0x38, 0xaa,
-	0x73, 0xef, 0x50, 0xe7, 0x50, 0xd2, 0x79, 0x1f, 0x20, 0xa3, 0x8f, 0x3c, 0x9f, 0x62, 0xc4, 0xca,
-	0xd1, 0x43, 0xcf, 0x24, 0x99, 0x8b, 0xf0, 0x03, 0xe8, 0x1d, 0xdc, 0xd3, 0xe9, 0x89, 0x0e, 0x3f,
-	0x85, 0xce, 0x5e, 0xf5, 0x9b, 0x2d, 0xe2, 0x60, 0xe6, 0x6c, 0xba, 0x98, 0xd1, 0xc7, 0x54, 0xd2,
-	0xd8, 0x5d, 0x69, 0x61, 0x86, 0xbf, 0x57, 0xe1, 0xe6, 0x89, 0xc9, 0xfe, 0xbf, 0x5d, 0x79, 0xf8,
-	0xa7, 0x06, 0xf0, 0x2a, 0x4b, 0x26, 0x3c, 0x5f, 0x27, 0x8c, 0x13, 0x89, 0x4b, 0xb9, 0xbc, 0xf3,
-	0x49, 0xdf, 0x55, 0x7d, 0xfc, 0x5d, 0x09, 0xde, 0x3e, 0x15, 0xb6, 0xd7, 0x1d, 0xf6, 0x7f, 0xf9,
-	0xeb, 0xef, 0x5f, 0xab, 0x3d, 0xf2, 0x6c, 0xb4, 0xfe, 0x70, 0xb4, 0x52, 0x3c, 0x1f, 0x51, 0x0b,
-	0x53, 0xc8, 0xfe, 0x35, 0x34, 0x8a, 0x57, 0x80, 0x74, 0xb7, 0x54, 0xe5, 0x67, 0x21, 0xb8, 0x75,
-	0xfe, 0x9d, 0x67, 0x20, 0xbc, 0x46, 0xe2, 0x26, 0xf1, 0x0c, 0xf1, 0x0c, 0x09, 0x5e, 0x43, 0xb3,
-	0xb4, 0x5b, 0x48, 0xcf, 0x7d, 0xb7, 0xbf, 0x84, 0x82, 0x60, 0x9b, 0x68, 0x7f, 0x11, 0x85, 0xcf,
-	0x91, 0xf6, 0x86, 0x5c, 0x1b, 0x5a, 0x21, 0x63, 0x3e, 0x5a, 0xf2, 0xd8, 0xd6, 0xca, 0xa0, 0xbd,
-	0xbb, 0x17, 0xc8, 0xdd, 0x96, 0xe8, 0x70, 0xfe, 0x83, 0xff, 0xd8, 0xa0, 0x61, 0x0f, 0xd3, 0x5c,
-	0x93, 0x8e, 0x49, 0x53, 0xda, 0xa6, 0x24, 0x85, 0xce, 0xde, 0x98, 0x6c, 0x3a, 0x70, 0x7c, 0xcd,
-	0x6c, 0x3a, 0x70, 0x62, 0xba, 0xc2, 0x00, 0x53, 0xdd, 0x86, 0xfb, 0xa9, 0x3e, 0xae, 0xbc, 0x9c,
-	0x9d, 0xe3, 0x56, 0xfc, 0xe8, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x81, 0x84, 0xaa, 0x68,
-	0x08, 0x00, 0x00,
+	// 1739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0xe4, 0x48,
+	0x15, 0x97, 0xbb, 0xf3, 0xd1, 0xfd, 0xba, 0x3b, 0x9d, 0x54, 0x32, 0x89, 0xc7, 0x3b, 0x99, 0x0d,
+	0x25, 0x06, 0x65, 0x76, 0x67, 0xa7, 0xb5, 0x33, 0x07, 0x24, 0x0e, 0x8b, 0x86, 0x61, 0x08, 0xc3,
+	0xe7, 0xca, 0x89, 0x56, 0x08, 0xb4, 0x34, 0x15, 0xbb, 0xd2, 0xb1, 0xd6, 0xed, 0x32, 0x55, 0xd5,
+	0x3d, 0x1b, 0x21, 0x0e, 0xec, 0x1d, 0x21, 0xc1, 0x99, 0x03, 0x7f, 0x09, 0x12, 0x12, 0x77, 0x24,
+	0xfe, 0x05, 0xfe, 0x0f, 0x50, 0x7d, 0xd9, 0xe5, 0xfe, 0x48, 0x72, 0xe0, 0xb8, 0x37, 0xbf, 0x0f,
+	0xff, 0xea, 0xd5, 0xab, 0x9f, 0xdf, 0x7b, 0x65, 0xe8, 0xf2, 0x32, 0x79, 0x5e, 0x72, 0x26, 0x19,
+	0xda, 0xe4, 0x65, 0x52, 0x5e, 0x46, 0x8f, 0x26, 0x8c, 0x4d, 0x72, 0x3a, 0x22, 0x65, 0x36, 0x22,
+	0x45, 0xc1, 0x24, 0x91, 0x19, 0x2b, 0x84, 0x71, 0xc2, 0x3f, 0x82, 0xc3, 0x33, 0x2a, 0x5f, 0x25,
+	0x09, 0x9b, 0x15, 0xf2, 0x5c, 0x12, 0x49, 0x63, 0xfa, 0xdb, 0x19, 0x15, 0x12, 0x85, 0xb0, 0x4d,
+	0xd2, 0x94, 0x53, 0x21, 0xc2, 0xe0, 0x24, 0x38, 0xed, 0xc6, 0x4e, 0x44, 0x87, 0xb0, 0x75, 0x4d,
+	0xb3, 0xc9, 0xb5, 0x0c, 0x5b, 0xda, 0x60, 0x25, 0xfc, 0x39, 0x1c, 0x2d, 0x61, 0x89, 0x92, 0x15,
+	0x82, 0x2a, 0xb0, 0x4b, 0x92, 0x93, 0x22, 0xa1, 0x0e, 0xcc, 0x8a, 0xe8, 0x00, 0x36, 0x0b, 0xa6,
+	0xf4, 0x0a, 0x6b, 0x23, 0x36, 0x02, 0x42, 0xb0, 0x21, 0x6f, 0x4a, 0x1a, 0xb6, 0x4f, 0x82, 0xd3,
+	0x41, 0xac, 0x9f, 0xf1, 0x13, 0x18, 0x9e, 0x51, 0xf9, 0xbd, 0x9c, 0x25, 0x5f, 0xb8, 0x18, 0x11,
+	0x6c, 0x5c, 0x13, 0x71, 0x6d, 0x31, 0xf5, 0x33, 0xfe, 0x57, 0x1b, 0x06, 0xd6, 0xc9, 0x2e, 0xbe,
+	0xc2, 0x0b, 0xbd, 0x0f, 0xbd, 0x92, 0x70, 0x5a, 0xc8, 0xb1, 0x36, 0x99, 0x8d, 0x80, 0x51, 0xfd,
+	0x50, 0x39, 0x44, 0xd0, 0x49, 0x58, 0x56, 0x5c, 0x12, 0x61, 0xa2, 0xe8, 0xc6, 0x95, 0x8c, 0x1e,
+	0x41, 0x57, 0x66, 0x53, 0x2a, 0x24, 0x99, 0x96, 0xe1, 0xc6, 0x49, 0x70, 0xda, 0x8e, 0x6b, 0x05,
+	0x7a, 0x08, 0x9d, 0xe4, 0x9a, 0x64, 0xc5, 0x38, 0x4b, 0xc3, 0x4d, 0x1d, 0xff, 0xb6, 0x96, 0xdf,
+	0xa6, 0x68, 0x17, 0xda, 0x24, 0x9f, 0x84, 0x5b, 0x5a, 0xab, 0x1e, 0x55, 0x6c, 0x22, 0x9b, 0x14,
+	0xe1, 0xb6, 0x89, 0x4d, 0x3d, 0xa3, 0xf7, 0xa0, 0x4b, 0x92, 0x44, 0x8c, 0x39, 0x63, 0x32, 0xec,
+	0x98, 0xb5, 0x95, 0x22, 0x66, 0x4c, 0x2a, 0x74, 0xf9, 0xa5, 0xb5, 0x75, 0x4d, 0x2a, 0xe5, 0x97,
+	0xc6, 0x74, 0x0c, 0x30, 0x13, 0x64, 0x42, 0x8d, 0x11, 0xb4, 0xb1, 0xab, 0x35, 0xda, 0xfc, 0x0d,
+	0xe8, 0x73, 0x9a, 0x30, 0x9e, 0xda, 0xb7, 0x7b, 0xda, 0xa1, 0x67, 0x75, 0xda, 0xe5, 0x09, 0xec,
+	0x24, 0x2a, 0x65, 0x85, 0x98, 0x59, 0xa7, 0xbe, 0x76, 0x1a, 0x54, 0x5a, 0xed, 0xf6, 0x09, 0xf4,
+	0x25, 0x27, 0x85, 0x20, 0x89, 0xa6, 0x52, 0x38, 0x38, 0x69, 0x9f, 0xf6, 0x5e, 0x44, 0xcf, 0x35,
+	0xe1, 0x9e, 0x5f, 0xd4, 0x26, 0x77, 0x04, 0x71, 0xc3, 0xdf, 0x23, 0xd0, 0x8e, 0x3e, 0x74, 0x2b,
+	0x29, 0xbd, 0x98, 0x95, 0x65, 0x7e, 0x13, 0x0e, 0x0d, 0xb1, 0x8c, 0x84, 0x9f, 0xc1, 0xee, 0x19,
+	0x95, 0x6f, 0x85, 0x98, 0x51, 0x7e, 0x27, 0x3d, 0xf1, 0xdf, 0x03, 0xd8, 0xf3, 0xdc, 0x6b, 0x06,
+	0xae, 0xa1, 0x33, 0x82, 0x8d, 0x82, 0x4c, 0xa9, 0xe5, 0x80, 0x7e, 0x56, 0xa9, 0x4c, 0x28, 0x97,
+	0x63, 0x45, 0x3c, 0x11, 0xb6, 0x4f, 0xda, 0x2a, 0x95, 0x4a, 0x73, 0xa1, 0x14, 0xea, 0x15, 0x9d,
+	0x1d, 0x75, 0xf6, 0x9d, 0x58, 0x3f, 0x2b, 0x52, 0x70, 0x3a, 0xc9, 0x84, 0xe4, 0x84, 0xeb, 0x73,
+	0xef, 0xc6, 0xb5, 0x02, 0x7d, 0x08, 0x7b, 0x4e, 0x50, 0x39, 0x18, 0x2b, 0xba, 0x68, 0x1e, 0xb4,
+	0xe3, 0x5d, 0xdf, 0x70, 0x91, 0x4d, 0x29, 0x46, 0xb0, 0xfb, 0x33, 0x56, 0x7c, 0x4a, 0x38, 0x99,
+	0x0a, 0xbb, 0x5f, 0xfc, 0xb7, 0x00, 0xf6, 0xcf, 0xa8, 0xfc, 0x29, 0x4d, 0x9b, 0x5f, 0x96, 0xcf,
+	0xb6, 0xa0, 0xc9, 0x36, 0xf5, 0x11, 0x91, 0x2c, 0x77, 0x1b, 0x53, 0xcf, 0x5e, 0xea, 0xdb, 0x8d,
+	0xd4, 0x3f, 0x85, 0x5d, 0x5d, 0x10, 0x12, 0x96, 0x8f, 0xe7, 0x94, 0x8b, 0x8c, 0x39, 0x4e, 0x0e,
+	0x9d, 0xfe, 0x33, 0xa3, 0x56, 0x99, 0x74, 0x1e, 0x86, 0x9c, 0x4e, 0xc4, 0xcf, 0x01, 0xa9, 0x10,
+	0x67, 0xb9, 0xcc, 0x44, 0x36, 0xb9, 0xfb, 0xa4, 0x7e, 0x07, 0xfb, 0x0d, 0xff, 0x3b, 0x8f, 0x4a,
+	0x7d, 0x78, 0xd7, 0x9c, 0x8a, 0x6b, 0x96, 0xa7, 0x7a, 0x5b, 0x83, 0xb8, 0x56, 0xa0, 0x67, 0xb0,
+	0xc5, 0xde, 0x15, 0x94, 0x9b, 0x03, 0xeb, 0xbd, 0x38, 0xb0, 0x84, 0x74, 0x0b, 0xfc, 0x5c, 0x19,
+	0x63, 0xeb, 0x83, 0x5f, 0xc1, 0xa0, 0x61, 0xb8, 0xbd, 0xe0, 0xbd, 0xab, 0x0b, 0xde, 0x20, 0xb6,
+	0x12, 0x1e, 0xe9, 0xf8, 0x5f, 0x93, 0x22, 0xcd, 0xd2, 0xfb, 0x54, 0x4e, 0xfc, 0xa7, 0x00, 0x0e,
+	0x9a, 0x6f, 0xdc, 0xb9, 0xe5, 0xc7, 0x00, 0x09, 0xcb, 0x73, 0x22, 0x29, 0x27, 0xee, 0x28, 0x3d,
+	0x4d, 0xc5, 0xde, 0xb6, 0xc7, 0xde, 0x5d, 0x68, 0xcf, 0x78, 0xae, 0xd9, 0xd9, 0x8d, 0xd5, 0x23,
+	0x3a, 0x82, 0xed, 0x92, 0x52, 0xee, 0x4a, 0x52, 0x37, 0xde, 0x52, 0xe2, 0xdb, 0x14, 0xbf, 0xd1,
+	0x01, 0xbd, 0x99, 0x67, 0x29, 0x2d, 0x12, 0x2a, 0xaa, 0x80, 0x3e, 0x82, 0x2e, 0x75, 0xca, 0x30,
+	0xd0, 0xe9, 0x1c, 0xda, 0x74, 0x3a, 0xe7, 0xb8, 0xf6, 0xc0, 0x7f, 0x0e, 0xa0, 0xe3, 0xf4, 0x2b,
+	0xeb, 0x6d, 0x04, 0x1d, 0x76, 0x75, 0x45, 0x8b, 0x94, 0x72, 0xbb, 0x89, 0x4a, 0x6e, 0x96, 0xd3,
+	0xf6, 0x62, 0x39, 0x8d, 0xa0, 0xe3, 0xd6, 0xb1, 0x3b, 0xaa, 0x64, 0xf5, 0xa6, 0x98, 0x5d, 0x4e,
+	0x33, 0x29, 0xa9, 0xd9, 0x58, 0x27, 0xae, 0x15, 0xf8, 0x25, 0x1c, 0x9f, 0x51, 0xf9, 0x83, 0xac,
+	0x20, 0x79, 0x26, 0x6f, 0x5e, 0x53, 0x2e, 0xb3, 0xab, 0x2c, 0xf1, 0x0e, 0x6a, 0x55, 0xfb, 0xf8,
+	0x6b, 0x00, 0x8f, 0xd7, 0xbd, 0x65, 0x73, 0x73, 0x0c, 0x70, 0xa9, 0x1a, 0xcc, 0xd8, 0x7b, 0xb9,
+	0xab, 0x35, 0xba, 0x73, 0x34, 0xdb, 0x63, 0xfd, 0x89, 0xf9, 0x5f, 0x6a, 0xbb, 0xf9, 0xa5, 0x3e,
+	0x85, 0xcd, 0x39, 0x93, 0x54, 0x84, 0x1b, 0x3a, 0xd3, 0xfb, 0x36, 0xd3, 0x2e, 0x88, 0xcf, 0x98,
+	0xa4, 0xb1, 0xf1, 0xc0, 0x17, 0xd0, 0xf7, 0xd5, 0xaa, 0x7f, 0x2a, 0x03, 0xb7, 0x71, 0x18, 0xc1,
+	0x35, 0x9a, 0x56, 0xdd, 0x68, 0x54, 0xaa, 0xb2, 0x49, 0x41, 0xe4, 0x8c, 0x3b, 0xb2, 0xd4, 0x0a,
+	0xfc, 0x7b, 0xcd, 0xe4, 0x9f, 0x64, 0x73, 0x5a, 0x50, 0x51, 0xb3, 0xe0, 0x63, 0xd8, 0x4e, 0x66,
+	0x5c, 0xf5, 0x44, 0xcb, 0x81, 0x23, 0x1b, 0xd9, 0xa7, 0x9c, 0x95, 0x4c, 0x50, 0x5e, 0xbd, 0xe1,
+	0xfc, 0xd0, 0x4b, 0xe8, 0x94, 0x9c, 0xce, 0x33, 0x36, 0x13, 0x61, 0xeb, 0xf6, 0x77, 0x2a, 0x47,
+	0xfc, 0x1b, 0xd8, 0x5d, 0xb4, 0xde, 0xf2, 0x49, 0x44, 0x6a, 0x09, 0x96, 0xce, 0x12, 0x9a, 0xda,
+	0x14, 0x57, 0xb2, 0x4a, 0xfe, 0x34, 0x13, 0x82, 0xa6, 0xae, 0xbe, 0x19, 0x09, 0x9f, 0xea, 0xd2,
+	0x64, 0x16, 0x21, 0xf9, 0x6d, 0x04, 0xf8, 0xaf, 0x29, 0xb4, 0xb5, 0xeb, 0x2d, 0x53, 0x84, 0x89,
+	0x44, 0xc7, 0xed, 0x58, 0xed, 0x64, 0xf4, 0x0c, 0xd4, 0xf1, 0x16, 0x13, 0xea, 0xca, 0x11, 0x72,
+	0x79, 0x50, 0x75, 0xfd, 0xb5, 0x36, 0xc5, 0xce, 0x45, 0xcd, 0x23, 0x86, 0xb8, 0xa6, 0x33, 0x98,
+	0xa1, 0x02, 0x8c, 0x4a, 0xf5, 0x04, 0xf4, 0x2d, 0x18, 0xce, 0x99, 0xcc, 0x8a, 0xc9, 0x98, 0x16,
+	0xa9, 0x71, 0xda, 0xd4, 0x4e, 0x03, 0xa3, 0x7e, 0x53, 0xa4, 0xda, 0x4f, 0xf5, 0x50, 0x49, 0xe4,
+	0x4c, 0x84, 0x5b, 0xb6, 0x87, 0x6a, 0xa9, 0xa6, 0xd8, 0x76, 0x83, 0x62, 0x6e, 0x9b, 0x3e, 0xc5,
+	0xbe, 0x0d, 0x3d, 0x2f, 0xc6, 0xaa, 0xc2, 0x04, 0x5e, 0x85, 0x51, 0xac, 0x23, 0xf9, 0xcc, 0x35,
+	0x4d, 0x23, 0xe0, 0x4f, 0xa0, 0xef, 0xe3, 0xad, 0xe1, 0xa6, 0x3a, 0xd8, 0xb2, 0xe4, 0x6c, 0x6e,
+	0xde, 0xee, 0xc4, 0x4e, 0xc4, 0x2f, 0xf5, 0x00, 0x19, 0x53, 0x41, 0xf9, 0x9c, 0xa6, 0x17, 0x44,
+	0x7c, 0x21, 0xee, 0xae, 0xa9, 0x6f, 0x20, 0x5c, 0x7e, 0xc9, 0x9e, 0xd9, 0x53, 0xd8, 0x94, 0x4a,
+	0x11, 0x06, 0x8d, 0x4d, 0xfb, 0xce, 0xb1, 0xf1, 0xc0, 0x5f, 0x05, 0xd0, 0xf7, 0xf5, 0x2b, 0xcf,
+	0xdb, 0x8d, 0xa5, 0xae, 0xa3, 0xde, 0x94, 0x3a, 0x3d, 0x57, 0x9c, 0x4d, 0x5d, 0x01, 0x56, 0xcf,
+	0x2a, 0xda, 0x92, 0xdc, 0xe4, 0x8c, 0xa4, 0xb6, 0x64, 0x39, 0xb1, 0x59, 0xeb, 0x36, 0x17, 0x6a,
+	0x1d, 0xfe, 0x10, 0x1e, 0x9c, 0x51, 0xd9, 0x18, 0xa0, 0xd6, 0x13, 0xf5, 0x9f, 0x2d, 0x38, 0x3c,
+	0xa7, 0x45, 0x7a, 0x3f, 0xf7, 0x2a, 0xce, 0x96, 0x17, 0xe7, 0x0e, 0xb4, 0x24, 0xb3, 0x91, 0xb7,
+	0x24, 0xab, 0x8f, 0x75, 0xc3, 0x3b, 0xd6, 0xdb, 0x63, 0x46, 0x1f, 0xc0, 0x46, 0x4a, 0x24, 0xd1,
+	0x74, 0xeb, 0xbd, 0x38, 0x5c, 0x1e, 0x02, 0xbf, 0x4f, 0x24, 0x89, 0xb5, 0x4f, 0x3d, 0xec, 0x6f,
+	0xfb, 0xc3, 0xbe, 0x5f, 0x18, 0x3b, 0x2b, 0x07, 0xe6, 0xee, 0xf2, 0xc0, 0x0c, 0xde, 0xc0, 0x7c,
+	0x0c, 0x50, 0x92, 0x1b, 0xca, 0xc7, 0xda, 0x62, 0xe6, 0xda, 0xae, 0xd6, 0x9c, 0x5b, 0xf3, 0x54,
+	0x75, 0x7a, 0x63, 0xee, 0x9b, 0x61, 0x4e, 0x6b, 0x94, 0x19, 0x7f, 0x04, 0x47, 0x4b, 0x69, 0x5c,
+	0xff, 0xcd, 0xe3, 0xef, 0xc2, 0x70, 0x61, 0x73, 0x15, 0x2d, 0x02, 0x8f, 0x16, 0x1e, 0x05, 0x5a,
+	0x0d, 0x0a, 0xe0, 0x7f, 0xb4, 0x60, 0xff, 0x9e, 0x8b, 0x7d, 0x7d, 0x68, 0x6b, 0x0e, 0xed, 0xc5,
+	0x1f, 0x7b, 0x00, 0xaf, 0xca, 0xec, 0x9c, 0xf2, 0x79, 0x96, 0x50, 0xc4, 0x60, 0xb8, 0x70, 0xf5,
+	0x44, 0xc7, 0x76, 0x53, 0xab, 0xaf, 0xb7, 0xd1, 0xe3, 0x75, 0x66, 0x73, 0x1a, 0xf8, 0xf8, 0xab,
+	0x7f, 0xff, 0xe7, 0x2f, 0xad, 0x23, 0xf4, 0x60, 0x34, 0xff, 0x78, 0x34, 0x13, 0x94, 0x8f, 0x88,
+	0x71, 0x13, 0x1a, 0xfd, 0xc7, 0xd0, 0x71, 0x97, 0x51, 0x74, 0x58, 0x43, 0xf9, 0xb7, 0xd3, 0xc8,
+	0xcd, 0x9f, 0x8d, 0xdb, 0x28, 0xde, 0xd3, 0xc0, 0x3d, 0xd4, 0x55, 0xc0, 0x7a, 0x6a, 0x40, 0xbf,
+	0x86, 0xbe, 0x3f, 0x15, 0xa2, 0xa8, 0x06, 0x5c, 0x1c, 0x2e, 0xa3, 0xf7, 0x56, 0xda, 0x2c, 0xf6,
+	0x03, 0x8d, 0x3d, 0x44, 0x03, 0x85, 0x9d, 0x54, 0x78, 0xbf, 0xd2, 0xf8, 0xd5, 0x90, 0x87, 0x5c,
+	0x47, 0x5e, 0xbc, 0x64, 0xf8, 0xe0, 0x4b, 0x23, 0x61, 0x13, 0xbc, 0x1a, 0xfd, 0xd0, 0x1f, 0x02,
+	0xfd, 0x0b, 0x61, 0xc5, 0xc0, 0x84, 0xbe, 0x59, 0xc3, 0xad, 0x9f, 0xc2, 0xa2, 0x27, 0x77, 0x78,
+	0xd9, 0xe5, 0x23, 0xbd, 0xfc, 0x01, 0x42, 0x55, 0xde, 0x46, 0x57, 0xd6, 0x1d, 0xc5, 0xd0, 0xad,
+	0x6e, 0x7c, 0xd5, 0xee, 0x16, 0xaf, 0x8c, 0x51, 0xb8, 0x6c, 0xb0, 0xd8, 0x48, 0x63, 0xf7, 0x11,
+	0x28, 0xec, 0xcc, 0xc0, 0xfc, 0x02, 0x7a, 0xde, 0x48, 0xb4, 0x3e, 0x67, 0xde, 0x61, 0x2d, 0xce,
+	0x4f, 0xf8, 0x40, 0xe3, 0xee, 0xa0, 0xbe, 0xc2, 0xcd, 0x1d, 0xd4, 0xe7, 0xd0, 0xf3, 0x6e, 0x72,
+	0xf7, 0x42, 0x5e, 0xbc, 0xf6, 0xe1, 0x87, 0x1a, 0x79, 0x1f, 0xed, 0x29, 0xe4, 0x82, 0xa5, 0x74,
+	0x34, 0xa5, 0xa9, 0xa1, 0xe6, 0x2f, 0x0d, 0xbc, 0xbd, 0xdb, 0xa0, 0x87, 0x1e, 0x4a, 0xf3, 0x66,
+	0x16, 0x45, 0xab, 0x4c, 0xab, 0x42, 0x9f, 0x3a, 0x30, 0x83, 0xed, 0x9a, 0xbc, 0x8f, 0xbd, 0x30,
+	0x5a, 0x45, 0xd1, 0x2a, 0xd3, 0x2a, 0xec, 0xd2, 0x81, 0x31, 0x7d, 0xcb, 0x6f, 0x34, 0x72, 0xe4,
+	0x7d, 0xa5, 0xab, 0xc6, 0x82, 0xe8, 0xfd, 0xb5, 0xf6, 0x55, 0xac, 0xe1, 0xd6, 0x65, 0xac, 0x5b,
+	0x3e, 0x4a, 0x60, 0xa7, 0xd9, 0x6d, 0xd1, 0xa3, 0x1a, 0x6e, 0xb9, 0xab, 0x46, 0xb7, 0xfc, 0xe0,
+	0xc0, 0x47, 0x7a, 0x9d, 0x3d, 0x34, 0x54, 0xeb, 0x78, 0x3f, 0x3b, 0x50, 0x0e, 0xc3, 0x85, 0xee,
+	0x52, 0x55, 0xa6, 0xd5, 0xcd, 0x3b, 0x7a, 0xbc, 0xce, 0xdc, 0xdc, 0xd2, 0x77, 0x82, 0x0f, 0xf0,
+	0xd2, 0x6a, 0xef, 0x00, 0x9d, 0x2b, 0x2f, 0xc6, 0xff, 0x8f, 0x0b, 0x62, 0xbd, 0xe0, 0x23, 0xb5,
+	0xe0, 0xd1, 0xc2, 0x82, 0x23, 0x61, 0x56, 0xbb, 0xdc, 0xd2, 0x7f, 0x09, 0x5e, 0xfe, 0x2f, 0x00,
+	0x00, 0xff, 0xff, 0x08, 0xac, 0xea, 0x63, 0x80, 0x14, 0x00, 0x00,
 }
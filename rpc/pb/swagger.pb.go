@@ -43,6 +43,138 @@ const (
         ]
       }
     },
+    "/v1/block/finality": {
+      "get": {
+        "operationId": "GetFinalityCertificate",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetFinalityCertificateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "hash",
+            "description": "Hex string of the block hash.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/candidate": {
+      "get": {
+        "operationId": "GetCandidate",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetCandidateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "address",
+            "description": "Hex string of the candidate address.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/evidences": {
+      "get": {
+        "operationId": "GetEvidences",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetEvidencesResponse"
+            }
+          }
+        },
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/issuer": {
+      "get": {
+        "operationId": "GetIssuer",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetIssuerResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "address",
+            "description": "Hex string of the issuer address.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/liveness": {
+      "get": {
+        "operationId": "GetLiveness",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetLivenessResponse"
+            }
+          }
+        },
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/multisig": {
+      "get": {
+        "operationId": "GetMultisig",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetMultisigResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "address",
+            "description": "Hex string of the multisig account address.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
     "/v1/node/medstate": {
       "get": {
         "operationId": "GetMedState",
@@ -59,6 +191,56 @@ const (
         ]
       }
     },
+    "/v1/proposal": {
+      "get": {
+        "operationId": "GetProposal",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetProposalResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "hash",
+            "description": "Hex string of the proposal hash.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/v1/reserved_tasks": {
+      "get": {
+        "operationId": "GetReservedTasks",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbGetReservedTasksResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "address",
+            "description": "Hex string of the task owner address. Empty means all tasks.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
     "/v1/transaction": {
       "get": {
         "operationId": "GetTransaction",
@@ -108,6 +290,32 @@ const (
         ]
       }
     },
+    "/v1/transaction/sponsor": {
+      "post": {
+        "operationId": "SponsorTransaction",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/rpcpbSendTransactionResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rpcpbSendTransactionRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
     "/v1/user/accountstate": {
       "get": {
         "operationId": "GetAccountState",
@@ -207,6 +415,55 @@ const (
           "type": "string",
           "format": "uint64",
           "title": "Block height"
+        },
+        "supply": {
+          "type": "string",
+          "title": "Total supply after block execution"
+        }
+      }
+    },
+    "rpcpbEvidence": {
+      "type": "object",
+      "properties": {
+        "hash": {
+          "type": "string",
+          "description": "Hex string of the evidence hash."
+        },
+        "offender": {
+          "type": "string",
+          "description": "Hex string of the double signing proposer address."
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64",
+          "description": "Timestamp of the double signed slot."
+        },
+        "evidence": {
+          "type": "string",
+          "description": "Hex string of the marshalled evidence to be reported."
+        },
+        "submitted": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "Whether the evidence is already reported on chain."
+        }
+      }
+    },
+    "rpcpbFinalityVote": {
+      "type": "object",
+      "properties": {
+        "voter": {
+          "type": "string",
+          "description": "Hex string of the voter address."
+        },
+        "alg": {
+          "type": "integer",
+          "format": "int64",
+          "description": "Signature algorithm of the vote."
+        },
+        "signature": {
+          "type": "string",
+          "description": "Hex string of the signature."
         }
       }
     },
@@ -229,6 +486,122 @@ const (
         }
       }
     },
+    "rpcpbGetCandidateResponse": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string",
+          "description": "Hex string of the candidate address."
+        },
+        "collateral": {
+          "type": "string",
+          "description": "Collateral of the candidate."
+        },
+        "name": {
+          "type": "string",
+          "description": "Name of the candidate."
+        },
+        "url": {
+          "type": "string",
+          "description": "URL of the candidate."
+        },
+        "peer_id": {
+          "type": "string",
+          "description": "Peer ID of the candidate node."
+        }
+      }
+    },
+    "rpcpbGetEvidencesResponse": {
+      "type": "object",
+      "properties": {
+        "evidences": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbEvidence"
+          },
+          "description": "Evidences of double signing detected by the node."
+        }
+      }
+    },
+    "rpcpbGetFinalityCertificateResponse": {
+      "type": "object",
+      "properties": {
+        "block_hash": {
+          "type": "string",
+          "description": "Hex string of the finalized block hash."
+        },
+        "height": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Height of the finalized block."
+        },
+        "chain_id": {
+          "type": "integer",
+          "format": "int64",
+          "description": "Chain id signed in the votes."
+        },
+        "votes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbFinalityVote"
+          },
+          "description": "Signed confirmations of dynasty members."
+        }
+      }
+    },
+    "rpcpbGetIssuerResponse": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string",
+          "description": "Hex string of the issuer address."
+        },
+        "name": {
+          "type": "string",
+          "description": "Issuer name."
+        },
+        "cert_types": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Certification types the issuer is allowed to issue. Empty means any type."
+        },
+        "root": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "Whether the issuer is a root issuer."
+        },
+        "registrar": {
+          "type": "string",
+          "description": "Hex string of the root issuer who registered this issuer."
+        },
+        "registration_time": {
+          "type": "string",
+          "format": "int64",
+          "description": "Registration timestamp."
+        }
+      }
+    },
+    "rpcpbGetLivenessResponse": {
+      "type": "object",
+      "properties": {
+        "current": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbProposerLiveness"
+          },
+          "description": "Liveness of proposers in current dynasty."
+        },
+        "previous": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbProposerLiveness"
+          },
+          "description": "Liveness of proposers in previous dynasty."
+        }
+      }
+    },
     "rpcpbGetMedStateResponse": {
       "type": "object",
       "properties": {
@@ -256,6 +629,166 @@ const (
         }
       }
     },
+    "rpcpbGetMultisigResponse": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string",
+          "description": "Hex string of the multisig account address."
+        },
+        "threshold": {
+          "type": "integer",
+          "format": "int64",
+          "description": "Total weight of signers required to send a transaction."
+        },
+        "owners": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbMultisigOwner"
+          },
+          "description": "Owners of the multisig account."
+        }
+      }
+    },
+    "rpcpbGetProposalResponse": {
+      "type": "object",
+      "properties": {
+        "hash": {
+          "type": "string",
+          "description": "Hex string of the proposal hash."
+        },
+        "proposer": {
+          "type": "string",
+          "description": "Hex string of the proposer address."
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbParamChange"
+          },
+          "description": "Chain parameter changes proposed."
+        },
+        "submit_time": {
+          "type": "string",
+          "format": "int64",
+          "description": "Timestamp when the proposal was submitted."
+        },
+        "voting_end_time": {
+          "type": "string",
+          "format": "int64",
+          "description": "Timestamp when voting on the proposal ends."
+        },
+        "status": {
+          "type": "string",
+          "description": "Status of the proposal. One of voting, passed and rejected."
+        },
+        "votes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbProposalVote"
+          },
+          "description": "Votes on the proposal."
+        }
+      }
+    },
+    "rpcpbGetReservedTasksResponse": {
+      "type": "object",
+      "properties": {
+        "tasks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rpcpbReservedTask"
+          },
+          "description": "Reserved tasks ordered by timestamp."
+        }
+      }
+    },
+    "rpcpbMultisigOwner": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string",
+          "description": "Hex string of the owner address."
+        },
+        "weight": {
+          "type": "integer",
+          "format": "int64",
+          "description": "Weight of the owner's signature."
+        }
+      }
+    },
+    "rpcpbParamChange": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "description": "Name of the chain parameter."
+        },
+        "value": {
+          "type": "string",
+          "description": "New value of the chain parameter."
+        }
+      }
+    },
+    "rpcpbProposalVote": {
+      "type": "object",
+      "properties": {
+        "voter": {
+          "type": "string",
+          "description": "Hex string of the voter address."
+        },
+        "approve": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "Whether the voter approves the proposal."
+        }
+      }
+    },
+    "rpcpbProposerLiveness": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string",
+          "description": "Hex string of the proposer address."
+        },
+        "produced": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Number of blocks produced by the proposer."
+        },
+        "missed": {
+          "type": "string",
+          "format": "uint64",
+          "description": "Number of slots missed by the proposer."
+        }
+      }
+    },
+    "rpcpbReservedTask": {
+      "type": "object",
+      "properties": {
+        "hash": {
+          "type": "string",
+          "description": "Hex string of the task hash."
+        },
+        "type": {
+          "type": "string",
+          "description": "Reserved task type."
+        },
+        "from": {
+          "type": "string",
+          "description": "Hex string of the task owner address."
+        },
+        "payload": {
+          "type": "string",
+          "description": "Hex string of the task payload."
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64",
+          "description": "Timestamp when the task is executed."
+        }
+      }
+    },
     "rpcpbSendTransactionRequest": {
       "type": "object",
       "properties": {
@@ -306,6 +839,13 @@ const (
         "payer_sign": {
           "type": "string",
           "description": "Transaction payer's sign."
+        },
+        "multi_sign": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Partial signs of multisig owners."
         }
       }
     },
@@ -381,6 +921,13 @@ const (
         "payer_sign": {
           "type": "string",
           "description": "Transaction payer's sign."
+        },
+        "multi_sign": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Partial signs of multisig owners."
         }
       }
     }
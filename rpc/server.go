@@ -22,6 +22,7 @@ import (
 	"github.com/medibloc/go-medibloc/medlet/pb"
 	"github.com/medibloc/go-medibloc/rpc/pb"
 	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 )
 
@@ -30,21 +31,35 @@ type Server struct {
 	addrGrpc  string
 	addrHTTP  string
 	rpcServer *grpc.Server
+	sponsor   *Sponsor
 }
 
 // New returns NewServer.
 func New(cfg *medletpb.Config) *Server {
 	rpc := grpc.NewServer()
+
+	var sponsor *Sponsor
+	if cfg.Sponsor != nil && cfg.Sponsor.Enabled {
+		var err error
+		sponsor, err = NewSponsor(cfg.Sponsor)
+		if err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"err": err,
+			}).Fatal("Failed to create transaction sponsor.")
+		}
+	}
+
 	return &Server{
 		rpcServer: rpc,
 		addrGrpc:  cfg.Rpc.RpcListen[0],
 		addrHTTP:  cfg.Rpc.HttpListen[0],
+		sponsor:   sponsor,
 	}
 }
 
 //Setup sets up server.
 func (s *Server) Setup(bm *core.BlockManager, tm *core.TransactionManager) {
-	api := newAPIService(bm, tm)
+	api := newAPIService(bm, tm, s.sponsor)
 	rpcpb.RegisterApiServiceServer(s.rpcServer, api)
 }
 
@@ -0,0 +1,138 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc
+
+import (
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/keystore"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+)
+
+// Sponsor signs users' transactions as payer according to the sponsor policy.
+//
+// The quota is counted per sender address and kept in memory. It does not survive a restart
+// of the node and does not stop a user from sending from many addresses.
+type Sponsor struct {
+	mu sync.Mutex
+
+	ks    *keystore.KeyStore
+	payer common.Address
+
+	allowedOps   map[string]bool
+	quotaPerUser uint32
+	quotaPeriod  int64
+
+	// timestamps of sponsored transactions per user
+	usage map[common.Address][]int64
+}
+
+// NewSponsor returns a sponsor with a payer key loaded from its encrypted key file.
+func NewSponsor(cfg *medletpb.SponsorConfig) (*Sponsor, error) {
+	passphrase, err := ioutil.ReadFile(cfg.PassphraseFile)
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := keystore.LoadKeyFile(cfg.Keyfile, strings.TrimRight(string(passphrase), "\r\n"))
+	if err != nil {
+		return nil, err
+	}
+	ks := keystore.NewKeyStore()
+	payer, err := ks.SetKey(privKey)
+	if err != nil {
+		return nil, err
+	}
+	if payer != common.HexToAddress(cfg.Address) {
+		return nil, ErrSponsorKeyMismatch
+	}
+
+	allowedOps := make(map[string]bool)
+	for _, op := range cfg.AllowedOperations {
+		allowedOps[op] = true
+	}
+
+	return &Sponsor{
+		ks:           ks,
+		payer:        payer,
+		allowedOps:   allowedOps,
+		quotaPerUser: cfg.QuotaPerUser,
+		quotaPeriod:  cfg.QuotaPeriod,
+		usage:        make(map[common.Address][]int64),
+	}, nil
+}
+
+// Payer returns address of the sponsor.
+func (s *Sponsor) Payer() common.Address {
+	return s.payer
+}
+
+// SponsorTransaction checks the sponsor policy, signs the transaction as payer and pushes it.
+// The transaction counts against the quota of its sender only if push succeeds.
+func (s *Sponsor) SponsorTransaction(tx *core.Transaction, push func(*core.Transaction) error) error {
+	if !s.allowedOps[tx.Type()] {
+		return ErrOperationNotSponsored
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().Unix()
+	if err := s.checkQuota(tx.From(), now); err != nil {
+		return err
+	}
+
+	key, err := s.ks.GetKey(s.payer)
+	if err != nil {
+		return err
+	}
+	signer, err := crypto.NewSignature(key.Algorithm())
+	if err != nil {
+		return err
+	}
+	signer.InitSign(key)
+	if err := tx.SignByPayer(signer); err != nil {
+		return err
+	}
+	if err := push(tx); err != nil {
+		return err
+	}
+
+	s.usage[tx.From()] = append(s.usage[tx.From()], now)
+	return nil
+}
+
+func (s *Sponsor) checkQuota(user common.Address, now int64) error {
+	if s.quotaPerUser == 0 {
+		return nil
+	}
+	var recent []int64
+	for _, t := range s.usage[user] {
+		if now-t < s.quotaPeriod {
+			recent = append(recent, t)
+		}
+	}
+	s.usage[user] = recent
+	if uint32(len(recent)) >= s.quotaPerUser {
+		return ErrSponsorQuotaExceeded
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package rpc_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/crypto/signature/secp256k1"
+	"github.com/medibloc/go-medibloc/keystore"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/rpc"
+	"github.com/medibloc/go-medibloc/util"
+	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestKeyFile writes the key to an encrypted key file and returns paths of the key file and its passphrase.
+func newTestKeyFile(t *testing.T, key signature.PrivateKey) (keyFile, passphraseFile string) {
+	dir, err := ioutil.TempDir(os.TempDir(), "sponsor")
+	require.NoError(t, err)
+	keyFile = filepath.Join(dir, "key.json")
+	passphraseFile = filepath.Join(dir, "passphrase")
+	require.NoError(t, keystore.StoreKeyFile(keyFile, key, "passphrase", 2, 1))
+	require.NoError(t, ioutil.WriteFile(passphraseFile, []byte("passphrase\n"), 0600))
+	return keyFile, passphraseFile
+}
+
+func newTestSponsor(t *testing.T, allowedOps []string, quota uint32) *rpc.Sponsor {
+	payer := testutil.NewAddrKeyPair(t)
+	keyFile, passphraseFile := newTestKeyFile(t, payer.PrivKey)
+
+	sponsor, err := rpc.NewSponsor(&medletpb.SponsorConfig{
+		Enabled:           true,
+		Address:           payer.Addr.Hex(),
+		Keyfile:           keyFile,
+		PassphraseFile:    passphraseFile,
+		AllowedOperations: allowedOps,
+		QuotaPerUser:      quota,
+		QuotaPeriod:       3600,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, payer.Addr, sponsor.Payer())
+	return sponsor
+}
+
+func pushOK(*core.Transaction) error { return nil }
+
+func newTestUserTx(t *testing.T, user *testutil.AddrKeyPair, txType string, nonce uint64) *core.Transaction {
+	tx, err := core.NewTransaction(testutil.ChainID, user.Addr, user.Addr,
+		util.Uint128Zero(), nonce, txType, []byte{})
+	require.NoError(t, err)
+	testutil.SignTx(t, tx, user.PrivKey)
+	return tx
+}
+
+func TestSponsorSignTransaction(t *testing.T) {
+	sponsor := newTestSponsor(t, []string{core.TxOperationAddRecord}, 2)
+	user := testutil.NewAddrKeyPair(t)
+
+	tx := newTestUserTx(t, user, core.TxOperationAddRecord, 1)
+	assert.NoError(t, sponsor.SponsorTransaction(tx, pushOK))
+	assert.NotEmpty(t, tx.PayerSignature())
+	assert.NoError(t, tx.VerifyIntegrity(testutil.ChainID))
+
+	assert.Equal(t, rpc.ErrOperationNotSponsored, sponsor.SponsorTransaction(newTestUserTx(t, user, core.TxOperationVest, 2), pushOK))
+
+	// A transaction which fails to be pushed does not use the quota.
+	errPush := errors.New("push failed")
+	assert.Equal(t, errPush, sponsor.SponsorTransaction(newTestUserTx(t, user, core.TxOperationAddRecord, 2), func(*core.Transaction) error {
+		return errPush
+	}))
+	assert.NoError(t, sponsor.SponsorTransaction(newTestUserTx(t, user, core.TxOperationAddRecord, 2), pushOK))
+	assert.Equal(t, rpc.ErrSponsorQuotaExceeded, sponsor.SponsorTransaction(newTestUserTx(t, user, core.TxOperationAddRecord, 3), pushOK))
+
+	other := testutil.NewAddrKeyPair(t)
+	assert.NoError(t, sponsor.SponsorTransaction(newTestUserTx(t, other, core.TxOperationAddRecord, 1), pushOK))
+}
+
+func TestSponsorDeniesByDefault(t *testing.T) {
+	sponsor := newTestSponsor(t, nil, 0)
+	user := testutil.NewAddrKeyPair(t)
+	assert.Equal(t, rpc.ErrOperationNotSponsored, sponsor.SponsorTransaction(newTestUserTx(t, user, core.TxOperationSend, 1), pushOK))
+}
+
+func TestNewSponsorKeyMismatch(t *testing.T) {
+	keyFile, passphraseFile := newTestKeyFile(t, secp256k1.GeneratePrivateKey())
+
+	_, err := rpc.NewSponsor(&medletpb.SponsorConfig{
+		Enabled:        true,
+		Address:        testutil.NewAddrKeyPair(t).Addr.Hex(),
+		Keyfile:        keyFile,
+		PassphraseFile: passphraseFile,
+	})
+	assert.Equal(t, rpc.ErrSponsorKeyMismatch, err)
+}
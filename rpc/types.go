@@ -15,6 +15,8 @@
 
 package rpc
 
+import "errors"
+
 // Block alias
 const (
 	// genesis block
@@ -29,16 +31,38 @@ const (
 const (
 	ErrMsgBlockNotFound              = "block not found"
 	ErrMsgBuildTransactionFail       = "cannot build transaction"
+	ErrMsgCandidateNotFound          = "candidate not found"
 	ErrMsgConvertBlockFailed         = "cannot convert block"
 	ErrMsgConvertBlockHeightFailed   = "cannot convert block height into integer"
 	ErrMsgConvertBlockResponseFailed = "cannot convert block response"
 	ErrMsgConvertTxResponseFailed    = "cannot convert transaction response"
+	ErrMsgGetEvidencesFailed         = "cannot get evidences"
+	ErrMsgFinalityNotFound           = "finality certificate not found"
+	ErrMsgGetFinalityFailed          = "cannot get finality certificate"
+	ErrMsgGetCandidateFailed         = "cannot get candidate from state"
+	ErrMsgGetLivenessFailed          = "cannot get liveness from state"
+	ErrMsgGetIssuerFailed            = "cannot get issuer from state"
+	ErrMsgGetMultisigFailed          = "cannot get multisig account from state"
+	ErrMsgGetProposalFailed          = "cannot get proposal from state"
+	ErrMsgGetReservedTasksFailed     = "cannot get reserved tasks from state"
 	ErrMsgGetTransactionFailed       = "cannot get transaction from state"
 	ErrMsgInvalidBlockHeight         = "invalid block height"
 	ErrMsgInvalidDataType            = "invalid transaction data type"
 	ErrMsgInvalidTransaction         = "invalid transaction"
 	ErrMsgInvalidTxValue             = "invalid transaction value"
 	ErrMsgInvalidTxDataPayload       = "invalid transaction data payload"
+	ErrMsgIssuerNotFound             = "issuer not found"
+	ErrMsgMultisigNotFound           = "multisig account not found"
+	ErrMsgProposalNotFound           = "proposal not found"
+	ErrMsgSponsorDisabled            = "transaction sponsorship is disabled"
+	ErrMsgSponsorRejected            = "transaction is rejected by sponsor policy"
 	ErrMsgTransactionNotFound        = "transaction not found"
 	ErrMsgUnmarshalTransactionFailed = "cannot unmarshal transaction"
 )
+
+// Error types of rpc package.
+var (
+	ErrSponsorKeyMismatch    = errors.New("sponsor private key does not match sponsor address")
+	ErrOperationNotSponsored = errors.New("transaction operation is not allowed to be sponsored")
+	ErrSponsorQuotaExceeded  = errors.New("sponsor quota of the user is exceeded")
+)
@@ -0,0 +1,191 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package signer
+
+import (
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/signer/pb"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+)
+
+// SignedBlock is the last block signed by a signer.
+type SignedBlock struct {
+	Address   common.Address
+	Height    uint64
+	Timestamp int64
+	Hash      []byte
+}
+
+// ToProto converts SignedBlock to signerpb.SignedBlock.
+func (b *SignedBlock) ToProto() *signerpb.SignedBlock {
+	return &signerpb.SignedBlock{
+		Address:   b.Address.Bytes(),
+		Height:    b.Height,
+		Timestamp: b.Timestamp,
+		Hash:      b.Hash,
+	}
+}
+
+// FromProto converts signerpb.SignedBlock to SignedBlock.
+func (b *SignedBlock) FromProto(msg *signerpb.SignedBlock) {
+	b.Address = common.BytesToAddress(msg.Address)
+	b.Height = msg.Height
+	b.Timestamp = msg.Timestamp
+	b.Hash = msg.Hash
+}
+
+// conflicts returns whether signing the given block together with this one is double signing.
+func (b *SignedBlock) conflicts(height uint64, timestamp int64, hash []byte) bool {
+	if byteutils.Equal(b.Hash, hash) {
+		return false
+	}
+	return height <= b.Height || timestamp <= b.Timestamp
+}
+
+// SlashingProtection persists the last block signed by each signer and refuses to sign blocks
+// at or below it, so that a signer never signs two blocks for the same slot or height.
+// It also persists the finality votes of each signer by height and refuses to vote two blocks
+// at the same height.
+type SlashingProtection struct {
+	mu      sync.Mutex
+	storage storage.Storage
+}
+
+// NewSlashingProtection returns slashing protection which keeps records in the storage.
+func NewSlashingProtection(stor storage.Storage) *SlashingProtection {
+	return &SlashingProtection{storage: stor}
+}
+
+// Guard records the block as signed by the signer before it is signed.
+// It returns ErrDoubleSign if the block conflicts with the last signed block.
+func (p *SlashingProtection) Guard(addr common.Address, height uint64, timestamp int64, hash []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	last, err := p.lastSigned(addr)
+	if err != nil && err != ErrSignedBlockNotFound {
+		return err
+	}
+	if last != nil && last.conflicts(height, timestamp, hash) {
+		return ErrDoubleSign
+	}
+	return p.put(&SignedBlock{
+		Address:   addr,
+		Height:    height,
+		Timestamp: timestamp,
+		Hash:      hash,
+	})
+}
+
+// GuardFinalityVote records the vote on the block as signed by the signer before it is signed.
+// It returns ErrDoubleVote if the signer has voted another block at the same height.
+// Unlike blocks, votes are not guarded by the last one since votes on lower heights are signed
+// after higher ones when the LIB advances by several blocks at once.
+func (p *SlashingProtection) GuardFinalityVote(addr common.Address, height uint64, hash []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := signedFinalityVoteKey(addr, height)
+	voted, err := p.storage.Get(key)
+	if err == nil {
+		if !byteutils.Equal(voted, hash) {
+			return ErrDoubleVote
+		}
+		return nil
+	}
+	if err != storage.ErrKeyNotFound {
+		return err
+	}
+	return p.putDurably(key, hash)
+}
+
+// Import merges a record exported from another node, so that a migrated signer does not sign
+// blocks at or below the ones signed before. It never lowers the record already kept.
+func (p *SlashingProtection) Import(signed *SignedBlock) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	last, err := p.lastSigned(signed.Address)
+	if err == ErrSignedBlockNotFound {
+		return p.put(signed)
+	}
+	if err != nil {
+		return err
+	}
+	merged := *last
+	if signed.Height > last.Height {
+		merged.Height = signed.Height
+		merged.Hash = signed.Hash
+	}
+	if signed.Timestamp > last.Timestamp {
+		merged.Timestamp = signed.Timestamp
+	}
+	return p.put(&merged)
+}
+
+// LastSigned returns the last block signed by the signer.
+func (p *SlashingProtection) LastSigned(addr common.Address) (*SignedBlock, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.lastSigned(addr)
+}
+
+func (p *SlashingProtection) lastSigned(addr common.Address) (*SignedBlock, error) {
+	value, err := p.storage.Get(signedBlockKey(addr))
+	if err == storage.ErrKeyNotFound {
+		return nil, ErrSignedBlockNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	msg := new(signerpb.SignedBlock)
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return nil, err
+	}
+	signed := new(SignedBlock)
+	signed.FromProto(msg)
+	return signed, nil
+}
+
+// put writes the record durably if the storage supports it, since a record lost by a crash
+// would allow signing a conflicting block after restart.
+func (p *SlashingProtection) put(signed *SignedBlock) error {
+	value, err := proto.Marshal(signed.ToProto())
+	if err != nil {
+		return err
+	}
+	return p.putDurably(signedBlockKey(signed.Address), value)
+}
+
+func (p *SlashingProtection) putDurably(key []byte, value []byte) error {
+	if stor, ok := p.storage.(storage.SyncStorage); ok {
+		return stor.PutSync(key, value)
+	}
+	return p.storage.Put(key, value)
+}
+
+func signedBlockKey(addr common.Address) []byte {
+	return append([]byte(signedBlockKeyPrefix), addr.Bytes()...)
+}
+
+func signedFinalityVoteKey(addr common.Address, height uint64) []byte {
+	key := append([]byte(signedFinalityVoteKeyPrefix), addr.Bytes()...)
+	return append(key, byteutils.FromUint64(height)...)
+}
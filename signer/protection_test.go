@@ -0,0 +1,130 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package signer_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/medibloc/go-medibloc/signer"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashingProtection(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	p := signer.NewSlashingProtection(stor)
+	addr := testutil.NewAddrKeyPair(t).Addr
+
+	_, err = p.LastSigned(addr)
+	assert.Equal(t, signer.ErrSignedBlockNotFound, err)
+
+	hash := []byte("block at height 10")
+	require.NoError(t, p.Guard(addr, 10, 100, hash))
+	// Signing the same block again is allowed.
+	assert.NoError(t, p.Guard(addr, 10, 100, hash))
+
+	tests := []struct {
+		height    uint64
+		timestamp int64
+	}{
+		{10, 100},
+		{10, 115},
+		{12, 100},
+		{9, 90},
+	}
+	for _, test := range tests {
+		assert.Equal(t, signer.ErrDoubleSign, p.Guard(addr, test.height, test.timestamp, []byte("conflicting")))
+	}
+
+	// Records are kept in the storage.
+	p = signer.NewSlashingProtection(stor)
+	last, err := p.LastSigned(addr)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), last.Height)
+	assert.Equal(t, int64(100), last.Timestamp)
+	assert.Equal(t, hash, last.Hash)
+
+	require.NoError(t, p.Guard(addr, 11, 115, []byte("block at height 11")))
+	// Other signers are not affected.
+	assert.NoError(t, p.Guard(testutil.NewAddrKeyPair(t).Addr, 10, 100, []byte("other")))
+}
+
+func TestSlashingProtection_FinalityVote(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	p := signer.NewSlashingProtection(stor)
+	addr := testutil.NewAddrKeyPair(t).Addr
+
+	require.NoError(t, p.GuardFinalityVote(addr, 10, []byte("block at height 10")))
+	// Voting the same block again is allowed.
+	assert.NoError(t, p.GuardFinalityVote(addr, 10, []byte("block at height 10")))
+	assert.Equal(t, signer.ErrDoubleVote, p.GuardFinalityVote(addr, 10, []byte("conflicting")))
+
+	// Votes on lower heights are signed after higher ones when the LIB advances by several blocks.
+	assert.NoError(t, p.GuardFinalityVote(addr, 9, []byte("block at height 9")))
+	assert.NoError(t, p.GuardFinalityVote(addr, 11, []byte("block at height 11")))
+
+	// Records are kept in the storage and do not affect blocks or other signers.
+	p = signer.NewSlashingProtection(stor)
+	assert.Equal(t, signer.ErrDoubleVote, p.GuardFinalityVote(addr, 9, []byte("conflicting")))
+	assert.NoError(t, p.GuardFinalityVote(testutil.NewAddrKeyPair(t).Addr, 10, []byte("other")))
+	assert.NoError(t, p.Guard(addr, 10, 100, []byte("conflicting")))
+}
+
+func TestSlashingProtection_Leveldb(t *testing.T) {
+	dir, err := ioutil.TempDir("", "protection")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	stor, err := storage.NewLeveldbStorage(dir)
+	require.NoError(t, err)
+	addr := testutil.NewAddrKeyPair(t).Addr
+	require.NoError(t, signer.NewSlashingProtection(stor).Guard(addr, 10, 100, []byte("a")))
+	require.NoError(t, stor.Close())
+
+	stor, err = storage.NewLeveldbStorage(dir)
+	require.NoError(t, err)
+	defer stor.Close()
+	p := signer.NewSlashingProtection(stor)
+	assert.Equal(t, signer.ErrDoubleSign, p.Guard(addr, 10, 105, []byte("b")))
+}
+
+func TestSlashingProtection_Import(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	p := signer.NewSlashingProtection(stor)
+	addr := testutil.NewAddrKeyPair(t).Addr
+
+	require.NoError(t, p.Import(&signer.SignedBlock{Address: addr, Height: 10, Timestamp: 100, Hash: []byte("a")}))
+	assert.Equal(t, signer.ErrDoubleSign, p.Guard(addr, 10, 105, []byte("b")))
+
+	// An older record does not lower the protection.
+	require.NoError(t, p.Import(&signer.SignedBlock{Address: addr, Height: 5, Timestamp: 50, Hash: []byte("c")}))
+	last, err := p.LastSigned(addr)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), last.Height)
+	assert.Equal(t, int64(100), last.Timestamp)
+	assert.Equal(t, []byte("a"), last.Hash)
+
+	require.NoError(t, p.Import(&signer.SignedBlock{Address: addr, Height: 12, Timestamp: 120, Hash: []byte("d")}))
+	assert.Equal(t, signer.ErrDoubleSign, p.Guard(addr, 12, 125, []byte("e")))
+	assert.NoError(t, p.Guard(addr, 13, 125, []byte("f")))
+}
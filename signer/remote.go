@@ -0,0 +1,187 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package signer
+
+import (
+	"bytes"
+	"io/ioutil"
+	"time"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/signer/pb"
+	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// RemoteSigner requests signatures of blocks to a remote signer server over gRPC.
+type RemoteSigner struct {
+	conn    *grpc.ClientConn
+	client  signerpb.SignerServiceClient
+	addr    common.Address
+	timeout time.Duration
+}
+
+// secretCredentials attaches the secret shared with the remote signer to every request.
+type secretCredentials []byte
+
+func (c secretCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{authMetadataKey: string(c)}, nil
+}
+
+// RequireTransportSecurity refuses to send the secret over a connection without TLS.
+func (c secretCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// ReadSecretFile reads a secret shared between a node and its remote signer.
+// Surrounding whitespace is ignored.
+func ReadSecretFile(path string) ([]byte, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	secret := bytes.TrimSpace(b)
+	if len(secret) == 0 {
+		return nil, ErrNoAuthSecret
+	}
+	return secret, nil
+}
+
+// NewRemoteSigner connects to the remote signer server over TLS authenticating with the secret
+// and fetches the address of its key. The server must present the certificate in certFile
+// or one issued by it.
+func NewRemoteSigner(target string, secret []byte, certFile string) (*RemoteSigner, error) {
+	if len(secret) == 0 {
+		return nil, ErrNoAuthSecret
+	}
+	if certFile == "" {
+		return nil, ErrNoTLSCert
+	}
+	creds, err := credentials.NewClientTLSFromFile(certFile, "")
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(target, grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(secretCredentials(secret)))
+	if err != nil {
+		return nil, err
+	}
+	s := &RemoteSigner{
+		conn:    conn,
+		client:  signerpb.NewSignerServiceClient(conn),
+		timeout: DefaultRequestTimeout,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	res, err := s.client.GetAddress(ctx, &signerpb.AddressRequest{})
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err":    err,
+			"target": target,
+		}).Error("Failed to get address from remote signer.")
+		conn.Close()
+		return nil, err
+	}
+	s.addr = common.BytesToAddress(res.Address)
+	return s, nil
+}
+
+// Address returns address of the key held by the remote signer.
+func (s *RemoteSigner) Address() common.Address {
+	return s.addr
+}
+
+// SignBlock requests a signature of a sealed block and verifies it.
+func (s *RemoteSigner) SignBlock(block *core.Block) error {
+	if !block.Sealed() {
+		return core.ErrBlockNotSealed
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return err
+	}
+	txHashes := make([][]byte, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		txHashes[i] = tx.Hash()
+	}
+	res, err := s.client.SignBlock(ctx, &signerpb.SignBlockRequest{
+		Header:   pbBlock.(*corepb.Block).Header,
+		Height:   block.Height(),
+		TxHashes: txHashes,
+	})
+	if err != nil {
+		return err
+	}
+
+	alg := algorithm.Algorithm(res.Alg)
+	sig, err := crypto.NewSignature(alg)
+	if err != nil {
+		return err
+	}
+	pubKey, err := sig.RecoverPublic(block.Hash(), res.Sign)
+	if err != nil {
+		return err
+	}
+	signer, err := common.PublicKeyToAddress(pubKey)
+	if err != nil {
+		return err
+	}
+	if !signer.Equals(s.addr) {
+		return ErrSignerMismatch
+	}
+	return block.SetSignature(alg, res.Sign)
+}
+
+// SignFinalityVote requests a signature of a finality vote and verifies it.
+func (s *RemoteSigner) SignFinalityVote(vote *core.FinalityVote) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	res, err := s.client.SignFinalityVote(ctx, &signerpb.SignFinalityVoteRequest{
+		ChainId:   vote.ChainID(),
+		BlockHash: vote.BlockHash(),
+		Height:    vote.Height(),
+	})
+	if err != nil {
+		return err
+	}
+
+	signed := *vote
+	signed.SetSignature(algorithm.Algorithm(res.Alg), res.Sign)
+	signer, err := signed.RecoverSigner()
+	if err != nil {
+		return err
+	}
+	if !signer.Equals(s.addr) {
+		return ErrSignerMismatch
+	}
+	vote.SetSignature(signed.Alg(), signed.Signature())
+	return nil
+}
+
+// Close closes the connection to the remote signer.
+func (s *RemoteSigner) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,223 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package signer
+
+import (
+	"crypto/subtle"
+	"net"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/signer/pb"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/byteutils"
+	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Server is a reference remote signer which holds a miner key apart from the node
+// and signs blocks requested by the node under slashing protection.
+// Requests must come over TLS and carry the secret shared with the node.
+type Server struct {
+	key        signature.PrivateKey
+	addr       common.Address
+	secret     []byte
+	protection *SlashingProtection
+
+	listener  net.Listener
+	rpcServer *grpc.Server
+}
+
+// NewServer returns a signer server with the key which serves requests authenticated by the secret
+// over TLS with the certificate and key in certFile and keyFile.
+// Signed blocks are recorded in the storage.
+func NewServer(key signature.PrivateKey, stor storage.Storage, secret []byte, certFile, keyFile string) (*Server, error) {
+	if len(secret) == 0 {
+		return nil, ErrNoAuthSecret
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, ErrNoTLSCert
+	}
+	creds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := common.PublicKeyToAddress(key.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		key:        key,
+		addr:       addr,
+		secret:     secret,
+		protection: NewSlashingProtection(stor),
+	}
+	s.rpcServer = grpc.NewServer(grpc.Creds(creds), grpc.UnaryInterceptor(s.authenticate))
+	signerpb.RegisterSignerServiceServer(s.rpcServer, s)
+	return s, nil
+}
+
+func (s *Server) authenticate(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md[authMetadataKey]
+	if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), s.secret) != 1 {
+		logging.Console().WithFields(logrus.Fields{
+			"method": info.FullMethod,
+		}).Warn("Refused an unauthenticated request.")
+		return nil, status.Error(codes.Unauthenticated, ErrUnauthenticated.Error())
+	}
+	return handler(ctx, req)
+}
+
+// Start starts to serve sign requests.
+func (s *Server) Start(listen string) error {
+	lis, err := net.Listen("tcp", listen)
+	if err != nil {
+		return err
+	}
+	s.listener = lis
+	go func() {
+		if err := s.rpcServer.Serve(lis); err != nil {
+			logging.Console().Error(err)
+		}
+	}()
+	logging.Console().WithFields(logrus.Fields{
+		"listen":  lis.Addr().String(),
+		"address": s.addr.Hex(),
+	}).Info("Signer server is running...")
+	return nil
+}
+
+// Addr returns the address the server listens on.
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Stop stops the server.
+func (s *Server) Stop() {
+	s.rpcServer.Stop()
+}
+
+// GetAddress returns address of the key.
+func (s *Server) GetAddress(ctx context.Context, req *signerpb.AddressRequest) (*signerpb.AddressResponse, error) {
+	return &signerpb.AddressResponse{
+		Address: s.addr.Bytes(),
+	}, nil
+}
+
+// SignBlock signs a block unless it conflicts with an already signed block.
+// The hash to sign is computed from the header and transaction hashes in the request,
+// so that the slot guarded by slashing protection is the one committed by the signature.
+func (s *Server) SignBlock(ctx context.Context, req *signerpb.SignBlockRequest) (*signerpb.SignBlockResponse, error) {
+	if req.Header == nil || req.Height == 0 {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidSignRequest.Error())
+	}
+	header := new(core.BlockHeader)
+	if err := header.FromProto(req.Header); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	hash := core.HashBlockHeader(header, req.TxHashes)
+	timestamp := req.Header.Timestamp
+
+	if err := s.protection.Guard(s.addr, req.Height, timestamp, hash); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err":       err,
+			"hash":      byteutils.Bytes2Hex(hash),
+			"height":    req.Height,
+			"timestamp": timestamp,
+		}).Warn("Refused to sign a block.")
+		if err == ErrDoubleSign {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sig, err := crypto.NewSignature(s.key.Algorithm())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	sig.InitSign(s.key)
+	sign, err := sig.Sign(hash)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logging.WithFields(logrus.Fields{
+		"hash":      byteutils.Bytes2Hex(hash),
+		"height":    req.Height,
+		"timestamp": timestamp,
+	}).Info("Signed a block.")
+	return &signerpb.SignBlockResponse{
+		Alg:  uint32(sig.Algorithm()),
+		Sign: sign,
+	}, nil
+}
+
+// SignFinalityVote signs a finality vote built from the fields in the request
+// unless another block at the same height has been voted.
+func (s *Server) SignFinalityVote(ctx context.Context, req *signerpb.SignFinalityVoteRequest) (*signerpb.SignFinalityVoteResponse, error) {
+	if len(req.BlockHash) == 0 || req.Height == 0 {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidSignRequest.Error())
+	}
+	vote := new(core.FinalityVote)
+	if err := vote.FromProto(&corepb.FinalityVote{
+		ChainId:   req.ChainId,
+		BlockHash: req.BlockHash,
+		Height:    req.Height,
+	}); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.protection.GuardFinalityVote(s.addr, req.Height, req.BlockHash); err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err":    err,
+			"hash":   byteutils.Bytes2Hex(req.BlockHash),
+			"height": req.Height,
+		}).Warn("Refused to sign a finality vote.")
+		if err == ErrDoubleVote {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sig, err := crypto.NewSignature(s.key.Algorithm())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	sig.InitSign(s.key)
+	if err := vote.SignThis(sig); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logging.WithFields(logrus.Fields{
+		"hash":   byteutils.Bytes2Hex(req.BlockHash),
+		"height": req.Height,
+	}).Info("Signed a finality vote.")
+	return &signerpb.SignFinalityVoteResponse{
+		Alg:  uint32(vote.Alg()),
+		Sign: vote.Signature(),
+	}, nil
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package signer_test
+
+import (
+	"testing"
+
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/core/pb"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature/algorithm"
+	"github.com/medibloc/go-medibloc/signer"
+	"github.com/medibloc/go-medibloc/signer/pb"
+	"github.com/medibloc/go-medibloc/storage"
+	"github.com/medibloc/go-medibloc/util/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var secret = []byte("shared secret")
+
+func TestRemoteSigner(t *testing.T) {
+	genesis, dynasties, _ := testutil.NewTestGenesisBlock(t)
+	miner := dynasties[0]
+	certFile, keyFile, cleanup := testutil.NewTestTLSCert(t)
+	defer cleanup()
+
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	server, err := signer.NewServer(miner.PrivKey, stor, secret, certFile, keyFile)
+	require.NoError(t, err)
+	require.NoError(t, server.Start("localhost:0"))
+	defer server.Stop()
+
+	remote, err := signer.NewRemoteSigner(server.Addr(), secret, certFile)
+	require.NoError(t, err)
+	defer remote.Close()
+	assert.Equal(t, miner.Addr, remote.Address())
+
+	block := testutil.NewTestBlock(t, genesis)
+	require.NoError(t, remote.SignBlock(block))
+	assert.NotEmpty(t, block.Signature())
+
+	// Another block of the same height is refused.
+	other := testutil.NewTestBlock(t, genesis)
+	require.NotEqual(t, block.Hash(), other.Hash())
+	assert.Error(t, remote.SignBlock(other))
+
+	vote := core.NewFinalityVote(testutil.ChainID, block)
+	require.NoError(t, remote.SignFinalityVote(vote))
+	voter, err := vote.RecoverSigner()
+	require.NoError(t, err)
+	assert.Equal(t, miner.Addr, voter)
+
+	// A vote on another block at the same height is refused.
+	otherVote := core.NewFinalityVote(testutil.ChainID, other)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(remote.SignFinalityVote(otherVote)))
+	assert.NoError(t, remote.SignFinalityVote(core.NewFinalityVote(testutil.ChainID, block)))
+
+	// The node refuses a signer serving another key.
+	otherStor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	otherServer, err := signer.NewServer(dynasties[1].PrivKey, otherStor, secret, certFile, keyFile)
+	require.NoError(t, err)
+	require.NoError(t, otherServer.Start("localhost:0"))
+	defer otherServer.Stop()
+
+	otherRemote, err := signer.NewRemoteSigner(otherServer.Addr(), secret, certFile)
+	require.NoError(t, err)
+	defer otherRemote.Close()
+	assert.Equal(t, dynasties[1].Addr, otherRemote.Address())
+}
+
+func TestRemoteSignerAuthentication(t *testing.T) {
+	_, dynasties, _ := testutil.NewTestGenesisBlock(t)
+	certFile, keyFile, cleanup := testutil.NewTestTLSCert(t)
+	defer cleanup()
+
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	_, err = signer.NewServer(dynasties[0].PrivKey, stor, nil, certFile, keyFile)
+	assert.Equal(t, signer.ErrNoAuthSecret, err)
+	_, err = signer.NewServer(dynasties[0].PrivKey, stor, secret, "", "")
+	assert.Equal(t, signer.ErrNoTLSCert, err)
+
+	server, err := signer.NewServer(dynasties[0].PrivKey, stor, secret, certFile, keyFile)
+	require.NoError(t, err)
+	require.NoError(t, server.Start("localhost:0"))
+	defer server.Stop()
+
+	_, err = signer.NewRemoteSigner(server.Addr(), secret, "")
+	assert.Equal(t, signer.ErrNoTLSCert, err)
+
+	_, err = signer.NewRemoteSigner(server.Addr(), []byte("wrong secret"), certFile)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// A server presenting another certificate is not trusted.
+	otherCertFile, _, otherCleanup := testutil.NewTestTLSCert(t)
+	defer otherCleanup()
+	_, err = signer.NewRemoteSigner(server.Addr(), secret, otherCertFile)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Plain text connections are refused.
+	insecureConn, err := grpc.Dial(server.Addr(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer insecureConn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), signer.DefaultRequestTimeout)
+	defer cancel()
+	_, err = signerpb.NewSignerServiceClient(insecureConn).GetAddress(ctx, &signerpb.AddressRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	creds, err := credentials.NewClientTLSFromFile(certFile, "")
+	require.NoError(t, err)
+	conn, err := grpc.Dial(server.Addr(), grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = signerpb.NewSignerServiceClient(conn).GetAddress(context.Background(), &signerpb.AddressRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestRemoteSignerSignsRecomputedHash(t *testing.T) {
+	genesis, dynasties, _ := testutil.NewTestGenesisBlock(t)
+	certFile, keyFile, cleanup := testutil.NewTestTLSCert(t)
+	defer cleanup()
+
+	stor, err := storage.NewMemoryStorage()
+	require.NoError(t, err)
+	server, err := signer.NewServer(dynasties[0].PrivKey, stor, secret, certFile, keyFile)
+	require.NoError(t, err)
+	require.NoError(t, server.Start("localhost:0"))
+	defer server.Stop()
+
+	creds, err := credentials.NewClientTLSFromFile(certFile, "")
+	require.NoError(t, err)
+	conn, err := grpc.Dial(server.Addr(), grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := signerpb.NewSignerServiceClient(conn)
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", string(secret)))
+
+	block := testutil.NewTestBlock(t, genesis)
+	pbBlock, err := block.ToProto()
+	require.NoError(t, err)
+	header := pbBlock.(*corepb.Block).Header
+	// A hash in the request is not what is signed.
+	header.Hash = []byte("arbitrary message to be signed")
+
+	res, err := client.SignBlock(ctx, &signerpb.SignBlockRequest{
+		Header: header,
+		Height: block.Height(),
+	})
+	require.NoError(t, err)
+
+	sig, err := crypto.NewSignature(algorithm.Algorithm(res.Alg))
+	require.NoError(t, err)
+	pubKey, err := sig.RecoverPublic(block.Hash(), res.Sign)
+	require.NoError(t, err)
+	addr, err := common.PublicKeyToAddress(pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, dynasties[0].Addr, addr)
+
+	// A request claiming a higher height for the same slot is refused.
+	other := testutil.NewTestBlock(t, genesis)
+	pbOther, err := other.ToProto()
+	require.NoError(t, err)
+	_, err = client.SignBlock(ctx, &signerpb.SignBlockRequest{
+		Header: pbOther.(*corepb.Block).Header,
+		Height: block.Height() + 1,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = client.SignBlock(ctx, &signerpb.SignBlockRequest{Height: block.Height()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package signer
+
+import (
+	"github.com/medibloc/go-medibloc/common"
+	"github.com/medibloc/go-medibloc/core"
+	"github.com/medibloc/go-medibloc/crypto"
+	"github.com/medibloc/go-medibloc/crypto/signature"
+	"github.com/medibloc/go-medibloc/crypto/signature/secp256k1"
+	"github.com/medibloc/go-medibloc/medlet/pb"
+	"github.com/medibloc/go-medibloc/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Signer signs blocks minted by the node and finality votes of the node.
+type Signer interface {
+	Address() common.Address
+	SignBlock(block *core.Block) error
+	SignFinalityVote(vote *core.FinalityVote) error
+}
+
+// New returns the signer of the miner in the chain config. It is a remote signer if remote_signer is set,
+// and otherwise a local signer with privkey.
+func New(cfg *medletpb.Config) (Signer, error) {
+	if cfg.Chain.RemoteSigner != "" {
+		secret, err := ReadSecretFile(cfg.Chain.RemoteSignerSecretFile)
+		if err != nil {
+			logging.Console().WithFields(logrus.Fields{
+				"err":  err,
+				"file": cfg.Chain.RemoteSignerSecretFile,
+			}).Error("Failed to read secret shared with remote signer.")
+			return nil, err
+		}
+		remote, err := NewRemoteSigner(cfg.Chain.RemoteSigner, secret, cfg.Chain.RemoteSignerCertFile)
+		if err != nil {
+			return nil, err
+		}
+		miner := common.HexToAddress(cfg.Chain.Miner)
+		if !remote.Address().Equals(miner) {
+			logging.Console().WithFields(logrus.Fields{
+				"miner":  miner.Hex(),
+				"signer": remote.Address().Hex(),
+			}).Error("Key of remote signer does not belong to the miner.")
+			remote.Close()
+			return nil, ErrSignerMismatch
+		}
+		return remote, nil
+	}
+
+	minerKey, err := secp256k1.NewPrivateKeyFromHex(cfg.Chain.Privkey)
+	if err != nil {
+		logging.Console().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Invalid miner private key.")
+		return nil, err
+	}
+	return NewLocalSigner(minerKey)
+}
+
+// LocalSigner signs blocks with a private key held in the node process.
+type LocalSigner struct {
+	key        signature.PrivateKey
+	addr       common.Address
+	protection *SlashingProtection
+}
+
+// NewLocalSigner returns a signer with the private key.
+func NewLocalSigner(key signature.PrivateKey) (*LocalSigner, error) {
+	addr, err := common.PublicKeyToAddress(key.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	return &LocalSigner{
+		key:  key,
+		addr: addr,
+	}, nil
+}
+
+// Address returns address of the private key.
+func (s *LocalSigner) Address() common.Address {
+	return s.addr
+}
+
+// SetProtection makes the signer record blocks and finality votes before signing them
+// and refuse double signing.
+func (s *LocalSigner) SetProtection(protection *SlashingProtection) {
+	s.protection = protection
+}
+
+// SignBlock signs a sealed block.
+func (s *LocalSigner) SignBlock(block *core.Block) error {
+	if s.protection != nil {
+		if err := s.protection.Guard(s.addr, block.Height(), block.Timestamp(), block.Hash()); err != nil {
+			return err
+		}
+	}
+	sig, err := crypto.NewSignature(s.key.Algorithm())
+	if err != nil {
+		return err
+	}
+	sig.InitSign(s.key)
+	return block.SignThis(sig)
+}
+
+// SignFinalityVote signs a finality vote.
+func (s *LocalSigner) SignFinalityVote(vote *core.FinalityVote) error {
+	if s.protection != nil {
+		if err := s.protection.GuardFinalityVote(s.addr, vote.Height(), vote.BlockHash()); err != nil {
+			return err
+		}
+	}
+	sig, err := crypto.NewSignature(s.key.Algorithm())
+	if err != nil {
+		return err
+	}
+	sig.InitSign(s.key)
+	return vote.SignThis(sig)
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package signer
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultRequestTimeout is a timeout of requests to a remote signer.
+	DefaultRequestTimeout = 3 * time.Second
+
+	signedBlockKeyPrefix        = "signer_signed_block_"
+	signedFinalityVoteKeyPrefix = "signer_finality_vote_"
+
+	authMetadataKey = "authorization"
+)
+
+// Error types of signer package.
+var (
+	ErrDoubleSign          = errors.New("refused to sign a block conflicting with an already signed block")
+	ErrDoubleVote          = errors.New("refused to sign a finality vote conflicting with an already signed vote")
+	ErrSignerMismatch      = errors.New("block is not signed by the expected signer")
+	ErrInvalidSignRequest  = errors.New("invalid sign request")
+	ErrSignedBlockNotFound = errors.New("signed block not found")
+	ErrNoAuthSecret        = errors.New("secret shared with remote signer is not given")
+	ErrUnauthenticated     = errors.New("request is not authenticated")
+	ErrNoTLSCert           = errors.New("TLS certificate of remote signer is not given")
+)
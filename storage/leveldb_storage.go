@@ -63,3 +63,13 @@ func (storage *LeveldbStorage) Get(key []byte) ([]byte, error) {
 func (storage *LeveldbStorage) Put(key []byte, value []byte) error {
 	return storage.db.Put(key, value, nil)
 }
+
+// PutSync put the key-value entry to Storage and returns after it is flushed to disk.
+func (storage *LeveldbStorage) PutSync(key []byte, value []byte) error {
+	return storage.db.Put(key, value, &opt.WriteOptions{Sync: true})
+}
+
+// Close closes the database.
+func (storage *LeveldbStorage) Close() error {
+	return storage.db.Close()
+}
@@ -32,3 +32,11 @@ type Storage interface {
 	// Put put the key-value entry to Storage.
 	Put(key []byte, value []byte) error
 }
+
+// SyncStorage is a Storage which can make a write durable before returning.
+type SyncStorage interface {
+	Storage
+
+	// PutSync put the key-value entry to Storage and returns after it is flushed to disk.
+	PutSync(key []byte, value []byte) error
+}
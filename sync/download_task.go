@@ -113,11 +113,17 @@ func (dt *downloadTask) verifyBlockChunkMessage(message net.Message) error {
 
 	var downloadedHashes [][]byte
 	blocks := make([]*core.BlockData, 0, dt.chunkSize)
+	var txs core.Transactions
 	for _, pbBlock := range blockChunk.Blocks {
 		block := new(core.BlockData)
 		block.FromProto(pbBlock)
 		blocks = append(blocks, block)
+		txs = append(txs, block.Transactions()...)
+	}
+	// Recover signers of all transactions in the chunk at once to verify blocks faster.
+	core.PreVerifyTransactions(txs)
 
+	for _, block := range blocks {
 		if err := block.VerifyIntegrity(); err != nil {
 			logging.WithFields(logrus.Fields{
 				"Block Height": block.Height(),
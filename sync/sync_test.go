@@ -68,7 +68,7 @@ func NewSyncTester(t *testing.T, config *medletpb.Config, genesisConf *corepb.Ge
 	stor, err := storage.NewMemoryStorage()
 	require.Nil(t, err)
 
-	consensus, err := dpos.New(config)
+	consensus, err := dpos.New(config, genesisConf)
 	require.NoError(t, err)
 
 	bm, err := core.NewBlockManager(config)
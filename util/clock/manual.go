@@ -0,0 +1,156 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Manual is a clock which moves only when it is advanced.
+// Tickers fire and sleepers wake up as the clock passes their deadlines.
+type Manual struct {
+	mu       sync.Mutex
+	now      time.Time
+	tickers  []*manualTicker
+	sleepers []*sleeper
+}
+
+type sleeper struct {
+	until time.Time
+	done  chan struct{}
+}
+
+// NewManual returns a manual clock starting at the given time.
+func NewManual(start time.Time) *Manual {
+	return &Manual{now: start}
+}
+
+// Now returns current time of the clock.
+func (m *Manual) Now() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.now
+}
+
+// NewTicker returns a ticker which fires every d after current time of the clock.
+func (m *Manual) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t := &manualTicker{
+		clock:  m,
+		period: d,
+		next:   m.now.Add(d),
+		c:      make(chan time.Time, 1),
+	}
+	m.tickers = append(m.tickers, t)
+	return t
+}
+
+// Sleep blocks until the clock is advanced by d.
+func (m *Manual) Sleep(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	m.mu.Lock()
+	s := &sleeper{
+		until: m.now.Add(d),
+		done:  make(chan struct{}),
+	}
+	m.sleepers = append(m.sleepers, s)
+	m.mu.Unlock()
+	<-s.done
+}
+
+// Tickers returns the number of running tickers.
+func (m *Manual) Tickers() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.tickers)
+}
+
+// PendingTicks returns the number of ticks fired but not received yet.
+// Simulations wait for it to be zero before advancing so that no tick is dropped.
+func (m *Manual) PendingTicks() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pending := 0
+	for _, t := range m.tickers {
+		pending += len(t.c)
+	}
+	return pending
+}
+
+// Advance moves the clock forward by d.
+func (m *Manual) Advance(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.now = m.now.Add(d)
+
+	var sleepers []*sleeper
+	for _, s := range m.sleepers {
+		if s.until.After(m.now) {
+			sleepers = append(sleepers, s)
+			continue
+		}
+		close(s.done)
+	}
+	m.sleepers = sleepers
+
+	for _, t := range m.tickers {
+		t.fire(m.now)
+	}
+}
+
+type manualTicker struct {
+	clock  *Manual
+	period time.Duration
+	next   time.Time
+	c      chan time.Time
+}
+
+// fire sends a tick if the ticker's deadline has passed. Like time.Ticker, ticks are dropped for slow receivers.
+func (t *manualTicker) fire(now time.Time) {
+	if t.next.After(now) {
+		return
+	}
+	for !t.next.After(now) {
+		t.next = t.next.Add(t.period)
+	}
+	select {
+	case t.c <- now:
+	default:
+	}
+}
+
+func (t *manualTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *manualTicker) Stop() {
+	m := t.clock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, ticker := range m.tickers {
+		if ticker == t {
+			m.tickers = append(m.tickers[:i], m.tickers[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2018  MediBloc
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>
+
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medibloc/go-medibloc/util/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManualTicker(t *testing.T) {
+	start := time.Unix(1500000000, 0)
+	c := clock.NewManual(start)
+	ticker := c.NewTicker(time.Second)
+
+	c.Advance(500 * time.Millisecond)
+	select {
+	case <-ticker.C():
+		t.Fatal("ticker fired before its interval")
+	default:
+	}
+
+	assert.Equal(t, 1, c.Tickers())
+	c.Advance(500 * time.Millisecond)
+	assert.Equal(t, 1, c.PendingTicks())
+	assert.Equal(t, start.Add(time.Second), <-ticker.C())
+	assert.Equal(t, 0, c.PendingTicks())
+
+	c.Advance(3 * time.Second)
+	assert.Equal(t, start.Add(4*time.Second), <-ticker.C())
+	select {
+	case <-ticker.C():
+		t.Fatal("ticks passed over by a single advance must be delivered once")
+	default:
+	}
+
+	ticker.Stop()
+	assert.Equal(t, 0, c.Tickers())
+	c.Advance(time.Second)
+	select {
+	case <-ticker.C():
+		t.Fatal("stopped ticker fired")
+	default:
+	}
+}
+
+func TestManualSleep(t *testing.T) {
+	c := clock.NewManual(time.Unix(1500000000, 0))
+	c.Sleep(0)
+
+	done := make(chan struct{})
+	go func() {
+		c.Sleep(time.Second)
+		close(done)
+	}()
+	for {
+		c.Advance(100 * time.Millisecond)
+		select {
+		case <-done:
+			assert.True(t, !c.Now().Before(time.Unix(1500000001, 0)))
+			return
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
@@ -19,8 +19,16 @@ import (
 	"strings"
 	"testing"
 
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
 	"math/big"
+	"os"
+	"path/filepath"
 
 	goNet "net"
 
@@ -51,6 +59,18 @@ var (
 	ChainID uint32 = 1
 	// GenesisID genesis ID
 	GenesisID BlockID = -1
+	// Forks forks active from genesis in test networks
+	Forks = []string{
+		core.ForkIssuerRegistry,
+		core.ForkMultisig,
+		core.ForkScheduledTransfer,
+		core.ForkGovernance,
+		core.ForkCandidateMeta,
+		core.ForkDoubleSignSlashing,
+		core.ForkMultiVote,
+		core.ForkDelegation,
+		core.ForkExtendedHeader,
+	}
 
 	fromAddress = "02279dcbc360174b4348685e75287a60abc5290497d2e3330b6a1791c4f35bcd20"
 )
@@ -92,7 +112,7 @@ func NewTestGenesisConf(t *testing.T) (conf *corepb.Genesis, dynasties Dynasties
 	conf = &corepb.Genesis{
 		Meta: &corepb.GenesisMeta{
 			ChainId:     ChainID,
-			DynastySize: dpos.DynastySize,
+			DynastySize: dpos.DefaultDynastySize,
 		},
 		Consensus: &corepb.GenesisConsensus{
 			Dpos: &corepb.GenesisConsensusDpos{
@@ -100,11 +120,15 @@ func NewTestGenesisConf(t *testing.T) (conf *corepb.Genesis, dynasties Dynasties
 			},
 		},
 		TokenDistribution: nil,
+		ChainParams:       new(corepb.ChainParams),
+	}
+	for _, fork := range Forks {
+		conf.ChainParams.Forks = append(conf.ChainParams.Forks, &corepb.Fork{Name: fork})
 	}
 
 	var dynasty []string
 	var tokenDist []*corepb.GenesisTokenDistribution
-	for i := 0; i < dpos.DynastySize; i++ {
+	for i := 0; i < dpos.DefaultDynastySize; i++ {
 		keypair := NewAddrKeyPair(t)
 		dynasty = append(dynasty, keypair.Addr.Hex())
 		tokenDist = append(tokenDist, &corepb.GenesisTokenDistribution{
@@ -147,7 +171,7 @@ func NewTestConsensus(t *testing.T) core.Consensus {
 	cfg.Chain.BlockCacheSize = 1
 	cfg.Chain.Coinbase = "02fc22ea22d02fc2469f5ec8fab44bc3de42dda2bf9ebc0c0055a9eb7df579056c"
 	cfg.Chain.Miner = "02fc22ea22d02fc2469f5ec8fab44bc3de42dda2bf9ebc0c0055a9eb7df579056c"
-	consensus, err := dpos.New(cfg)
+	consensus, err := dpos.New(cfg, nil)
 	require.NoError(t, err)
 	return consensus
 }
@@ -193,7 +217,7 @@ func getBlock(t *testing.T, parent *core.Block, coinbaseHex string) *core.Block
 	require.EqualValues(t, block.ParentHash(), parent.Hash())
 
 	parentBlockTime := time.Unix(parent.Timestamp(), 0)
-	err = block.SetTimestamp(parentBlockTime.Add(dpos.BlockInterval).Unix())
+	err = block.SetTimestamp(parentBlockTime.Add(dpos.DefaultBlockInterval).Unix())
 	require.NoError(t, err)
 
 	return block
@@ -203,7 +227,7 @@ func getBlock(t *testing.T, parent *core.Block, coinbaseHex string) *core.Block
 func SignBlock(t *testing.T, block *core.Block, dynasties Dynasties) {
 	members, err := block.State().Dynasty()
 	require.NoError(t, err)
-	proposer, err := dpos.FindProposer(block.Timestamp(), members)
+	proposer, err := dpos.DefaultParams().FindProposer(block.Timestamp(), members)
 	require.NoError(t, err)
 
 	privKey := dynasties.findPrivKey(proposer)
@@ -407,7 +431,7 @@ func NewMockMedlet(t *testing.T) *MockMedlet {
 	var ns net.Service
 	stor, err := storage.NewMemoryStorage()
 	require.NoError(t, err)
-	consensus, err := dpos.New(cfg)
+	consensus, err := dpos.New(cfg, genesisConf)
 	require.NoError(t, err)
 	bm, err := core.NewBlockManager(cfg)
 	require.NoError(t, err)
@@ -486,3 +510,34 @@ func FindRandomListenPorts(n int) (ports []string) {
 	}
 	return ports
 }
+
+// NewTestTLSCert writes a self-signed TLS certificate for localhost and its key to files.
+// Remove the files with cleanup.
+func NewTestTLSCert(t *testing.T) (certFile string, keyFile string, cleanup func()) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []goNet.IP{goNet.ParseIP("127.0.0.1"), goNet.ParseIP("::1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "tls")
+	require.NoError(t, err)
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile, func() { os.RemoveAll(dir) }
+}